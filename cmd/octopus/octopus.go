@@ -12,43 +12,108 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/cloudflare/octopus/pkg/connector"
+	"github.com/cloudflare/octopus/pkg/connector/file"
+	"github.com/cloudflare/octopus/pkg/connector/mock"
 	"github.com/cloudflare/octopus/pkg/connector/netbox"
+	"github.com/cloudflare/octopus/pkg/graph"
+	"github.com/cloudflare/octopus/pkg/model"
 	"github.com/cloudflare/octopus/pkg/octopus"
+	"github.com/cloudflare/octopus/pkg/validation"
 )
 
 const (
 	httpServerTimeout            = time.Second * 60
 	netboxPostgresPasswordOption = "NETBOX_DB_PASSWORD"
+	netboxAPITokenOption         = "NETBOX_API_TOKEN"
+	netboxWebhookSecretOption    = "NETBOX_WEBHOOK_SECRET"
+	netboxWebhookPath            = "/webhooks/netbox"
+	graphQLPath                  = "/graphql"
 )
 
 var (
 	grpcPort       = flag.Uint("grpc-port", 2342, "GRPC API server port")
-	httpPort       = flag.Uint("http-port", 8080, "HTTP server port (for metrics)")
+	httpPort       = flag.Uint("http-port", 8080, "HTTP server port (for metrics, the REST and the GraphQL API)")
 	mockConnectors = flag.Bool("mock-connectors", false, "If set, connectors will be used with mock data")
+	mockScale      = flag.Uint("mock.scale", 3, "Number of sites generated by the mock connector")
 
-	netboxDisable      = flag.Bool("netbox.disable", false, "Disable NetBox connector")
-	netboxDBHost       = flag.String("netbox.db.host", "localhost", "Netbox's postgres DB host")
-	netboxDBPort       = flag.Uint("netbox.db.port", 5432, "Netbox's postgres DB port")
-	netboxDBUser       = flag.String("netbox.db.user", "netbox", "Netbox's postgres DB user")
-	netboxDBName       = flag.String("netbox.db.db", "netbox", "Netbox's postgres DB name")
-	netboxDBPassword   = flag.String("netbox.db.password", "", fmt.Sprintf("Netbox DB password (should be set as ENV %q", netboxPostgresPasswordOption))
-	netboxDBTLS        = flag.Bool("netbox.db.tls", true, "Use TLS for the DB connection")
-	netboxDBCaCertPath = flag.String("netbox.db.ca-cert-file-path", "", "Path to CA certificate PEM file")
-	netboxDBLogQueries = flag.Bool("netbox.db.log-queries", false, "Log DB queries")
+	snapshotDir       = flag.String("snapshot.dir", "", "Directory to persist topology snapshots to and warm start from (disabled if empty)")
+	snapshotRetention = flag.Int("snapshot.retention", 10, "Number of topology snapshots kept in snapshot.dir, i.e. the history covers roughly that many rebuilds")
+	snapshotMaxAge    = flag.Duration("snapshot.max-age", 0, "Age after which topology snapshots are removed from snapshot.dir, on top of snapshot.retention (the latest one is always kept, disabled if 0)")
+	historyMaxBytes   = flag.Int64("history.max-bytes", 256<<20, "Size (serialized) of the topology snapshots kept in memory for point-in-time queries. Older ones are only kept in snapshot.dir")
+
+	connectorPolicies    = flag.String("connector.policies", "", "Comma separated connector=policy pairs, policy being required (default), optional-skip or optional-last-good, e.g. \"File=optional-last-good\"")
+	validationSeverities = flag.String("validation.severities", "", "Comma separated rule=severity pairs overriding the default severity of validation rules, severity being info, warning, error or fatal (blocks publishing), e.g. \"dangling-cable=fatal\"")
+	shrinkageLimits      = flag.String("shrinkage.limits", "*=50%", "Comma separated item=limit pairs of how many items (number or percentage) may disappear between two topologies before publishing is blocked, item being * or sites, pops, colos, devices, interfaces, cables, circuits or prefixes, e.g. \"devices=10%,*=50%\" (disabled if empty)")
+	mergePolicyFile      = flag.String("merge.policy-file", "", "YAML file configuring how conflicting attributes of connectors are merged (later connectors win if empty)")
+
+	graphQLMaxDepth      = flag.Int("graphql.max-depth", 12, "Maximum depth of nested fields of GraphQL queries")
+	graphQLMaxComplexity = flag.Int("graphql.max-complexity", 100000, "Maximum complexity of GraphQL queries, roughly the number of fields resolved with list fields counting their items as often as the current topology has them (or the first argument)")
+
+	fileDir = flag.String("file.dir", "", "Directory of YAML/JSON documents to overlay onto the topology (disabled if empty)")
+
+	netboxDisable       = flag.Bool("netbox.disable", false, "Disable NetBox connector")
+	netboxSource        = flag.String("netbox.source", "db", "Where to read NetBox data from, either \"db\" (Postgres) or \"api\" (REST API)")
+	netboxAPIURL        = flag.String("netbox.api.url", "", "NetBox base URL, e.g. https://netbox.example.com")
+	netboxAPIToken      = flag.String("netbox.api.token", "", fmt.Sprintf("NetBox API token (should be set as ENV %q)", netboxAPITokenOption))
+	netboxDBHost        = flag.String("netbox.db.host", "localhost", "Netbox's postgres DB host")
+	netboxDBPort        = flag.Uint("netbox.db.port", 5432, "Netbox's postgres DB port")
+	netboxDBUser        = flag.String("netbox.db.user", "netbox", "Netbox's postgres DB user")
+	netboxDBName        = flag.String("netbox.db.db", "netbox", "Netbox's postgres DB name")
+	netboxDBPassword    = flag.String("netbox.db.password", "", fmt.Sprintf("Netbox DB password (should be set as ENV %q", netboxPostgresPasswordOption))
+	netboxDBTLS         = flag.Bool("netbox.db.tls", true, "Use TLS for the DB connection")
+	netboxDBCaCertPath  = flag.String("netbox.db.ca-cert-file-path", "", "Path to CA certificate PEM file")
+	netboxDBLogQueries  = flag.Bool("netbox.db.log-queries", false, "Log DB queries")
+	netboxDBNotify      = flag.String("netbox.db.notify-channel", "", "Postgres channel to LISTEN on for change notifications (disabled if empty)")
+	netboxWebhook       = flag.Bool("netbox.webhook", false, fmt.Sprintf("Accept NetBox webhooks triggering a refresh at %s", netboxWebhookPath))
+	netboxWebhookSecret = flag.String("netbox.webhook.secret", "", fmt.Sprintf("Secret to verify NetBox webhook signatures with (should be set as ENV %q)", netboxWebhookSecretOption))
+
+	// Set if the NetBox connector is enabled, to register the webhook handler
+	netboxConnector *netbox.NetboxConnector
 )
 
 func getConnectors() []connector.Connector {
 	conns := make([]connector.Connector, 0)
 
 	if !*netboxDisable {
+		netboxConnector = getNetboxConnector()
+		conns = append(conns, netboxConnector)
+	}
+
+	// The file connector has to come last, as it overrides data of the connectors before
+	if *fileDir != "" {
+		conns = append(conns, file.NewConnector(*fileDir))
+	}
+
+	return conns
+}
+
+func getNetboxConnector() *netbox.NetboxConnector {
+	switch *netboxSource {
+	case "db":
 		if *netboxDBPassword == "" {
 			log.Fatalf("%s is a mandatory parameter", netboxPostgresPasswordOption)
 		}
 
-		conns = append(conns, netbox.NewConnector(*netboxDBHost, *netboxDBPort, *netboxDBUser, *netboxDBPassword, *netboxDBName, *netboxDBTLS, *netboxDBCaCertPath, *netboxDBLogQueries))
+		nc := netbox.NewConnector(*netboxDBHost, *netboxDBPort, *netboxDBUser, *netboxDBPassword, *netboxDBName, *netboxDBTLS, *netboxDBCaCertPath, *netboxDBLogQueries)
+		if *netboxDBNotify != "" {
+			err := nc.ListenForNotifications(*netboxDBNotify)
+			if err != nil {
+				log.Fatalf("Failed to set up NetBox change notifications: %v", err)
+			}
+		}
+
+		return nc
+
+	case "api":
+		if *netboxAPIURL == "" || *netboxAPIToken == "" {
+			log.Fatalf("netbox.api.url and %s are mandatory parameters", netboxAPITokenOption)
+		}
+
+		return netbox.NewAPIConnector(*netboxAPIURL, *netboxAPIToken)
 	}
 
-	return conns
+	log.Fatalf("Unknown NetBox source %q", *netboxSource)
+	return nil
 }
 
 func getMockConnectors() []connector.Connector {
@@ -57,7 +122,11 @@ func getMockConnectors() []connector.Connector {
 	conns := make([]connector.Connector, 0)
 
 	if !*netboxDisable {
-		log.Info("Mock connector for NetBox not implemented (yet).")
+		conns = append(conns, mock.NewConnector(*mockScale))
+	}
+
+	if *fileDir != "" {
+		conns = append(conns, file.NewConnector(*fileDir))
 	}
 
 	return conns
@@ -68,6 +137,16 @@ func loadEnvVars() {
 	if netboxDBPasswordEnv != "" {
 		netboxDBPassword = &netboxDBPasswordEnv
 	}
+
+	netboxAPITokenEnv := os.Getenv(netboxAPITokenOption)
+	if netboxAPITokenEnv != "" {
+		netboxAPIToken = &netboxAPITokenEnv
+	}
+
+	netboxWebhookSecretEnv := os.Getenv(netboxWebhookSecretOption)
+	if netboxWebhookSecretEnv != "" {
+		netboxWebhookSecret = &netboxWebhookSecretEnv
+	}
 }
 
 func main() {
@@ -94,14 +173,77 @@ func main() {
 	 * Set up the Octopus
 	 */
 	o := octopus.NewOctopus(uint16(*grpcPort))
-	err := o.Init(connectors)
+	o.SetHistoryBudget(*historyMaxBytes)
+
+	policies, err := octopus.ParseConnectorPolicies(*connectorPolicies)
 	if err != nil {
-		log.Fatalf("Failed to initialize octopus: %v", err)
+		log.Fatalf("Invalid connector.policies: %v", err)
+	}
+
+	for name, policy := range policies {
+		o.SetConnectorPolicy(name, policy)
+	}
+
+	if *mergePolicyFile != "" {
+		mp, err := octopus.LoadMergePolicies(*mergePolicyFile)
+		if err != nil {
+			log.Fatalf("Invalid merge.policy-file: %v", err)
+		}
+
+		o.SetMergePolicies(mp)
+	}
+
+	severities, err := validation.ParseSeverities(*validationSeverities)
+	if err != nil {
+		log.Fatalf("Invalid validation.severities: %v", err)
+	}
+
+	validator := validation.NewValidator(validation.DefaultRules()...)
+	for rule, severity := range severities {
+		err := validator.SetSeverity(rule, severity)
+		if err != nil {
+			log.Fatalf("Invalid validation.severities: %v", err)
+		}
+	}
+
+	o.SetValidator(validator)
+
+	limits, err := octopus.ParseShrinkageLimits(*shrinkageLimits)
+	if err != nil {
+		log.Fatalf("Invalid shrinkage.limits: %v", err)
+	}
+
+	o.SetShrinkageLimits(limits)
+
+	if *snapshotDir != "" {
+		err := o.EnablePersistence(*snapshotDir, *snapshotRetention, *snapshotMaxAge)
+		if err != nil {
+			log.Fatalf("Failed to enable snapshot persistence: %v", err)
+		}
+
+		err = o.WarmStart()
+		if err != nil {
+			log.Warnf("Unable to warm start: %v", err)
+		}
+	}
+
+	// With a warm started topology we keep serving it (flagged stale) until the connectors recover
+	err = o.Init(connectors)
+	if err != nil {
+		if !o.Healthy() {
+			log.Fatalf("Failed to initialize octopus: %v", err)
+		}
+
+		log.Errorf("Failed to initialize octopus, serving stale topology: %v", err)
 	}
 
 	err = o.UpdateTopology()
 	if err != nil {
-		log.Fatalf("Failed to update topology data: %v", err)
+		if !o.Healthy() {
+			log.Fatalf("Failed to update topology data: %v", err)
+		}
+
+		log.Errorf("Failed to update topology data, serving stale topology: %v", err)
 	}
 
 	/*
@@ -137,7 +279,24 @@ func serveHTTP(o *octopus.Octopus) {
 
 	m.HandleFunc("/ready", func(rw http.ResponseWriter, req *http.Request) {
 		if o.Healthy() {
-			_, _ = rw.Write([]byte("OK"))
+			// Followed by the current topology, whether it has been restored from disk only, and the connectors it has been built from
+			topology := o.GetTopology()
+			_, _ = rw.Write([]byte("OK\n"))
+			if topology.Stale {
+				_, _ = fmt.Fprintf(rw, "Topology generation %d built %s ago, stale (restored from disk, not rebuilt from the connectors yet)\n", topology.Generation, time.Since(topology.Timestamp).Truncate(time.Second))
+			} else {
+				_, _ = fmt.Fprintf(rw, "Topology generation %d built %s ago\n", topology.Generation, time.Since(topology.Timestamp).Truncate(time.Second))
+			}
+
+			for _, cp := range topology.Connectors {
+				if cp.Contribution == model.ContributionSkipped {
+					_, _ = fmt.Fprintf(rw, "%s: %s (%s)\n", cp.Connector, cp.Contribution, cp.Policy)
+					continue
+				}
+
+				_, _ = fmt.Fprintf(rw, "%s: %s (%s), loaded %s ago\n", cp.Connector, cp.Contribution, cp.Policy, time.Since(cp.LoadTime).Truncate(time.Second))
+			}
+
 			return
 		}
 
@@ -146,6 +305,12 @@ func serveHTTP(o *octopus.Octopus) {
 	})
 
 	m.Handle("/metrics", promhttp.Handler())
+	m.Handle(octopus.RESTAPIPrefix, o.RESTHandler())
+	m.Handle(graphQLPath, graph.NewHandler(o, *graphQLMaxDepth, *graphQLMaxComplexity))
+
+	if *netboxWebhook && netboxConnector != nil {
+		m.Handle(netboxWebhookPath, netboxConnector.WebhookHandler(*netboxWebhookSecret))
+	}
 
 	err := s.ListenAndServe()
 	if err != nil {
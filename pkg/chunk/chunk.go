@@ -0,0 +1,186 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+// Package chunk splits topologies into chunks of bounded size for StreamTopology and reassembles them on the client side
+package chunk
+
+import (
+	"fmt"
+	"io"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// Split splits the topology into chunks with parts of at most maxBytes (serialized), objects larger than that get a chunk of their own.
+// The first chunk holds the scalar fields, connectors and as many merge conflicts as fit, the following ones batches of the remaining
+// merge conflicts, sites, pops, colos, devices, cables, prefixes, circuits, and logical links. The chunks share objects with pt.
+func Split(pt *octopuspb.Topology, maxBytes int) []*octopuspb.TopologyChunk {
+	s := &splitter{
+		generation: pt.Generation,
+		maxBytes:   maxBytes,
+		chunks:     make([]*octopuspb.TopologyChunk, 0),
+	}
+
+	// There can be a lot of merge conflicts, so they are batched starting in the first chunk
+	s.part = &octopuspb.Topology{
+		Timestamp:  pt.Timestamp,
+		Generation: pt.Generation,
+		Stale:      pt.Stale,
+		Connectors: pt.Connectors,
+	}
+	s.size = proto.Size(s.part)
+
+	batch(s, pt.MergeConflicts, func(part *octopuspb.Topology, mc *octopuspb.MergeConflict) {
+		part.MergeConflicts = append(part.MergeConflicts, mc)
+	})
+	batch(s, pt.Sites, func(part *octopuspb.Topology, site *octopuspb.Site) {
+		part.Sites = append(part.Sites, site)
+	})
+	batch(s, pt.Pops, func(part *octopuspb.Topology, pop *octopuspb.Pop) {
+		part.Pops = append(part.Pops, pop)
+	})
+	batch(s, pt.Colos, func(part *octopuspb.Topology, colo *octopuspb.Colo) {
+		part.Colos = append(part.Colos, colo)
+	})
+	batch(s, pt.Devices, func(part *octopuspb.Topology, d *octopuspb.Device) {
+		part.Devices = append(part.Devices, d)
+	})
+	batch(s, pt.Cables, func(part *octopuspb.Topology, c *octopuspb.Cable) {
+		part.Cables = append(part.Cables, c)
+	})
+	batch(s, pt.Prefixes, func(part *octopuspb.Topology, p *octopuspb.Prefix) {
+		part.Prefixes = append(part.Prefixes, p)
+	})
+	batch(s, pt.Circuits, func(part *octopuspb.Topology, c *octopuspb.Circuit) {
+		part.Circuits = append(part.Circuits, c)
+	})
+	batch(s, pt.LogicalLinks, func(part *octopuspb.Topology, ll *octopuspb.LogicalLink) {
+		part.LogicalLinks = append(part.LogicalLinks, ll)
+	})
+
+	s.chunks[len(s.chunks)-1].Last = true
+	return s.chunks
+}
+
+type splitter struct {
+	generation uint64
+	maxBytes   int
+	chunks     []*octopuspb.TopologyChunk
+
+	// Part of the current batch and its size
+	part *octopuspb.Topology
+	size int
+}
+
+// add adds a chunk with the given part, ending the current batch
+func (s *splitter) add(part *octopuspb.Topology) {
+	s.chunks = append(s.chunks, &octopuspb.TopologyChunk{
+		Generation: s.generation,
+		Index:      uint32(len(s.chunks)),
+		Part:       part,
+	})
+
+	s.part = nil
+	s.size = 0
+}
+
+// batch adds the items to parts of at most maxBytes, continuing the current part if there is one
+func batch[T proto.Message](s *splitter, items []T, appendTo func(part *octopuspb.Topology, item T)) {
+	for _, item := range items {
+		// Size of the item as field of the Topology, the tag takes at most 2 bytes
+		size := proto.Size(item)
+		size += 2 + protowire.SizeVarint(uint64(size))
+
+		if s.part != nil && s.size+size > s.maxBytes {
+			s.add(s.part)
+		}
+
+		if s.part == nil {
+			s.part = &octopuspb.Topology{}
+		}
+
+		appendTo(s.part, item)
+		s.size += size
+	}
+
+	if s.part != nil {
+		s.add(s.part)
+	}
+}
+
+// Assembler reassembles a topology from its chunks
+type Assembler struct {
+	topology *octopuspb.Topology
+	next     uint32
+	done     bool
+}
+
+func NewAssembler() *Assembler {
+	return &Assembler{
+		topology: &octopuspb.Topology{},
+	}
+}
+
+// Add adds the next chunk. Chunks have to be added in order.
+func (a *Assembler) Add(c *octopuspb.TopologyChunk) error {
+	if a.done {
+		return fmt.Errorf("chunk %d received after the last chunk", c.Index)
+	}
+
+	if c.Index != a.next {
+		return fmt.Errorf("expected chunk %d, got %d", a.next, c.Index)
+	}
+
+	if c.Index > 0 && c.Generation != a.topology.Generation {
+		return fmt.Errorf("chunk %d is of generation %d, expected %d", c.Index, c.Generation, a.topology.Generation)
+	}
+
+	if c.Part != nil {
+		proto.Merge(a.topology, c.Part)
+	}
+
+	a.topology.Generation = c.Generation
+	a.next++
+	a.done = c.Last
+	return nil
+}
+
+// Topology returns the reassembled topology once the last chunk has been added
+func (a *Assembler) Topology() (*octopuspb.Topology, error) {
+	if !a.done {
+		return nil, fmt.Errorf("topology incomplete, got %d chunk(s)", a.next)
+	}
+
+	return a.topology, nil
+}
+
+// Receiver is implemented by StreamTopology client streams
+type Receiver interface {
+	Recv() (*octopuspb.TopologyChunk, error)
+}
+
+// Receive reads all chunks from the stream and returns the reassembled topology
+func Receive(r Receiver) (*octopuspb.Topology, error) {
+	a := NewAssembler()
+	for {
+		c, err := r.Recv()
+		if err == io.EOF {
+			return a.Topology()
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to receive chunk: %v", err)
+		}
+
+		err = a.Add(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,165 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package chunk
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestTopology(devices int) *octopuspb.Topology {
+	pt := &octopuspb.Topology{
+		Timestamp:  1700000000,
+		Generation: 42,
+		Sites:      []*octopuspb.Site{{Name: "DUS01"}},
+		Pops:       []*octopuspb.Pop{{Name: "dus01"}},
+		Connectors: []*octopuspb.ConnectorProvenance{{Connector: "NetBox"}},
+	}
+
+	for i := 0; i < devices; i++ {
+		name := fmt.Sprintf("ccr%02d.dus01", i)
+		pt.Devices = append(pt.Devices, &octopuspb.Device{
+			Name:       name,
+			Interfaces: []*octopuspb.Interface{{Name: "et-0/0/0"}, {Name: "et-0/0/1"}},
+		})
+		pt.Cables = append(pt.Cables, &octopuspb.Cable{
+			AEnd: &octopuspb.CableEnd{DeviceName: name, EndpointName: "et-0/0/0"},
+			BEnd: &octopuspb.CableEnd{DeviceName: name, EndpointName: "et-0/0/1"},
+		})
+	}
+
+	return pt
+}
+
+type chunkStream struct {
+	chunks []*octopuspb.TopologyChunk
+}
+
+func (s *chunkStream) Recv() (*octopuspb.TopologyChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+
+	c := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return c, nil
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name           string
+		devices        int
+		maxBytes       int
+		expectedChunks int
+	}{
+		{
+			name:           "Empty topology",
+			devices:        0,
+			maxBytes:       1024,
+			expectedChunks: 3,
+		},
+		{
+			name:           "Everything fits",
+			devices:        10,
+			maxBytes:       1 << 20,
+			expectedChunks: 5,
+		},
+		{
+			name:           "Multiple batches",
+			devices:        100,
+			maxBytes:       512,
+			expectedChunks: 24,
+		},
+		{
+			name:           "Objects larger than a chunk",
+			devices:        3,
+			maxBytes:       1,
+			expectedChunks: 3 + 3 + 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pt := newTestTopology(test.devices)
+			chunks := Split(pt, test.maxBytes)
+			assert.Equal(t, test.expectedChunks, len(chunks))
+
+			for i, c := range chunks {
+				assert.Equal(t, uint32(i), c.Index)
+				assert.Equal(t, pt.Generation, c.Generation)
+				assert.Equal(t, i == len(chunks)-1, c.Last)
+
+				objects := len(c.Part.Sites) + len(c.Part.Pops) + len(c.Part.Devices) + len(c.Part.Cables)
+				if objects > 1 {
+					assert.LessOrEqual(t, proto.Size(c.Part), test.maxBytes)
+				}
+			}
+
+			res, err := Receive(&chunkStream{chunks: chunks})
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(pt, res))
+		})
+	}
+}
+
+func TestSplitMergeConflicts(t *testing.T) {
+	pt := newTestTopology(10)
+	for i := 0; i < 100000; i++ {
+		pt.MergeConflicts = append(pt.MergeConflicts, &octopuspb.MergeConflict{
+			Entity:        "interface",
+			Key:           fmt.Sprintf("ccr%02d.dus01:et-0/0/%d", i%10, i),
+			Field:         "description",
+			Existing:      &octopuspb.Source{Connector: "NetBox", ObjectId: fmt.Sprint(i)},
+			ExistingValue: "Transit: Example Networks",
+			Incoming:      &octopuspb.Source{Connector: "File", ObjectId: "overlay.yaml"},
+			IncomingValue: "Peering: Example Networks",
+		})
+	}
+
+	// Larger than the maximum message size of gRPC (4 MiB) in one piece
+	assert.Greater(t, proto.Size(pt), 4<<20)
+
+	maxBytes := 1 << 20
+	chunks := Split(pt, maxBytes)
+	assert.Greater(t, len(chunks), 4)
+	assert.NotEmpty(t, chunks[0].Part.MergeConflicts, "conflicts start in the first chunk")
+	for _, c := range chunks {
+		assert.LessOrEqual(t, proto.Size(c.Part), maxBytes, "chunk %d", c.Index)
+	}
+
+	res, err := Receive(&chunkStream{chunks: chunks})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(pt, res))
+}
+
+func TestAssembler(t *testing.T) {
+	chunks := Split(newTestTopology(10), 256)
+
+	a := NewAssembler()
+	assert.Error(t, a.Add(chunks[1]))
+	assert.NoError(t, a.Add(chunks[0]))
+	_, err := a.Topology()
+	assert.Error(t, err)
+
+	other := proto.Clone(chunks[1]).(*octopuspb.TopologyChunk)
+	other.Generation = 43
+	assert.Error(t, a.Add(other))
+
+	for _, c := range chunks[1:] {
+		assert.NoError(t, a.Add(c))
+	}
+
+	assert.Error(t, a.Add(chunks[0]))
+	_, err = a.Topology()
+	assert.NoError(t, err)
+}
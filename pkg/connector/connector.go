@@ -15,7 +15,9 @@ import (
 
 // The Connector (think Tentacle of the Octopus) is the glue between any given data source and the Octopus.
 // It is responsible for getting the relevant data out of the data source and caching it internally for resilience.
-// The Octopus will periodically ask all the connectors to enrich a new topology with the current data set to for them full enriched topology.
+// The Octopus will periodically ask all the connectors to enrich a new (partial) topology each with the current data set, concurrently,
+// and merge them into the full enriched topology. By default data of later connectors takes precedence.
+// Additionally the Octopus rebuilds the topology shortly after any connector signals new data via its Changes channel.
 type Connector interface {
 	GetName() string                      // Who am I?
 	InitialLoad() error                   // Initial load of data
@@ -25,4 +27,12 @@ type Connector interface {
 	GetLoadDuration() time.Duration       // How long did the last data load take?
 	GetLoadTime() time.Time               // When was the current connector data loaded?
 	GetUpdateErrorCount() uint64          // The number of time the refresh of connector data has failed
+	Changes() <-chan struct{}             // Signals new data has been loaded
+	GetDataGeneration() uint64            // Increases whenever the connector data changes
+}
+
+// LastGoodEnricher is implemented by connectors which keep the data they loaded successfully last while unhealthy,
+// so optional connectors can still contribute it to the topology.
+type LastGoodEnricher interface {
+	EnrichTopologyLastGood(*model.Topology) error // Update the given Topology with the last good data, even if unhealthy
 }
@@ -0,0 +1,495 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/connector"
+	"github.com/cloudflare/octopus/pkg/model"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	connectorName = "File"
+	pollInterval  = time.Second * 10
+)
+
+// FileConnector reads YAML and JSON documents from a directory and overlays them onto the topology.
+// Attributes set in the documents override the ones of other connectors enriching the topology before.
+type FileConnector struct {
+	connectorMu       sync.RWMutex
+	dir               string
+	loadDuration      time.Duration
+	loadTime          time.Time
+	refreshErrorCount atomic.Uint64
+	dataGeneration    atomic.Uint64
+	changes           *connector.Notifier
+
+	// Fingerprint of the files we (tried to) load last, used to detect changes
+	fingerprint string
+	loadErr     error
+	documents   []*Document
+}
+
+func NewConnector(dir string) *FileConnector {
+	return &FileConnector{
+		dir:     dir,
+		changes: connector.NewNotifier(),
+	}
+}
+
+func (f *FileConnector) GetName() string {
+	return connectorName
+}
+
+func (f *FileConnector) InitialLoad() error {
+	_, err := f.update()
+	return err
+}
+
+// Healthy returns true if the documents have been loaded and the current files are valid
+func (f *FileConnector) Healthy() bool {
+	f.connectorMu.RLock()
+	defer f.connectorMu.RUnlock()
+
+	return f._healthy()
+}
+
+func (f *FileConnector) _healthy() bool {
+	return f.documents != nil && f.loadErr == nil
+}
+
+func (f *FileConnector) GetLoadDuration() time.Duration {
+	f.connectorMu.RLock()
+	defer f.connectorMu.RUnlock()
+
+	return f.loadDuration
+}
+
+func (f *FileConnector) GetLoadTime() time.Time {
+	f.connectorMu.RLock()
+	defer f.connectorMu.RUnlock()
+
+	return f.loadTime
+}
+
+func (f *FileConnector) GetUpdateErrorCount() uint64 {
+	return f.refreshErrorCount.Load()
+}
+
+// GetDataGeneration returns a number increased whenever changed documents have been loaded
+func (f *FileConnector) GetDataGeneration() uint64 {
+	return f.dataGeneration.Load()
+}
+
+func (f *FileConnector) Changes() <-chan struct{} {
+	return f.changes.C()
+}
+
+func (f *FileConnector) StartRefreshRoutine() {
+	go f.refreshRoutine()
+}
+
+func (f *FileConnector) refreshRoutine() {
+	ticker := time.NewTicker(pollInterval)
+	for {
+		<-ticker.C
+
+		changed, err := f.update()
+		if err != nil {
+			log.Errorf("Failed to refresh data from %q: %v", f.dir, err)
+			continue
+		}
+
+		if changed {
+			log.Infof("Successfully refreshed data from %q", f.dir)
+			f.changes.Notify()
+		}
+	}
+}
+
+// update loads all documents if any file in the directory changed since the last attempt
+func (f *FileConnector) update() (bool, error) {
+	startTime := time.Now()
+	files, fingerprint, err := f.listFiles()
+	if err != nil {
+		f.refreshErrorCount.Add(1)
+		return false, fmt.Errorf("failed to list files: %v", err)
+	}
+
+	f.connectorMu.RLock()
+	unchanged := f.fingerprint == fingerprint && (f.documents != nil || f.loadErr != nil)
+	loadErr := f.loadErr
+	f.connectorMu.RUnlock()
+	if unchanged {
+		// Files which failed to load are only retried once they change
+		return false, loadErr
+	}
+
+	documents := make([]*Document, 0, len(files))
+	for _, path := range files {
+		doc, err := readDocument(path)
+		if err != nil {
+			err = fmt.Errorf("%s: %v", path, err)
+			f.setLoadError(fingerprint, err)
+			return false, err
+		}
+
+		doc.Path, _ = filepath.Rel(f.dir, path)
+		documents = append(documents, doc)
+	}
+
+	f.connectorMu.Lock()
+	defer f.connectorMu.Unlock()
+
+	f.fingerprint = fingerprint
+	f.loadErr = nil
+	f.documents = documents
+	f.loadDuration = time.Since(startTime)
+	f.loadTime = time.Now()
+	f.dataGeneration.Add(1)
+
+	return true, nil
+}
+
+// setLoadError marks the connector unhealthy, keeping the previous documents
+func (f *FileConnector) setLoadError(fingerprint string, err error) {
+	f.connectorMu.Lock()
+	defer f.connectorMu.Unlock()
+
+	// Only count the error once per change of the files
+	if f.fingerprint != fingerprint || f.loadErr == nil {
+		f.refreshErrorCount.Add(1)
+	}
+
+	f.fingerprint = fingerprint
+	f.loadErr = err
+}
+
+// listFiles returns all YAML and JSON files in the directory and its sub directories, sorted by path, and a fingerprint of their names, sizes, and modification times
+func (f *FileConnector) listFiles() ([]string, string, error) {
+	files := make([]string, 0)
+	fingerprint := strings.Builder{}
+
+	err := filepath.WalkDir(f.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(d.Name(), ".") && path != f.dir {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() || !isDocument(path) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		files = append(files, path)
+		fmt.Fprintf(&fingerprint, "%s:%d:%d\n", path, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// WalkDir walks in lexical order already, so files and fingerprint are sorted
+	return files, fingerprint.String(), nil
+}
+
+func isDocument(path string) bool {
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+
+	return false
+}
+
+func readDocument(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{}
+	if filepath.Ext(path) == ".json" {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(doc)
+	} else {
+		// A file may hold several documents separated by ---, which are combined. An empty file is a valid, empty document.
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		for i := 0; ; i++ {
+			part := &Document{}
+			err = dec.Decode(part)
+			if errors.Is(err, io.EOF) {
+				err = nil
+				break
+			}
+
+			if err != nil {
+				err = fmt.Errorf("document %d: %v", i, err)
+				break
+			}
+
+			doc.add(part)
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse: %v", err)
+	}
+
+	err = doc.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid document: %v", err)
+	}
+
+	return doc, nil
+}
+
+func (f *FileConnector) EnrichTopology(t *model.Topology) error {
+	f.connectorMu.RLock()
+	defer f.connectorMu.RUnlock()
+
+	if !f._healthy() {
+		return fmt.Errorf("%s not healthy", connectorName)
+	}
+
+	return f._enrichTopology(t)
+}
+
+// EnrichTopologyLastGood overlays the documents loaded last, even if the current files are invalid
+func (f *FileConnector) EnrichTopologyLastGood(t *model.Topology) error {
+	f.connectorMu.RLock()
+	defer f.connectorMu.RUnlock()
+
+	if f.documents == nil {
+		return fmt.Errorf("%s has no documents loaded", connectorName)
+	}
+
+	return f._enrichTopology(t)
+}
+
+func (f *FileConnector) _enrichTopology(t *model.Topology) error {
+	// Prefixes are keyed by NetBox IDs in the topology, so we count downwards from -1 to not collide with them
+	prefixID := int64(-1)
+
+	for _, doc := range f.documents {
+		for _, d := range doc.Devices {
+			err := addDevice(t, d, doc.Path)
+			if err != nil {
+				return fmt.Errorf("failed to add device %q: %v", d.Name, err)
+			}
+		}
+
+		for _, c := range doc.Circuits {
+			addCircuit(t, c, doc.Path)
+		}
+
+		for _, c := range doc.Cables {
+			addCable(t, c, doc.Path)
+		}
+
+		for _, p := range doc.Prefixes {
+			// The prefix has been validated while loading the document
+			pfx, _ := bnet.PrefixFromString(p.Prefix)
+			mp := model.NewPrefix(*pfx)
+			mp.SourceID = doc.Path
+			mergeMetaData(mp.MetaData, p.MetaData)
+			t.Prefixes[prefixID] = mp
+			prefixID--
+		}
+	}
+
+	return nil
+}
+
+// addDevice adds the device to the topology, source being the path of the document it is defined in
+func addDevice(t *model.Topology, fd Device, source string) error {
+	d := t.AddDeviceIfNotExists(fd.Name)
+	d.SourceID = source
+	setIfNotEmpty(&d.Status, fd.Status)
+	setIfNotEmpty(&d.Role, fd.Role)
+	setIfNotEmpty(&d.Platform, fd.Platform)
+	setIfNotEmpty(&d.DeviceType, fd.DeviceType)
+
+	if fd.Site != "" {
+		d.Site = t.AddSiteIfNotExists(fd.Site)
+	}
+
+	if fd.Colo != nil {
+		d.Colo = t.AddColoIfNotExists(fd.Colo.ID, fd.Colo.Name, fd.Colo.Pop)
+	}
+
+	if d.MetaData == nil {
+		d.MetaData = model.NewMetaData()
+	}
+
+	mergeMetaData(d.MetaData, fd.MetaData)
+
+	for _, fIfa := range fd.Interfaces {
+		ifa := d.AddInterfaceItNotExists(fIfa.Name)
+		ifa.SourceID = source
+		setIfNotEmpty(&ifa.Type, fIfa.Type)
+		setIfNotEmpty(&ifa.LAGMemberOf, fIfa.LAGMemberOf)
+		if ifa.MetaData == nil {
+			ifa.MetaData = model.NewMetaData()
+		}
+
+		mergeMetaData(ifa.MetaData, fIfa.MetaData)
+
+		for _, fu := range fIfa.Units {
+			vt := model.NewVLANTag(fu.OuterTag, fu.InnerTag)
+			u := ifa.AddUnitIfNotExists(vt)
+			u.SourceID = source
+			if u.MetaData == nil {
+				u.MetaData = model.NewMetaData()
+			}
+
+			mergeMetaData(u.MetaData, fu.MetaData)
+
+			for _, addr := range fu.IPAddresses {
+				pfx, err := bnet.PrefixFromString(addr)
+				if err != nil {
+					return fmt.Errorf("failed to parse IP %q: %v", addr, err)
+				}
+
+				ifa.AddIPAddressIfNotExists(vt, model.NewIP(*pfx))
+			}
+		}
+	}
+
+	for _, rp := range fd.RearPorts {
+		d.RearPorts[rp.Name] = &model.RearPort{
+			Name:      rp.Name,
+			Positions: rp.Positions,
+		}
+	}
+
+	for _, fp := range fd.FrontPorts {
+		d.FrontPorts[fp.Name] = &model.FrontPort{
+			Name:             fp.Name,
+			RearPort:         fp.RearPort,
+			RearPortPosition: fp.RearPortPosition,
+		}
+	}
+
+	return nil
+}
+
+func addCircuit(t *model.Topology, fc Circuit, source string) {
+	c, exists := t.Circuits[fc.CID]
+	if !exists {
+		c = model.NewCircuit(fc.CID, fc.Provider, fc.Type, fc.Status)
+		t.Circuits[fc.CID] = c
+	}
+
+	c.SourceID = source
+	setIfNotEmpty(&c.Provider, fc.Provider)
+	setIfNotEmpty(&c.Type, fc.Type)
+	setIfNotEmpty(&c.Status, fc.Status)
+	if c.MetaData == nil {
+		c.MetaData = model.NewMetaData()
+	}
+
+	mergeMetaData(c.MetaData, fc.MetaData)
+}
+
+// addCable adds the given cable, replacing any cable connected to either of its ends
+func addCable(t *model.Topology, fc Cable, source string) {
+	c := &model.Cable{
+		AEnd:     fc.A.toModel(),
+		BEnd:     fc.B.toModel(),
+		SourceID: source,
+	}
+
+	for key, existing := range t.Cables {
+		if connectsAny(existing, c.AEnd, c.BEnd) {
+			delete(t.Cables, key)
+		}
+	}
+
+	t.Cables[c.String()] = c
+}
+
+func connectsAny(c *model.Cable, ends ...model.CableEnd) bool {
+	for _, ce := range ends {
+		if c.AEnd == ce || c.BEnd == ce {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ce CableEnd) toModel() model.CableEnd {
+	return model.CableEnd{
+		DeviceName:   ce.Device,
+		EndpointName: ce.Name,
+		EndpointType: cableEndpointTypes[ce.Type],
+	}
+}
+
+// mergeMetaData adds the tags not present yet and sets the semantic tags and custom field data of src on dst
+func mergeMetaData(dst *model.MetaData, src *MetaData) {
+	if src == nil {
+		return
+	}
+
+	for _, tag := range src.Tags {
+		found := false
+		for _, existing := range dst.Tags {
+			if existing == tag {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			dst.Tags = append(dst.Tags, tag)
+		}
+	}
+
+	for k, v := range src.SemanticTags {
+		dst.SemanticTags[k] = v
+	}
+
+	setIfNotEmpty(&dst.CustomFieldData, src.CustomFieldData)
+}
+
+func setIfNotEmpty(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
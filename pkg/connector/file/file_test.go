@@ -0,0 +1,247 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+const labYAML = `
+devices:
+  - name: ccr01.lab01
+    status: planned
+    role: ccr
+    site: LAB01
+    colo:
+      id: 1000
+      name: lab01
+      pop: lab-a
+    meta_data:
+      tags: ["lab"]
+      semantic_tags:
+        NET:ASN: "65000"
+    interfaces:
+      - name: et-0/0/0
+        lag_member_of: ae0
+      - name: ae0
+        type: lag
+        units:
+          - inner_tag: 100
+            ip_addresses: ["192.0.2.0/31", "2001:db8::/127"]
+  - name: ccr01.dus01
+    status: offline
+circuits:
+  - cid: LAB-1
+    provider: acme
+    status: planned
+cables:
+  - a: {device: ccr01.lab01, name: et-0/0/0, type: interface}
+    b: {device: LAB-1, name: A, type: circuit_termination}
+`
+
+const prefixesJSON = `{"prefixes": [{"prefix": "192.0.2.0/24", "meta_data": {"tags": ["lab"]}}]}`
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644)
+	assert.NoError(t, err)
+}
+
+func TestEnrichTopology(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "lab.yaml", labYAML)
+	writeFile(t, dir, "prefixes.json", prefixesJSON)
+	writeFile(t, dir, "README.md", "not a document")
+
+	f := NewConnector(dir)
+	assert.NoError(t, f.InitialLoad())
+	assert.True(t, f.Healthy())
+
+	// Data of a connector enriching the topology before
+	topology := model.NewTopology()
+	existing := topology.AddDeviceIfNotExists("ccr01.dus01")
+	existing.Status = "active"
+	existing.Role = "ccr"
+	existing.AddInterfaceItNotExists("et-0/0/0")
+	c := &model.Cable{
+		AEnd: model.CableEnd{DeviceName: "ccr01.dus01", EndpointName: "et-0/0/0", EndpointType: octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE},
+		BEnd: model.CableEnd{DeviceName: "LAB-1", EndpointName: "A", EndpointType: octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION},
+	}
+	topology.Cables[c.String()] = c
+
+	assert.NoError(t, f.EnrichTopology(topology))
+
+	lab := topology.GetDevice("ccr01.lab01")
+	assert.NotNil(t, lab)
+	assert.Equal(t, "planned", lab.Status)
+	assert.Equal(t, "LAB01", lab.Site.Name)
+	assert.Equal(t, "lab-a", lab.Colo.Pop.Name)
+	assert.Equal(t, []string{"lab"}, lab.MetaData.Tags)
+	assert.Equal(t, "65000", lab.MetaData.SemanticTags["NET:ASN"])
+	assert.Equal(t, "ae0", lab.GetInterface("et-0/0/0").LAGMemberOf)
+
+	u := lab.GetInterface("ae0").Units[model.NewVLANTag(0, 100)]
+	assert.Equal(t, 1, len(u.IPv4Addresses))
+	assert.Equal(t, 1, len(u.IPv6Addresses))
+
+	// Overrides keep the attributes not set in the document
+	assert.Equal(t, "offline", existing.Status)
+	assert.Equal(t, "ccr", existing.Role)
+
+	// The cable to the circuit termination replaces the existing one
+	assert.Equal(t, 1, len(topology.Cables))
+	for _, c := range topology.Cables {
+		assert.Equal(t, "ccr01.lab01", c.AEnd.DeviceName)
+	}
+
+	assert.Equal(t, "planned", topology.Circuits["LAB-1"].Status)
+	assert.Equal(t, []string{"lab"}, topology.Prefixes[-1].MetaData.Tags)
+}
+
+func TestUpdate(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "lab.yaml", labYAML)
+
+	f := NewConnector(dir)
+	assert.NoError(t, f.InitialLoad())
+	loadTime := f.GetLoadTime()
+
+	changed, err := f.update()
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	tests := []struct {
+		name     string
+		content  string
+		wantFail bool
+		errors   uint64
+	}{
+		{
+			name:     "unknown field",
+			content:  "devices:\n  - name: foo\n    colour: blue\n",
+			wantFail: true,
+			errors:   1,
+		},
+		{
+			name:     "invalid cable end",
+			content:  "cables:\n  - a: {device: foo, name: bar, type: console}\n    b: {device: foo, name: baz, type: interface}\n",
+			wantFail: true,
+			errors:   2,
+		},
+		{
+			name:     "invalid IP",
+			content:  "devices:\n  - name: foo\n    interfaces:\n      - name: et-0/0/0\n        units:\n          - ip_addresses: [\"192.0.2.300/31\"]\n",
+			wantFail: true,
+			errors:   3,
+		},
+		{
+			name:    "valid again",
+			content: "devices:\n  - name: foo\n",
+			errors:  3,
+		},
+	}
+
+	for _, test := range tests {
+		// Make sure the modification time changes even on coarse grained file systems
+		time.Sleep(10 * time.Millisecond)
+		writeFile(t, dir, "lab.yaml", test.content)
+
+		changed, err := f.update()
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			assert.False(t, f.Healthy(), test.name)
+
+			// The documents loaded before are still available as last good data
+			assert.Error(t, f.EnrichTopology(model.NewTopology()), test.name)
+			assert.NoError(t, f.EnrichTopologyLastGood(model.NewTopology()), test.name)
+
+			// Broken files are only counted once until they change
+			_, err = f.update()
+			assert.Error(t, err, test.name)
+		} else {
+			assert.NoError(t, err, test.name)
+			assert.True(t, changed, test.name)
+			assert.True(t, f.Healthy(), test.name)
+			assert.True(t, f.GetLoadTime().After(loadTime), test.name)
+		}
+
+		assert.Equal(t, test.errors, f.GetUpdateErrorCount(), test.name)
+	}
+}
+
+func TestReadDocument(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		wantFail        bool
+		expectedDevices []string
+		expectedCables  int
+	}{
+		{
+			name:            "empty",
+			content:         "",
+			expectedDevices: []string{},
+		},
+		{
+			name:            "only whitespace and comments",
+			content:         "\n# nothing yet\n",
+			expectedDevices: []string{},
+		},
+		{
+			name:            "single document",
+			content:         "devices:\n  - name: foo\n",
+			expectedDevices: []string{"foo"},
+		},
+		{
+			name:            "multiple documents",
+			content:         "devices:\n  - name: foo\n---\ndevices:\n  - name: bar\ncables:\n  - a: {device: foo, name: et-0/0/0, type: interface}\n    b: {device: bar, name: et-0/0/0, type: interface}\n",
+			expectedDevices: []string{"foo", "bar"},
+			expectedCables:  1,
+		},
+		{
+			name:            "empty documents",
+			content:         "---\n---\ndevices:\n  - name: foo\n---\n",
+			expectedDevices: []string{"foo"},
+		},
+		{
+			name:     "unknown field in later document",
+			content:  "devices:\n  - name: foo\n---\ndevices:\n  - name: bar\n    colour: blue\n",
+			wantFail: true,
+		},
+		{
+			name:     "invalid later document",
+			content:  "devices:\n  - name: foo\n---\ndevices:\n  - status: active\n",
+			wantFail: true,
+		},
+	}
+
+	dir := t.TempDir()
+	for _, test := range tests {
+		writeFile(t, dir, "lab.yaml", test.content)
+		doc, err := readDocument(filepath.Join(dir, "lab.yaml"))
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			continue
+		}
+
+		assert.NoError(t, err, test.name)
+		names := make([]string, 0, len(doc.Devices))
+		for _, d := range doc.Devices {
+			names = append(names, d.Name)
+		}
+
+		assert.Equal(t, test.expectedDevices, names, test.name)
+		assert.Equal(t, test.expectedCables, len(doc.Cables), test.name)
+	}
+}
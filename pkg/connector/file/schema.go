@@ -0,0 +1,213 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package file
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/octopus/proto/octopus"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+)
+
+// Document is the content of one YAML or JSON file
+type Document struct {
+	Devices  []Device  `yaml:"devices" json:"devices"`
+	Cables   []Cable   `yaml:"cables" json:"cables"`
+	Circuits []Circuit `yaml:"circuits" json:"circuits"`
+	Prefixes []Prefix  `yaml:"prefixes" json:"prefixes"`
+
+	// Path of the document relative to the directory, used as source of the objects defined in it
+	Path string `yaml:"-" json:"-"`
+}
+
+// Device adds a device or overrides the attributes set of an existing one
+type Device struct {
+	Name       string      `yaml:"name" json:"name"`
+	Status     string      `yaml:"status" json:"status"`
+	Role       string      `yaml:"role" json:"role"`
+	Platform   string      `yaml:"platform" json:"platform"`
+	DeviceType string      `yaml:"device_type" json:"device_type"`
+	Site       string      `yaml:"site" json:"site"`
+	Colo       *Colo       `yaml:"colo" json:"colo"`
+	Interfaces []Interface `yaml:"interfaces" json:"interfaces"`
+	FrontPorts []FrontPort `yaml:"front_ports" json:"front_ports"`
+	RearPorts  []RearPort  `yaml:"rear_ports" json:"rear_ports"`
+	MetaData   *MetaData   `yaml:"meta_data" json:"meta_data"`
+}
+
+type Colo struct {
+	ID   uint16 `yaml:"id" json:"id"`
+	Name string `yaml:"name" json:"name"`
+	Pop  string `yaml:"pop" json:"pop"`
+}
+
+type Interface struct {
+	Name        string    `yaml:"name" json:"name"`
+	Type        string    `yaml:"type" json:"type"`
+	LAGMemberOf string    `yaml:"lag_member_of" json:"lag_member_of"`
+	Units       []Unit    `yaml:"units" json:"units"`
+	MetaData    *MetaData `yaml:"meta_data" json:"meta_data"`
+}
+
+type Unit struct {
+	OuterTag    uint16    `yaml:"outer_tag" json:"outer_tag"`
+	InnerTag    uint16    `yaml:"inner_tag" json:"inner_tag"`
+	IPAddresses []string  `yaml:"ip_addresses" json:"ip_addresses"`
+	MetaData    *MetaData `yaml:"meta_data" json:"meta_data"`
+}
+
+type FrontPort struct {
+	Name             string `yaml:"name" json:"name"`
+	RearPort         string `yaml:"rear_port" json:"rear_port"`
+	RearPortPosition uint32 `yaml:"rear_port_position" json:"rear_port_position"`
+}
+
+type RearPort struct {
+	Name      string `yaml:"name" json:"name"`
+	Positions int16  `yaml:"positions" json:"positions"`
+}
+
+type Cable struct {
+	A CableEnd `yaml:"a" json:"a"`
+	B CableEnd `yaml:"b" json:"b"`
+}
+
+// CableEnd refers to a device and one of its interfaces or ports, or to a circuit and its A or Z termination
+type CableEnd struct {
+	Device string `yaml:"device" json:"device"`
+	Name   string `yaml:"name" json:"name"`
+	Type   string `yaml:"type" json:"type"`
+}
+
+type Circuit struct {
+	CID      string    `yaml:"cid" json:"cid"`
+	Provider string    `yaml:"provider" json:"provider"`
+	Type     string    `yaml:"type" json:"type"`
+	Status   string    `yaml:"status" json:"status"`
+	MetaData *MetaData `yaml:"meta_data" json:"meta_data"`
+}
+
+type Prefix struct {
+	Prefix   string    `yaml:"prefix" json:"prefix"`
+	MetaData *MetaData `yaml:"meta_data" json:"meta_data"`
+}
+
+type MetaData struct {
+	Tags            []string          `yaml:"tags" json:"tags"`
+	SemanticTags    map[string]string `yaml:"semantic_tags" json:"semantic_tags"`
+	CustomFieldData string            `yaml:"custom_field_data" json:"custom_field_data"`
+}
+
+var cableEndpointTypes = map[string]octopus.CableEndpointType{
+	"interface":           octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE,
+	"front_port":          octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT,
+	"rear_port":           octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT,
+	"circuit_termination": octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION,
+}
+
+// add appends the objects of other, e.g. of another YAML document in the same file
+func (doc *Document) add(other *Document) {
+	doc.Devices = append(doc.Devices, other.Devices...)
+	doc.Cables = append(doc.Cables, other.Cables...)
+	doc.Circuits = append(doc.Circuits, other.Circuits...)
+	doc.Prefixes = append(doc.Prefixes, other.Prefixes...)
+}
+
+// Validate checks the document for missing names and invalid values
+func (doc *Document) Validate() error {
+	for i, d := range doc.Devices {
+		err := d.validate()
+		if err != nil {
+			return fmt.Errorf("device %d (%q): %v", i, d.Name, err)
+		}
+	}
+
+	for i, c := range doc.Cables {
+		for _, ce := range []CableEnd{c.A, c.B} {
+			err := ce.validate()
+			if err != nil {
+				return fmt.Errorf("cable %d: %v", i, err)
+			}
+		}
+	}
+
+	for i, c := range doc.Circuits {
+		if c.CID == "" {
+			return fmt.Errorf("circuit %d: cid missing", i)
+		}
+	}
+
+	for i, p := range doc.Prefixes {
+		pfx, err := bnet.PrefixFromString(p.Prefix)
+		if err != nil {
+			return fmt.Errorf("prefix %d: failed to parse %q: %v", i, p.Prefix, err)
+		}
+
+		if !pfx.Valid() {
+			return fmt.Errorf("prefix %d: %q has host bits set", i, p.Prefix)
+		}
+	}
+
+	return nil
+}
+
+func (d *Device) validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("name missing")
+	}
+
+	if d.Colo != nil && (d.Colo.ID == 0 || d.Colo.Name == "" || d.Colo.Pop == "") {
+		return fmt.Errorf("colo needs id, name, and pop")
+	}
+
+	for _, ifa := range d.Interfaces {
+		if ifa.Name == "" {
+			return fmt.Errorf("interface name missing")
+		}
+
+		for _, u := range ifa.Units {
+			for _, addr := range u.IPAddresses {
+				_, err := bnet.PrefixFromString(addr)
+				if err != nil {
+					return fmt.Errorf("interface %q: failed to parse IP %q: %v", ifa.Name, addr, err)
+				}
+			}
+		}
+	}
+
+	for _, fp := range d.FrontPorts {
+		if fp.Name == "" || fp.RearPort == "" {
+			return fmt.Errorf("front port needs name and rear_port")
+		}
+	}
+
+	for _, rp := range d.RearPorts {
+		if rp.Name == "" {
+			return fmt.Errorf("rear port name missing")
+		}
+	}
+
+	return nil
+}
+
+func (ce *CableEnd) validate() error {
+	if ce.Device == "" || ce.Name == "" {
+		return fmt.Errorf("cable end needs device and name")
+	}
+
+	if _, exists := cableEndpointTypes[ce.Type]; !exists {
+		return fmt.Errorf("unknown cable end type %q", ce.Type)
+	}
+
+	if ce.Type == "circuit_termination" && ce.Name != "A" && ce.Name != "Z" {
+		return fmt.Errorf("circuit termination has to be A or Z, got %q", ce.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package mock
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/connector"
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/cloudflare/octopus/proto/octopus"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	connectorName = "Mock"
+	MaxScale      = math.MaxUint16
+)
+
+// Codes used to derive site, colo, and pop names. Sites beyond the length of this list get a higher number suffix.
+var siteCodes = []string{"dus", "ams", "fra", "lhr", "cdg", "pad", "sjc", "iad", "sin", "nrt"}
+
+// MockConnector generates a synthetic topology of scale sites connected in a ring by circuits.
+// Each site has two core routers bundled by a LAG, two edge routers, and a patch panel the circuits are connected to.
+type MockConnector struct {
+	connectorMu  sync.RWMutex
+	scale        uint
+	loaded       bool
+	loadDuration time.Duration
+	loadTime     time.Time
+	changes      *connector.Notifier
+}
+
+func NewConnector(scale uint) *MockConnector {
+	return &MockConnector{
+		scale:   scale,
+		changes: connector.NewNotifier(),
+	}
+}
+
+func (m *MockConnector) GetName() string {
+	return connectorName
+}
+
+func (m *MockConnector) InitialLoad() error {
+	if m.scale == 0 || m.scale > MaxScale {
+		return fmt.Errorf("scale has to be between 1 and %d", MaxScale)
+	}
+
+	startTime := time.Now()
+
+	m.connectorMu.Lock()
+	defer m.connectorMu.Unlock()
+
+	m.loaded = true
+	m.loadDuration = time.Since(startTime)
+	m.loadTime = time.Now()
+
+	return nil
+}
+
+func (m *MockConnector) Healthy() bool {
+	m.connectorMu.RLock()
+	defer m.connectorMu.RUnlock()
+
+	return m.loaded
+}
+
+// StartRefreshRoutine is a no-op as the mock data never changes
+func (m *MockConnector) StartRefreshRoutine() {
+	log.Infof("%s connector data is static, not starting refresh routine", connectorName)
+}
+
+func (m *MockConnector) GetLoadDuration() time.Duration {
+	m.connectorMu.RLock()
+	defer m.connectorMu.RUnlock()
+
+	return m.loadDuration
+}
+
+func (m *MockConnector) GetLoadTime() time.Time {
+	m.connectorMu.RLock()
+	defer m.connectorMu.RUnlock()
+
+	return m.loadTime
+}
+
+func (m *MockConnector) GetUpdateErrorCount() uint64 {
+	return 0
+}
+
+// GetDataGeneration is constant as the mock data never changes
+func (m *MockConnector) GetDataGeneration() uint64 {
+	return 1
+}
+
+// Changes never fires as the mock data never changes
+func (m *MockConnector) Changes() <-chan struct{} {
+	return m.changes.C()
+}
+
+func (m *MockConnector) EnrichTopology(t *model.Topology) error {
+	if !m.Healthy() {
+		return fmt.Errorf("%s not healthy", connectorName)
+	}
+
+	addAggregates(t)
+	for i := uint(0); i < m.scale; i++ {
+		addSite(t, i, (i+1)%m.scale)
+	}
+
+	return nil
+}
+
+func siteName(i uint) string {
+	return fmt.Sprintf("%s%02d", siteCodes[i%uint(len(siteCodes))], i/uint(len(siteCodes))+1)
+}
+
+func addAggregates(t *model.Topology) {
+	addPrefix(t, 0, bnet.NewPfx(bnet.IPv4FromOctets(10, 0, 0, 0), 8), "aggregate")
+	addPrefix(t, 1, bnet.NewPfx(bnet.IPv6FromBlocks(0x2001, 0xdb8, 0, 0, 0, 0, 0, 0), 32), "aggregate")
+}
+
+func addPrefix(t *model.Topology, id int64, pfx bnet.Prefix, role string) {
+	p := model.NewPrefix(pfx)
+	p.MetaData.SemanticTags["PREFIX:ROLE"] = role
+	t.Prefixes[id] = p
+}
+
+// addSite adds the devices of site i and the circuit towards site next
+func addSite(t *model.Topology, i uint, next uint) {
+	name := siteName(i)
+	colo := t.AddColoIfNotExists(uint16(i+1), name, name+"-a")
+	colo.Status = "active"
+	colo.Region = "mock"
+	colo.Tier = uint8(i%3 + 1)
+
+	site := t.AddSiteIfNotExists(strings.ToUpper(name))
+	site.Colos = append(site.Colos, colo)
+	colo.Sites = append(colo.Sites, site)
+
+	// 10.<i / 256>.<i % 256>.0/24 and 2001:db8:<i>::/48 per site
+	v4Base := bnet.IPv4FromOctets(10, uint8(i>>8), uint8(i), 0)
+	v6Base := bnet.IPv6FromBlocks(0x2001, 0xdb8, uint16(i), 0, 0, 0, 0, 0)
+	addPrefix(t, int64(2*i+2), bnet.NewPfx(v4Base, 24), "infrastructure")
+	addPrefix(t, int64(2*i+3), bnet.NewPfx(v6Base, 48), "infrastructure")
+
+	ccrs := make([]*model.Device, 2)
+	edges := make([]*model.Device, 2)
+	for n := 0; n < 2; n++ {
+		ccrs[n] = addDevice(t, fmt.Sprintf("ccr%02d.%s", n+1, name), "ccr", "mx10003", colo, site)
+		edges[n] = addDevice(t, fmt.Sprintf("edge%02d.%s", n+1, name), "edge", "qfx5120", colo, site)
+		edges[n].MetaData.SemanticTags["NET:ASN"] = "13335"
+
+		for port := 0; port < 5; port++ {
+			ifa := ccrs[n].AddInterfaceItNotExists(fmt.Sprintf("et-0/0/%d", port))
+			ifa.Type = "100gbase-x-qsfp28"
+		}
+
+		edgeIfa := edges[n].AddInterfaceItNotExists("et-0/0/2")
+		edgeIfa.Type = "100gbase-x-qsfp28"
+	}
+
+	// Core routers are connected by a LAG of two members
+	for n := 0; n < 2; n++ {
+		lag := ccrs[n].AddInterfaceItNotExists("ae0")
+		lag.Type = "lag"
+		lag.MetaData.Tags = []string{"backbone"}
+		lag.AddIPAddressIfNotExists(model.NewVLANTag(0, 0), model.NewIP(bnet.NewPfx(offset(v4Base, n), 31)))
+		lag.AddIPAddressIfNotExists(model.NewVLANTag(0, 0), model.NewIP(bnet.NewPfx(offset(v6Base, n), 127)))
+
+		ccrs[n].GetInterface("et-0/0/0").LAGMemberOf = "ae0"
+		ccrs[n].GetInterface("et-0/0/1").LAGMemberOf = "ae0"
+	}
+
+	for _, ifName := range []string{"et-0/0/0", "et-0/0/1"} {
+		addCable(t, interfaceEnd(ccrs[0], ifName), interfaceEnd(ccrs[1], ifName))
+	}
+
+	// Each core router connects to its edge router with two VLAN sub-interfaces
+	for n := 0; n < 2; n++ {
+		ccrIfa := ccrs[n].GetInterface("et-0/0/2")
+		edgeIfa := edges[n].GetInterface("et-0/0/2")
+
+		addUnit(ccrIfa, 100, offset(v4Base, 2+2*n), offset(v6Base, 2+2*n), "transit")
+		addUnit(edgeIfa, 100, offset(v4Base, 3+2*n), offset(v6Base, 3+2*n), "transit")
+		addUnit(ccrIfa, 200, offset(v4Base, 6+2*n), offset(v6Base, 6+2*n), "peering")
+		addUnit(edgeIfa, 200, offset(v4Base, 7+2*n), offset(v6Base, 7+2*n), "peering")
+
+		addCable(t, interfaceEnd(ccrs[n], "et-0/0/2"), interfaceEnd(edges[n], "et-0/0/2"))
+	}
+
+	// The patch panel connects ccr01 to the circuits towards the next (east) and previous (west) site
+	pp := addDevice(t, "pp01."+name, "patch-panel", "fiber-panel-24", colo, site)
+	for port, rpName := range []string{"R1", "R2"} {
+		pp.RearPorts[rpName] = &model.RearPort{Name: rpName, Positions: 1}
+		pp.FrontPorts[fmt.Sprint(port+1)] = &model.FrontPort{Name: fmt.Sprint(port + 1), RearPort: rpName, RearPortPosition: 1}
+	}
+
+	addCable(t, interfaceEnd(ccrs[0], "et-0/0/3"), portEnd(pp, "1", octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT))
+	addCable(t, interfaceEnd(ccrs[0], "et-0/0/4"), portEnd(pp, "2", octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT))
+
+	cid := fmt.Sprintf("CKT-%s-%s", strings.ToUpper(name), strings.ToUpper(siteName(next)))
+	ckt := model.NewCircuit(cid, "acme", "dark-fiber", "active")
+	t.Circuits[cid] = ckt
+
+	nextPP := t.AddDeviceIfNotExists("pp01." + siteName(next))
+	addCable(t, portEnd(pp, "R1", octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT), circuitEnd(cid, "A"))
+	addCable(t, circuitEnd(cid, "Z"), portEnd(nextPP, "R2", octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT))
+}
+
+func addDevice(t *model.Topology, name string, role string, deviceType string, colo *model.Colo, site *model.Site) *model.Device {
+	d := t.AddDeviceIfNotExists(name)
+	d.Status = "active"
+	d.Role = role
+	d.DeviceType = deviceType
+	d.Colo = colo
+	d.Site = site
+
+	if role != "patch-panel" {
+		d.Platform = "junos"
+	}
+
+	return d
+}
+
+func addUnit(ifa *model.Interface, vlan uint16, v4 bnet.IP, v6 bnet.IP, role string) {
+	vt := model.NewVLANTag(0, vlan)
+	u := ifa.AddUnitIfNotExists(vt)
+	u.MetaData.SemanticTags["UNIT:ROLE"] = role
+	ifa.AddIPAddressIfNotExists(vt, model.NewIP(bnet.NewPfx(v4, 31)))
+	ifa.AddIPAddressIfNotExists(vt, model.NewIP(bnet.NewPfx(v6, 127)))
+}
+
+// offset returns the address n addresses after base
+func offset(base bnet.IP, n int) bnet.IP {
+	for i := 0; i < n; i++ {
+		base = base.Next()
+	}
+
+	return base
+}
+
+func addCable(t *model.Topology, a model.CableEnd, b model.CableEnd) {
+	c := &model.Cable{
+		AEnd: a,
+		BEnd: b,
+	}
+
+	t.Cables[c.String()] = c
+}
+
+func interfaceEnd(d *model.Device, ifName string) model.CableEnd {
+	return portEnd(d, ifName, octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE)
+}
+
+func portEnd(d *model.Device, name string, endpointType octopus.CableEndpointType) model.CableEnd {
+	return model.CableEnd{
+		DeviceName:   d.Name,
+		EndpointName: name,
+		EndpointType: endpointType,
+	}
+}
+
+func circuitEnd(cid string, name string) model.CableEnd {
+	return model.CableEnd{
+		DeviceName:   cid,
+		EndpointName: name,
+		EndpointType: octopus.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION,
+	}
+}
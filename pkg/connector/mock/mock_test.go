@@ -0,0 +1,93 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package mock
+
+import (
+	"testing"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnrichTopology(t *testing.T) {
+	tests := []struct {
+		name         string
+		scale        uint
+		wantFail     bool
+		devices      int
+		circuits     int
+		logicalLinks int
+	}{
+		{
+			name:     "zero scale",
+			scale:    0,
+			wantFail: true,
+		},
+		{
+			name:  "single site",
+			scale: 1,
+			// 2 CCRs, 2 edges, 1 patch panel
+			devices:  5,
+			circuits: 1,
+			// 2 LAG members, 2 CCR<->edge, 1 ring
+			logicalLinks: 5,
+		},
+		{
+			name:         "beyond site codes",
+			scale:        12,
+			devices:      60,
+			circuits:     12,
+			logicalLinks: 60,
+		},
+	}
+
+	for _, test := range tests {
+		m := NewConnector(test.scale)
+		err := m.InitialLoad()
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			assert.False(t, m.Healthy(), test.name)
+			continue
+		}
+
+		assert.NoError(t, err, test.name)
+		assert.True(t, m.Healthy(), test.name)
+
+		topology := model.NewTopology()
+		err = m.EnrichTopology(topology)
+		assert.NoError(t, err, test.name)
+
+		topology.ComputeLogicalLinks()
+		assert.Equal(t, test.devices, len(topology.Nodes), test.name)
+		assert.Equal(t, test.circuits, len(topology.Circuits), test.name)
+		assert.Equal(t, test.logicalLinks, len(topology.LogicalLinks), test.name)
+		assert.Equal(t, int(test.scale), len(topology.Colos), test.name)
+		assert.Equal(t, 2*int(test.scale)+2, len(topology.Prefixes), test.name)
+	}
+}
+
+func TestRingPath(t *testing.T) {
+	m := NewConnector(3)
+	assert.NoError(t, m.InitialLoad())
+
+	topology := model.NewTopology()
+	assert.NoError(t, m.EnrichTopology(topology))
+
+	p, err := topology.TracePath("ccr01.dus01", "et-0/0/3")
+	assert.NoError(t, err)
+	assert.True(t, p.Complete)
+	assert.Equal(t, "ccr01.ams01", p.Destination.DeviceName)
+	assert.Equal(t, "et-0/0/4", p.Destination.EndpointName)
+
+	topology.IndexIPs()
+	res, err := topology.LookupIP(offset(topology.Prefixes[4].Prefix.Addr(), 3))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Owners))
+	assert.Equal(t, "edge01.ams01", res.Owners[0].DeviceName)
+	assert.Equal(t, 2, len(res.Prefixes))
+}
@@ -0,0 +1,598 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package netbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/connector/netbox/model"
+	"github.com/cloudflare/octopus/pkg/utils"
+)
+
+const apiPageSize = 1000
+
+// The REST API refers to object types by name instead of Django content type IDs, so we assign our own IDs
+const (
+	apiContentTypeDcimInterface int32 = iota + 1
+	apiContentTypeCircuitsCircuittermination
+	apiContentTypeDcimFrontPort
+	apiContentTypeDcimRearPort
+)
+
+var apiContentTypes = map[string]int32{
+	"dcim.interface":              apiContentTypeDcimInterface,
+	"circuits.circuittermination": apiContentTypeCircuitsCircuittermination,
+	"dcim.frontport":              apiContentTypeDcimFrontPort,
+	"dcim.rearport":               apiContentTypeDcimRearPort,
+}
+
+// NetboxAPIClient reads the NetBox data via the REST API, producing the same structures as the NetboxClient reading the DB
+type NetboxAPIClient struct {
+	baseURL string
+	token   string
+}
+
+func newAPIClient(baseURL string, token string) *NetboxAPIClient {
+	return &NetboxAPIClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+	}
+}
+
+type apiPage struct {
+	Next    *string           `json:"next"`
+	Results []json.RawMessage `json:"results"`
+}
+
+type apiRef struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type apiChoice struct {
+	Value string `json:"value"`
+}
+
+type apiTag struct {
+	Name string `json:"name"`
+}
+
+type apiDeviceType struct {
+	ID    int64  `json:"id"`
+	Model string `json:"model"`
+	Slug  string `json:"slug"`
+}
+
+type apiDevice struct {
+	ID          int64         `json:"id"`
+	Name        string        `json:"name"`
+	Serial      string        `json:"serial"`
+	Status      apiChoice     `json:"status"`
+	Role        *apiRef       `json:"role"`
+	DeviceRole  *apiRef       `json:"device_role"`
+	DeviceType  apiDeviceType `json:"device_type"`
+	Platform    *apiRef       `json:"platform"`
+	Site        apiRef        `json:"site"`
+	Tags        []apiTag      `json:"tags"`
+	AssetTag    string        `json:"asset_tag"`
+	Rack        *apiRef       `json:"rack"`
+	Location    *apiRef       `json:"location"`
+	Tenant      *apiRef       `json:"tenant"`
+	PrimaryIP4  *apiRef       `json:"primary_ip4"`
+	PrimaryIP6  *apiRef       `json:"primary_ip6"`
+	Position    float64       `json:"position"`
+	Comments    string        `json:"comments"`
+	LastUpdated time.Time     `json:"last_updated"`
+}
+
+type apiInterface struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Type        apiChoice `json:"type"`
+	MgmtOnly    bool      `json:"mgmt_only"`
+	Device      apiRef    `json:"device"`
+	MacAddress  string    `json:"mac_address"`
+	LAG         *apiRef   `json:"lag"`
+	Cable       *apiRef   `json:"cable"`
+	Parent      *apiRef   `json:"parent"`
+	Speed       int32     `json:"speed"`
+	Tags        []apiTag  `json:"tags"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+type apiIPAddress struct {
+	ID                 int64           `json:"id"`
+	Address            string          `json:"address"`
+	AssignedObjectType string          `json:"assigned_object_type"`
+	AssignedObjectID   int64           `json:"assigned_object_id"`
+	CustomFields       json.RawMessage `json:"custom_fields"`
+	LastUpdated        time.Time       `json:"last_updated"`
+}
+
+type apiCableTermination struct {
+	ObjectType string `json:"object_type"`
+	ObjectID   int64  `json:"object_id"`
+}
+
+type apiCable struct {
+	ID            int64                 `json:"id"`
+	Type          string                `json:"type"`
+	Status        apiChoice             `json:"status"`
+	Tenant        *apiRef               `json:"tenant"`
+	ATerminations []apiCableTermination `json:"a_terminations"`
+	BTerminations []apiCableTermination `json:"b_terminations"`
+	LastUpdated   time.Time             `json:"last_updated"`
+}
+
+type apiPrefix struct {
+	ID          int64     `json:"id"`
+	Prefix      string    `json:"prefix"`
+	Tags        []apiTag  `json:"tags"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+type apiCircuit struct {
+	ID           int64     `json:"id"`
+	Cid          string    `json:"cid"`
+	Provider     apiRef    `json:"provider"`
+	Type         apiRef    `json:"type"`
+	Status       apiChoice `json:"status"`
+	Tenant       *apiRef   `json:"tenant"`
+	TerminationA *apiRef   `json:"termination_a"`
+	TerminationZ *apiRef   `json:"termination_z"`
+	Tags         []apiTag  `json:"tags"`
+	LastUpdated  time.Time `json:"last_updated"`
+}
+
+type apiCircuitTermination struct {
+	ID          int64     `json:"id"`
+	Circuit     apiRef    `json:"circuit"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+type apiFrontPort struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`
+	Device           apiRef    `json:"device"`
+	RearPort         apiRef    `json:"rear_port"`
+	RearPortPosition int16     `json:"rear_port_position"`
+	LastUpdated      time.Time `json:"last_updated"`
+}
+
+type apiObjectChange struct {
+	ID                int64     `json:"id"`
+	Time              time.Time `json:"time"`
+	Action            apiChoice `json:"action"`
+	ChangedObjectType string    `json:"changed_object_type"`
+	ChangedObjectID   int64     `json:"changed_object_id"`
+}
+
+type apiRearPort struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Type        apiChoice `json:"type"`
+	Positions   int16     `json:"positions"`
+	Device      apiRef    `json:"device"`
+	LastUpdated time.Time `json:"last_updated"`
+}
+
+// Connect checks the API is reachable and the token is valid
+func (c *NetboxAPIClient) Connect() error {
+	_, err := c.fetch(c.baseURL + "/api/status/")
+	if err != nil {
+		return fmt.Errorf("failed to query status: %v", err)
+	}
+
+	return nil
+}
+
+func (c *NetboxAPIClient) GetDBHost() string {
+	return c.baseURL
+}
+
+func (c *NetboxAPIClient) fetch(url string) ([]byte, error) {
+	return utils.FetchHTTPWithHeaders(url, map[string]string{
+		"Authorization": "Token " + c.token,
+		"Accept":        "application/json",
+	})
+}
+
+// getAll fetches all pages of the given API endpoint and decodes each result into a new T.
+// If since is not zero only objects updated at or after since are fetched.
+func getAll[T any](c *NetboxAPIClient, path string, since time.Time) ([]*T, error) {
+	params := url.Values{}
+	if !since.IsZero() {
+		params.Set("last_updated__gte", since.UTC().Format(time.RFC3339))
+	}
+
+	return getAllWithParams[T](c, path, params)
+}
+
+// getAllWithParams fetches all pages of the given API endpoint using the given query parameters
+func getAllWithParams[T any](c *NetboxAPIClient, path string, params url.Values) ([]*T, error) {
+	params.Set("limit", fmt.Sprint(apiPageSize))
+	next := c.baseURL + path + "?" + params.Encode()
+
+	res := make([]*T, 0)
+	for next != "" {
+		data, err := c.fetch(next)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %q: %v", next, err)
+		}
+
+		page := apiPage{}
+		err = json.Unmarshal(data, &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode page %q: %v", next, err)
+		}
+
+		for _, raw := range page.Results {
+			obj := new(T)
+			err = json.Unmarshal(raw, obj)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode result of %q: %v", next, err)
+			}
+
+			res = append(res, obj)
+		}
+
+		next = ""
+		if page.Next != nil {
+			next = *page.Next
+		}
+	}
+
+	return res, nil
+}
+
+func (c *NetboxAPIClient) GetDevices(since time.Time) ([]*model.DcimDevice, error) {
+	apiDevices, err := getAll[apiDevice](c, "/api/dcim/devices/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get devices: %v", err)
+	}
+
+	devices := make([]*model.DcimDevice, 0, len(apiDevices))
+	for _, d := range apiDevices {
+		// NetBox < 4.0 calls the role device_role
+		role := d.Role
+		if role == nil {
+			role = d.DeviceRole
+		}
+
+		dev := &model.DcimDevice{
+			ID:           d.ID,
+			LastUpdated:  d.LastUpdated,
+			Name:         d.Name,
+			Serial:       d.Serial,
+			Position:     d.Position,
+			Status:       d.Status.Value,
+			Comments:     d.Comments,
+			DeviceTypeID: d.DeviceType.ID,
+			PlatformID:   refID(d.Platform),
+			RackID:       refID(d.Rack),
+			PrimaryIp4ID: refID(d.PrimaryIP4),
+			PrimaryIp6ID: refID(d.PrimaryIP6),
+			TenantID:     refID(d.Tenant),
+			AssetTag:     d.AssetTag,
+			SiteID:       d.Site.ID,
+			LocationID:   refID(d.Location),
+			DeviceType: model.DcimDevicetype{
+				ID:    d.DeviceType.ID,
+				Model: d.DeviceType.Model,
+				Slug:  d.DeviceType.Slug,
+			},
+			Site: model.DcimSite{
+				ID:   d.Site.ID,
+				Name: d.Site.Name,
+				Slug: d.Site.Slug,
+			},
+			Tags: tagNames(d.Tags),
+		}
+
+		if role != nil {
+			dev.RoleID = role.ID
+			dev.DeviceRole = model.DcimDevicerole{
+				ID:   role.ID,
+				Name: role.Name,
+				Slug: role.Slug,
+			}
+		}
+
+		devices = append(devices, dev)
+	}
+
+	return devices, nil
+}
+
+func (c *NetboxAPIClient) GetInterfaces(since time.Time) (map[int64]*model.DcimInterface, error) {
+	apiInterfaces, err := getAll[apiInterface](c, "/api/dcim/interfaces/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get interfaces: %v", err)
+	}
+
+	interfaces := make(map[int64]*model.DcimInterface, len(apiInterfaces))
+	for _, ifa := range apiInterfaces {
+		interfaces[ifa.ID] = &model.DcimInterface{
+			ID:          ifa.ID,
+			LastUpdated: ifa.LastUpdated,
+			Name:        ifa.Name,
+			Type:        ifa.Type.Value,
+			MgmtOnly:    ifa.MgmtOnly,
+			DeviceID:    ifa.Device.ID,
+			MacAddress:  ifa.MacAddress,
+			LagID:       refID(ifa.LAG),
+			CableID:     refID(ifa.Cable),
+			ParentID:    refID(ifa.Parent),
+			Speed:       ifa.Speed,
+			Parent:      interfaceRef(ifa.Parent),
+			Device: model.DcimDevice{
+				ID:   ifa.Device.ID,
+				Name: ifa.Device.Name,
+			},
+			LAG:  interfaceRef(ifa.LAG),
+			Tags: tagNames(ifa.Tags),
+		}
+	}
+
+	return interfaces, nil
+}
+
+func (c *NetboxAPIClient) GetIPAddresses(since time.Time) ([]*model.IpamIpaddress, error) {
+	apiAddrs, err := getAll[apiIPAddress](c, "/api/ipam/ip-addresses/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get ip addresses: %v", err)
+	}
+
+	addrs := make([]*model.IpamIpaddress, 0, len(apiAddrs))
+	for _, a := range apiAddrs {
+		addrs = append(addrs, &model.IpamIpaddress{
+			ID:                   a.ID,
+			LastUpdated:          a.LastUpdated,
+			Address:              a.Address,
+			AssignedObjectID:     a.AssignedObjectID,
+			AssignedObjectTypeID: apiContentTypes[a.AssignedObjectType],
+			CustomFieldData:      customFieldData(a.CustomFields),
+		})
+	}
+
+	return addrs, nil
+}
+
+func (c *NetboxAPIClient) GetCables(since time.Time) ([]*model.DcimCable, error) {
+	apiCables, err := getAll[apiCable](c, "/api/dcim/cables/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get cables: %v", err)
+	}
+
+	cables := make([]*model.DcimCable, 0, len(apiCables))
+	for _, ac := range apiCables {
+		cable := &model.DcimCable{
+			ID:           ac.ID,
+			LastUpdated:  ac.LastUpdated,
+			Type:         ac.Type,
+			Status:       ac.Status.Value,
+			TenantID:     refID(ac.Tenant),
+			Terminations: make([]*model.DcimCabletermination, 0, len(ac.ATerminations)+len(ac.BTerminations)),
+		}
+
+		for _, end := range []struct {
+			name         string
+			terminations []apiCableTermination
+		}{
+			{name: "A", terminations: ac.ATerminations},
+			{name: "B", terminations: ac.BTerminations},
+		} {
+			for _, t := range end.terminations {
+				cable.Terminations = append(cable.Terminations, &model.DcimCabletermination{
+					CableEnd:          end.name,
+					TerminationID:     t.ObjectID,
+					CableID:           ac.ID,
+					TerminationTypeID: apiContentTypes[t.ObjectType],
+				})
+			}
+		}
+
+		cables = append(cables, cable)
+	}
+
+	return cables, nil
+}
+
+func (c *NetboxAPIClient) GetPrefixes(since time.Time) ([]*model.IpamPrefix, error) {
+	apiPrefixes, err := getAll[apiPrefix](c, "/api/ipam/prefixes/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get prefixes: %v", err)
+	}
+
+	prefixes := make([]*model.IpamPrefix, 0, len(apiPrefixes))
+	for _, p := range apiPrefixes {
+		prefixes = append(prefixes, &model.IpamPrefix{
+			ID:          p.ID,
+			LastUpdated: p.LastUpdated,
+			Prefix:      p.Prefix,
+			Tags:        tagNames(p.Tags),
+		})
+	}
+
+	return prefixes, nil
+}
+
+func (c *NetboxAPIClient) GetCircuits(since time.Time) ([]*model.CircuitsCircuit, error) {
+	apiCircuits, err := getAll[apiCircuit](c, "/api/circuits/circuits/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get circuits: %v", err)
+	}
+
+	circuits := make([]*model.CircuitsCircuit, 0, len(apiCircuits))
+	for _, ac := range apiCircuits {
+		circuits = append(circuits, &model.CircuitsCircuit{
+			ID:             ac.ID,
+			LastUpdated:    ac.LastUpdated,
+			Cid:            ac.Cid,
+			ProviderID:     ac.Provider.ID,
+			TypeID:         ac.Type.ID,
+			TenantID:       refID(ac.Tenant),
+			Status:         ac.Status.Value,
+			TerminationAID: refID(ac.TerminationA),
+			TerminationZID: refID(ac.TerminationZ),
+			Tags:           tagNames(ac.Tags),
+			Provider: model.CircuitsProvider{
+				ID:   ac.Provider.ID,
+				Name: ac.Provider.Name,
+				Slug: ac.Provider.Slug,
+			},
+			Type: model.CircuitsCircuittype{
+				ID:   ac.Type.ID,
+				Name: ac.Type.Name,
+				Slug: ac.Type.Slug,
+			},
+		})
+	}
+
+	return circuits, nil
+}
+
+func (c *NetboxAPIClient) GetCircuitTerminations(since time.Time) ([]*model.CircuitsCircuittermination, error) {
+	apiCTs, err := getAll[apiCircuitTermination](c, "/api/circuits/circuit-terminations/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get circuit terminations: %v", err)
+	}
+
+	cts := make([]*model.CircuitsCircuittermination, 0, len(apiCTs))
+	for _, ct := range apiCTs {
+		cts = append(cts, &model.CircuitsCircuittermination{
+			ID:          ct.ID,
+			LastUpdated: ct.LastUpdated,
+			CircuitID:   ct.Circuit.ID,
+		})
+	}
+
+	return cts, nil
+}
+
+func (c *NetboxAPIClient) GetFrontPorts(since time.Time) ([]*model.DcimFrontport, error) {
+	apiFPs, err := getAll[apiFrontPort](c, "/api/dcim/front-ports/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get front ports: %v", err)
+	}
+
+	fps := make([]*model.DcimFrontport, 0, len(apiFPs))
+	for _, fp := range apiFPs {
+		fps = append(fps, &model.DcimFrontport{
+			ID:               fp.ID,
+			LastUpdated:      fp.LastUpdated,
+			Name:             fp.Name,
+			RearPortPosition: fp.RearPortPosition,
+			DeviceID:         fp.Device.ID,
+			RearPortID:       fp.RearPort.ID,
+		})
+	}
+
+	return fps, nil
+}
+
+func (c *NetboxAPIClient) GetRearPorts(since time.Time) ([]*model.DcimRearport, error) {
+	apiRPs, err := getAll[apiRearPort](c, "/api/dcim/rear-ports/", since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get rear ports: %v", err)
+	}
+
+	rps := make([]*model.DcimRearport, 0, len(apiRPs))
+	for _, rp := range apiRPs {
+		rps = append(rps, &model.DcimRearport{
+			ID:          rp.ID,
+			LastUpdated: rp.LastUpdated,
+			Name:        rp.Name,
+			Type:        rp.Type.Value,
+			Positions:   rp.Positions,
+			DeviceID:    rp.Device.ID,
+		})
+	}
+
+	return rps, nil
+}
+
+// GetDeletedObjects returns the IDs of objects deleted at or after since from the change log, by object type ("<app_label>.<model>")
+func (c *NetboxAPIClient) GetDeletedObjects(since time.Time) (map[string][]int64, error) {
+	params := url.Values{}
+	params.Set("action", "delete")
+	params.Set("time_after", since.UTC().Format(time.RFC3339))
+
+	changes, err := getAllWithParams[apiObjectChange](c, "/api/extras/object-changes/", params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get object changes: %v", err)
+	}
+
+	ret := make(map[string][]int64)
+	for _, oc := range changes {
+		ret[oc.ChangedObjectType] = append(ret[oc.ChangedObjectType], oc.ChangedObjectID)
+	}
+
+	return ret, nil
+}
+
+func (c *NetboxAPIClient) GetDcimInterfaceTypeID() int32 {
+	return apiContentTypeDcimInterface
+}
+
+func (c *NetboxAPIClient) GetCircuitsCircuitterminationTypeID() int32 {
+	return apiContentTypeCircuitsCircuittermination
+}
+
+func (c *NetboxAPIClient) GetDcimFrontPortTypeID() int32 {
+	return apiContentTypeDcimFrontPort
+}
+
+func (c *NetboxAPIClient) GetDcimRearPortTypeID() int32 {
+	return apiContentTypeDcimRearPort
+}
+
+func refID(ref *apiRef) int64 {
+	if ref == nil {
+		return 0
+	}
+
+	return ref.ID
+}
+
+func interfaceRef(ref *apiRef) *model.DcimInterface {
+	if ref == nil {
+		return nil
+	}
+
+	return &model.DcimInterface{
+		ID:   ref.ID,
+		Name: ref.Name,
+	}
+}
+
+func tagNames(tags []apiTag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	ret := make([]string, 0, len(tags))
+	for _, t := range tags {
+		ret = append(ret, t.Name)
+	}
+
+	return ret
+}
+
+func customFieldData(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+
+	return string(raw)
+}
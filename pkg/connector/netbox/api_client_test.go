@@ -0,0 +1,171 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package netbox
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/stretchr/testify/assert"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+const apiTestToken = "s3cr3t"
+
+// newFakeNetboxAPI serves a minimal NetBox REST API. Devices are split into two pages.
+func newFakeNetboxAPI() *httptest.Server {
+	m := http.NewServeMux()
+	s := httptest.NewServer(m)
+
+	responses := map[string]string{
+		"/api/status/": `{"netbox-version": "3.7.0"}`,
+		"/api/dcim/interfaces/": `{"next": null, "results": [
+			{"id": 10, "name": "et-0/0/0", "type": {"value": "100gbase-x-qsfp28"}, "device": {"id": 1, "name": "ccr01.dus01"}, "lag": {"id": 12, "name": "ae0"}, "tags": [{"name": "backbone"}]},
+			{"id": 11, "name": "et-0/0/1", "type": {"value": "100gbase-x-qsfp28"}, "device": {"id": 1, "name": "ccr01.dus01"}},
+			{"id": 12, "name": "ae0", "type": {"value": "lag"}, "device": {"id": 1, "name": "ccr01.dus01"}},
+			{"id": 13, "name": "ae0.100", "type": {"value": "virtual"}, "device": {"id": 1, "name": "ccr01.dus01"}, "parent": {"id": 12, "name": "ae0"}, "tags": [{"name": "peer=GCP"}]},
+			{"id": 20, "name": "et-0/0/0", "type": {"value": "100gbase-x-qsfp28"}, "device": {"id": 2, "name": "edge01.dus01"}}
+		]}`,
+		"/api/ipam/ip-addresses/": `{"next": null, "results": [
+			{"id": 100, "address": "192.0.2.0/31", "assigned_object_type": "dcim.interface", "assigned_object_id": 13, "custom_fields": {"foo": "bar"}},
+			{"id": 101, "address": "192.0.2.100/32", "assigned_object_type": "virtualization.vminterface", "assigned_object_id": 13, "custom_fields": {}}
+		]}`,
+		"/api/dcim/cables/": `{"next": null, "results": [
+			{"id": 200, "status": {"value": "connected"},
+			 "a_terminations": [{"object_type": "dcim.interface", "object_id": 10}],
+			 "b_terminations": [{"object_type": "dcim.interface", "object_id": 20}]},
+			{"id": 201, "status": {"value": "connected"},
+			 "a_terminations": [{"object_type": "dcim.interface", "object_id": 11}],
+			 "b_terminations": [{"object_type": "dcim.frontport", "object_id": 500}]},
+			{"id": 202, "status": {"value": "connected"},
+			 "a_terminations": [{"object_type": "dcim.rearport", "object_id": 600}],
+			 "b_terminations": [{"object_type": "circuits.circuittermination", "object_id": 400}]}
+		]}`,
+		"/api/ipam/prefixes/": `{"next": null, "results": [
+			{"id": 300, "prefix": "192.0.2.0/24", "tags": [{"name": "infra"}]}
+		]}`,
+		"/api/circuits/circuits/": `{"next": null, "results": [
+			{"id": 350, "cid": "CID-1", "provider": {"id": 1, "name": "ACME", "slug": "acme"}, "type": {"id": 1, "name": "Dark Fiber", "slug": "dark-fiber"},
+			 "status": {"value": "active"}, "termination_a": {"id": 400}, "termination_z": {"id": 401}}
+		]}`,
+		"/api/circuits/circuit-terminations/": `{"next": null, "results": [
+			{"id": 400, "circuit": {"id": 350}},
+			{"id": 401, "circuit": {"id": 350}}
+		]}`,
+		"/api/dcim/front-ports/": `{"next": null, "results": [
+			{"id": 500, "name": "1", "device": {"id": 3}, "rear_port": {"id": 600}, "rear_port_position": 1}
+		]}`,
+		"/api/dcim/rear-ports/": `{"next": null, "results": [
+			{"id": 600, "name": "R1", "type": {"value": "lc"}, "positions": 1, "device": {"id": 3}}
+		]}`,
+	}
+
+	devicePages := map[string]string{
+		"": `{"next": "BASE_URL/api/dcim/devices/?limit=1000&offset=2", "results": [
+			{"id": 1, "name": "ccr01.dus01", "status": {"value": "active"}, "role": {"id": 1, "name": "CCR", "slug": "ccr"},
+			 "device_type": {"id": 1, "model": "MX10003", "slug": "mx10003"}, "site": {"id": 1, "name": "DUS01", "slug": "dus01"}, "tags": [{"name": "NET:ASN=13335"}]},
+			{"id": 2, "name": "edge01.dus01", "status": {"value": "planned"}, "device_role": {"id": 2, "name": "Edge", "slug": "edge"},
+			 "device_type": {"id": 2, "model": "QFX5120", "slug": "qfx5120"}, "site": {"id": 1, "name": "DUS01", "slug": "dus01"}}
+		]}`,
+		"2": `{"next": null, "results": [
+			{"id": 3, "name": "pp01.dus01", "status": {"value": "active"}, "role": {"id": 3, "name": "Patch Panel", "slug": "patch-panel"},
+			 "device_type": {"id": 3, "model": "Panel", "slug": "panel"}, "site": {"id": 1, "name": "DUS01", "slug": "dus01"}}
+		]}`,
+	}
+
+	m.HandleFunc("/", func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Token "+apiTestToken {
+			rw.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if req.URL.Path == "/api/dcim/devices/" {
+			page, exists := devicePages[req.URL.Query().Get("offset")]
+			if !exists {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			_, _ = rw.Write([]byte(strings.ReplaceAll(page, "BASE_URL", s.URL)))
+			return
+		}
+
+		resp, exists := responses[req.URL.Path]
+		if !exists {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = rw.Write([]byte(resp))
+	})
+
+	return s
+}
+
+func TestAPIClient(t *testing.T) {
+	s := newFakeNetboxAPI()
+	defer s.Close()
+
+	assert.Error(t, newAPIClient(s.URL, "wrong").Connect())
+
+	c := newAPIClient(s.URL+"/", apiTestToken)
+	assert.NoError(t, c.Connect())
+
+	devices, err := c.GetDevices(time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(devices))
+	assert.Equal(t, "ccr", devices[0].DeviceRole.Slug)
+	assert.Equal(t, "edge", devices[1].DeviceRole.Slug)
+	assert.Equal(t, "pp01.dus01", devices[2].Name)
+
+	n := newNetboxConnectorWithClient(c)
+	assert.NoError(t, n.InitialLoad())
+	assert.True(t, n.Healthy())
+
+	topology := model.NewTopology()
+	assert.NoError(t, n.EnrichTopology(topology))
+
+	ccr := topology.GetDevice("ccr01.dus01")
+	assert.Equal(t, "13335", ccr.MetaData.SemanticTags["NET:ASN"])
+	assert.Equal(t, "ae0", ccr.GetInterface("et-0/0/0").LAGMemberOf)
+	assert.Equal(t, []string{"backbone"}, ccr.GetInterface("et-0/0/0").MetaData.Tags)
+
+	u := ccr.GetInterface("ae0").Units[model.NewVLANTag(0, 100)]
+	assert.Equal(t, "GCP", u.MetaData.SemanticTags["peer"])
+	assert.Equal(t, 1, len(u.IPv4Addresses))
+	assert.Equal(t, `{"foo": "bar"}`, u.IPv4Addresses[0].MetaData.CustomFieldData)
+
+	cables := make([]string, 0)
+	for key := range topology.Cables {
+		cables = append(cables, key)
+	}
+
+	assert.ElementsMatch(t, []string{
+		model.Cable{
+			AEnd: model.CableEnd{DeviceName: "ccr01.dus01", EndpointName: "et-0/0/0", EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE},
+			BEnd: model.CableEnd{DeviceName: "edge01.dus01", EndpointName: "et-0/0/0", EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE},
+		}.String(),
+		model.Cable{
+			AEnd: model.CableEnd{DeviceName: "ccr01.dus01", EndpointName: "et-0/0/1", EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE},
+			BEnd: model.CableEnd{DeviceName: "pp01.dus01", EndpointName: "1", EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT},
+		}.String(),
+		model.Cable{
+			AEnd: model.CableEnd{DeviceName: "pp01.dus01", EndpointName: "R1", EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT},
+			BEnd: model.CableEnd{DeviceName: "CID-1", EndpointName: "A", EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION},
+		}.String(),
+	}, cables)
+
+	assert.Equal(t, "acme", topology.Circuits["CID-1"].Provider)
+	assert.Equal(t, "R1", topology.GetDevice("pp01.dus01").FrontPorts["1"].RearPort)
+	assert.Equal(t, []string{"infra"}, topology.Prefixes[300].MetaData.Tags)
+}
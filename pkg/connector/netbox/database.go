@@ -12,6 +12,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/cloudflare/octopus/pkg/connector/netbox/model"
 	"github.com/go-pg/pg"
@@ -57,6 +58,9 @@ type database struct {
 	contentTypeCircuitsCircuittermination int32
 	contentTypeFrontPort                  int32
 	contentTypeRearPort                   int32
+
+	// "<app_label>.<model>" by content type ID
+	contentTypeNames map[int32]string
 }
 
 func newDB(params dbParams) *database {
@@ -66,24 +70,44 @@ func newDB(params dbParams) *database {
 }
 
 func (db *database) connect() error {
+	opts, err := db.getOptions()
+	if err != nil {
+		return err
+	}
+
+	db.pgdb = pg.Connect(opts)
+
+	if db.params.logDBQueries {
+		db.pgdb.AddQueryHook(dbLogger{})
+	}
+
+	return nil
+}
+
+func (db *database) getOptions() (*pg.Options, error) {
 	tlsConfig, err := db.getTLSConfig()
 	if err != nil {
-		return fmt.Errorf("error building TLS config: %v", err)
+		return nil, fmt.Errorf("error building TLS config: %v", err)
 	}
 
-	db.pgdb = pg.Connect(&pg.Options{
+	return &pg.Options{
 		Addr:      fmt.Sprintf("%s:%d", db.params.host, db.params.port),
 		User:      db.params.user,
 		Password:  db.params.password,
 		Database:  db.params.dBname,
 		TLSConfig: tlsConfig,
-	})
+	}, nil
+}
 
-	if db.params.logDBQueries {
-		db.pgdb.AddQueryHook(dbLogger{})
+// listen subscribes to the given notification channel (see LISTEN/NOTIFY) on a dedicated connection.
+// The listener reconnects on its own if the connection breaks.
+func (db *database) listen(channel string) (<-chan *pg.Notification, error) {
+	opts, err := db.getOptions()
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return pg.Connect(opts).Listen(channel).Channel(), nil
 }
 
 func (db *database) getTLSConfig() (*tls.Config, error) {
@@ -114,15 +138,15 @@ func (db *database) getTLSConfig() (*tls.Config, error) {
 	return cfg, nil
 }
 
-func (db *database) getDevices() ([]*model.DcimDevice, error) {
+func (db *database) getDevices(since time.Time) ([]*model.DcimDevice, error) {
 	dcimDevices := make([]*model.DcimDevice, 0)
 
-	err := db.pgdb.Model(&dcimDevices).Relation("DeviceRole").Relation("Site").Relation("DeviceType").Select()
+	err := sinceFilter(db.pgdb.Model(&dcimDevices).Relation("DeviceRole").Relation("Site").Relation("DeviceType"), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
 
-	tagsByID, err := db.tagsByID(uint(db.contentTypeDcimDevice))
+	tagsByID, err := db.tagsByID(uint(db.contentTypeDcimDevice), since, deviceIDs(dcimDevices))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tags: %v", err)
 	}
@@ -134,15 +158,15 @@ func (db *database) getDevices() ([]*model.DcimDevice, error) {
 	return dcimDevices, nil
 }
 
-func (db *database) getInterfaces() (map[int64]*model.DcimInterface, error) {
+func (db *database) getInterfaces(since time.Time) (map[int64]*model.DcimInterface, error) {
 	dcimInterfaces := make([]*model.DcimInterface, 0)
 
-	err := db.pgdb.Model(&dcimInterfaces).Relation("Parent").Relation("Device").Relation("LAG").Select()
+	err := sinceFilter(db.pgdb.Model(&dcimInterfaces).Relation("Parent").Relation("Device").Relation("LAG"), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
 
-	tagsByID, err := db.tagsByID(uint(db.contentTypeDcimInterface))
+	tagsByID, err := db.tagsByID(uint(db.contentTypeDcimInterface), since, interfaceIDs(dcimInterfaces))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tags: %v", err)
 	}
@@ -159,10 +183,10 @@ func (db *database) getInterfaces() (map[int64]*model.DcimInterface, error) {
 	return res, nil
 }
 
-func (db *database) getIPAddresses() ([]*model.IpamIpaddress, error) {
+func (db *database) getIPAddresses(since time.Time) ([]*model.IpamIpaddress, error) {
 	addrs := make([]*model.IpamIpaddress, 0)
 
-	err := db.pgdb.Model(&addrs).Select()
+	err := sinceFilter(db.pgdb.Model(&addrs), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
@@ -170,10 +194,10 @@ func (db *database) getIPAddresses() ([]*model.IpamIpaddress, error) {
 	return addrs, nil
 }
 
-func (db *database) getCables() ([]*model.DcimCable, error) {
+func (db *database) getCables(since time.Time) ([]*model.DcimCable, error) {
 	cables := make([]*model.DcimCable, 0)
 
-	err := db.pgdb.Model(&cables).Relation("Terminations").Select()
+	err := sinceFilter(db.pgdb.Model(&cables).Relation("Terminations"), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
@@ -181,15 +205,15 @@ func (db *database) getCables() ([]*model.DcimCable, error) {
 	return cables, nil
 }
 
-func (db *database) getPrefixes() ([]*model.IpamPrefix, error) {
+func (db *database) getPrefixes(since time.Time) ([]*model.IpamPrefix, error) {
 	prefixes := make([]*model.IpamPrefix, 0)
 
-	err := db.pgdb.Model(&prefixes).Select()
+	err := sinceFilter(db.pgdb.Model(&prefixes), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
 
-	tagsByID, err := db.tagsByID(uint(db.contentTypeIpamPrefix))
+	tagsByID, err := db.tagsByID(uint(db.contentTypeIpamPrefix), since, prefixIDs(prefixes))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tags: %v", err)
 	}
@@ -201,15 +225,15 @@ func (db *database) getPrefixes() ([]*model.IpamPrefix, error) {
 	return prefixes, nil
 }
 
-func (db *database) getCircuits() ([]*model.CircuitsCircuit, error) {
+func (db *database) getCircuits(since time.Time) ([]*model.CircuitsCircuit, error) {
 	circuits := make([]*model.CircuitsCircuit, 0)
 
-	err := db.pgdb.Model(&circuits).Relation("Provider").Relation("Type").Select()
+	err := sinceFilter(db.pgdb.Model(&circuits).Relation("Provider").Relation("Type"), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
 
-	tagsByID, err := db.tagsByID(uint(db.contentTypeCircuitsCircuit))
+	tagsByID, err := db.tagsByID(uint(db.contentTypeCircuitsCircuit), since, circuitIDs(circuits))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tags: %v", err)
 	}
@@ -221,10 +245,10 @@ func (db *database) getCircuits() ([]*model.CircuitsCircuit, error) {
 	return circuits, nil
 }
 
-func (db *database) getCircuitTerminations() ([]*model.CircuitsCircuittermination, error) {
+func (db *database) getCircuitTerminations(since time.Time) ([]*model.CircuitsCircuittermination, error) {
 	cts := make([]*model.CircuitsCircuittermination, 0)
 
-	err := db.pgdb.Model(&cts).Select()
+	err := sinceFilter(db.pgdb.Model(&cts), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
@@ -232,10 +256,10 @@ func (db *database) getCircuitTerminations() ([]*model.CircuitsCircuitterminatio
 	return cts, nil
 }
 
-func (db *database) getFrontports() ([]*model.DcimFrontport, error) {
+func (db *database) getFrontports(since time.Time) ([]*model.DcimFrontport, error) {
 	fps := make([]*model.DcimFrontport, 0)
 
-	err := db.pgdb.Model(&fps).Select()
+	err := sinceFilter(db.pgdb.Model(&fps), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
@@ -243,10 +267,10 @@ func (db *database) getFrontports() ([]*model.DcimFrontport, error) {
 	return fps, nil
 }
 
-func (db *database) getRearports() ([]*model.DcimRearport, error) {
+func (db *database) getRearports(since time.Time) ([]*model.DcimRearport, error) {
 	rps := make([]*model.DcimRearport, 0)
 
-	err := db.pgdb.Model(&rps).Select()
+	err := sinceFilter(db.pgdb.Model(&rps), since).Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
@@ -254,13 +278,22 @@ func (db *database) getRearports() ([]*model.DcimRearport, error) {
 	return rps, nil
 }
 
-func (db *database) tagsByID(contentTypeID uint) (map[int64][]string, error) {
-	tags, err := db.getTags(contentTypeID)
+// tagsByID returns the tags of the given objects. On a full load (since is zero) the tags of all objects are fetched.
+func (db *database) tagsByID(contentTypeID uint, since time.Time, objectIDs []int64) (map[int64][]string, error) {
+	ret := make(map[int64][]string)
+	if !since.IsZero() && len(objectIDs) == 0 {
+		return ret, nil
+	}
+
+	if since.IsZero() {
+		objectIDs = nil
+	}
+
+	tags, err := db.getTags(contentTypeID, objectIDs)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get tags: %v", err)
 	}
 
-	ret := make(map[int64][]string)
 	for _, tag := range tags {
 		if _, exists := ret[int64(tag.ObjectID)]; !exists {
 			ret[int64(tag.ObjectID)] = make([]string, 0, 1)
@@ -272,9 +305,14 @@ func (db *database) tagsByID(contentTypeID uint) (map[int64][]string, error) {
 	return ret, nil
 }
 
-func (db *database) getTags(contentTypeID uint) ([]model.ExtrasTaggeditem, error) {
+func (db *database) getTags(contentTypeID uint, objectIDs []int64) ([]model.ExtrasTaggeditem, error) {
 	tagsMapping := make([]model.ExtrasTaggeditem, 0)
-	err := db.pgdb.Model(&tagsMapping).Relation("Tag").Where("content_type_id = ?", contentTypeID).Select()
+	q := db.pgdb.Model(&tagsMapping).Relation("Tag").Where("content_type_id = ?", contentTypeID)
+	if objectIDs != nil {
+		q = q.Where("object_id IN (?)", pg.In(objectIDs))
+	}
+
+	err := q.Select()
 	if err != nil {
 		return nil, fmt.Errorf("select failed: %v", err)
 	}
@@ -299,7 +337,10 @@ func (db *database) loadContentTypes() error {
 		return err
 	}
 
+	db.contentTypeNames = make(map[int32]string, len(types))
 	for _, t := range types {
+		db.contentTypeNames[t.ID] = t.AppLabel + "." + t.Model
+
 		switch t.AppLabel {
 		case "dcim":
 			switch t.Model {
@@ -335,3 +376,70 @@ func (db *database) loadContentTypes() error {
 
 	return nil
 }
+
+// sinceFilter limits the query to rows updated at or after since, unless since is zero
+func sinceFilter(q *orm.Query, since time.Time) *orm.Query {
+	if since.IsZero() {
+		return q
+	}
+
+	return q.Where("?TableAlias.last_updated >= ?", since)
+}
+
+// getDeletedObjects returns the IDs of objects deleted at or after since from the change log, by object type ("<app_label>.<model>")
+func (db *database) getDeletedObjects(since time.Time) (map[string][]int64, error) {
+	changes := make([]*model.ExtrasObjectchange, 0)
+
+	err := db.pgdb.Model(&changes).Where("action = ?", "delete").Where("time >= ?", since).Select()
+	if err != nil {
+		return nil, fmt.Errorf("select failed: %v", err)
+	}
+
+	ret := make(map[string][]int64)
+	for _, c := range changes {
+		objectType, exists := db.contentTypeNames[c.ChangedObjectTypeID]
+		if !exists {
+			continue
+		}
+
+		ret[objectType] = append(ret[objectType], c.ChangedObjectID)
+	}
+
+	return ret, nil
+}
+
+func deviceIDs(devices []*model.DcimDevice) []int64 {
+	ret := make([]int64, 0, len(devices))
+	for _, d := range devices {
+		ret = append(ret, d.ID)
+	}
+
+	return ret
+}
+
+func interfaceIDs(interfaces []*model.DcimInterface) []int64 {
+	ret := make([]int64, 0, len(interfaces))
+	for _, ifa := range interfaces {
+		ret = append(ret, ifa.ID)
+	}
+
+	return ret
+}
+
+func prefixIDs(prefixes []*model.IpamPrefix) []int64 {
+	ret := make([]int64, 0, len(prefixes))
+	for _, p := range prefixes {
+		ret = append(ret, p.ID)
+	}
+
+	return ret
+}
+
+func circuitIDs(circuits []*model.CircuitsCircuit) []int64 {
+	ret := make([]int64, 0, len(circuits))
+	for _, c := range circuits {
+		ret = append(ret, c.ID)
+	}
+
+	return ret
+}
@@ -0,0 +1,128 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package netbox
+
+import (
+	"sort"
+	"time"
+
+	dbModel "github.com/cloudflare/octopus/pkg/connector/netbox/model"
+)
+
+// row gives access to the columns needed to merge rows of type T
+type row[T any] struct {
+	id          func(*T) int64
+	lastUpdated func(*T) time.Time
+}
+
+var (
+	deviceRow = row[dbModel.DcimDevice]{
+		id:          func(d *dbModel.DcimDevice) int64 { return d.ID },
+		lastUpdated: func(d *dbModel.DcimDevice) time.Time { return d.LastUpdated },
+	}
+	interfaceRow = row[dbModel.DcimInterface]{
+		id:          func(i *dbModel.DcimInterface) int64 { return i.ID },
+		lastUpdated: func(i *dbModel.DcimInterface) time.Time { return i.LastUpdated },
+	}
+	ipAddressRow = row[dbModel.IpamIpaddress]{
+		id:          func(a *dbModel.IpamIpaddress) int64 { return a.ID },
+		lastUpdated: func(a *dbModel.IpamIpaddress) time.Time { return a.LastUpdated },
+	}
+	cableRow = row[dbModel.DcimCable]{
+		id:          func(c *dbModel.DcimCable) int64 { return c.ID },
+		lastUpdated: func(c *dbModel.DcimCable) time.Time { return c.LastUpdated },
+	}
+	prefixRow = row[dbModel.IpamPrefix]{
+		id:          func(p *dbModel.IpamPrefix) int64 { return p.ID },
+		lastUpdated: func(p *dbModel.IpamPrefix) time.Time { return p.LastUpdated },
+	}
+	circuitRow = row[dbModel.CircuitsCircuit]{
+		id:          func(c *dbModel.CircuitsCircuit) int64 { return c.ID },
+		lastUpdated: func(c *dbModel.CircuitsCircuit) time.Time { return c.LastUpdated },
+	}
+	circuitTerminationRow = row[dbModel.CircuitsCircuittermination]{
+		id:          func(ct *dbModel.CircuitsCircuittermination) int64 { return ct.ID },
+		lastUpdated: func(ct *dbModel.CircuitsCircuittermination) time.Time { return ct.LastUpdated },
+	}
+	frontPortRow = row[dbModel.DcimFrontport]{
+		id:          func(fp *dbModel.DcimFrontport) int64 { return fp.ID },
+		lastUpdated: func(fp *dbModel.DcimFrontport) time.Time { return fp.LastUpdated },
+	}
+	rearPortRow = row[dbModel.DcimRearport]{
+		id:          func(rp *dbModel.DcimRearport) int64 { return rp.ID },
+		lastUpdated: func(rp *dbModel.DcimRearport) time.Time { return rp.LastUpdated },
+	}
+)
+
+// mergeByID removes the deleted rows from cached and adds or replaces the changed ones.
+// It returns the number of rows actually added, modified, or removed. Rows fetched again with an unchanged last_updated don't count.
+func mergeByID[T any](cached map[int64]*T, changed []*T, deleted []int64, r row[T]) (map[int64]*T, int) {
+	if cached == nil {
+		cached = make(map[int64]*T, len(changed))
+	}
+
+	modified := 0
+	for _, id := range deleted {
+		if _, exists := cached[id]; exists {
+			delete(cached, id)
+			modified++
+		}
+	}
+
+	for _, obj := range changed {
+		old, exists := cached[r.id(obj)]
+		if !exists || !r.lastUpdated(old).Equal(r.lastUpdated(obj)) {
+			modified++
+		}
+
+		cached[r.id(obj)] = obj
+	}
+
+	return cached, modified
+}
+
+// mergeSortedByID works like mergeByID for slices. The result is sorted by ID to keep the enrichment deterministic.
+func mergeSortedByID[T any](cached []*T, changed []*T, deleted []int64, r row[T]) ([]*T, int) {
+	m := make(map[int64]*T, len(cached)+len(changed))
+	for _, obj := range cached {
+		m[r.id(obj)] = obj
+	}
+
+	m, modified := mergeByID(m, changed, deleted, r)
+
+	ret := make([]*T, 0, len(m))
+	for _, obj := range m {
+		ret = append(ret, obj)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return r.id(ret[i]) < r.id(ret[j])
+	})
+
+	return ret, modified
+}
+
+func (r row[T]) maxLastUpdated(objs []*T) time.Time {
+	ret := time.Time{}
+	for _, obj := range objs {
+		if r.lastUpdated(obj).After(ret) {
+			ret = r.lastUpdated(obj)
+		}
+	}
+
+	return ret
+}
+
+func mapValues[T any](m map[int64]*T) []*T {
+	ret := make([]*T, 0, len(m))
+	for _, v := range m {
+		ret = append(ret, v)
+	}
+
+	return ret
+}
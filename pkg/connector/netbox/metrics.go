@@ -0,0 +1,43 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package netbox
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	refreshRowsFetchedVec = prometheus.NewDesc("octopus_netbox_refresh_rows_fetched", "The number of rows fetched by the last refresh", []string{"object_type"}, nil)
+	refreshRowsDeletedVec = prometheus.NewDesc("octopus_netbox_refresh_rows_deleted", "The number of rows removed by the last refresh", []string{"object_type"}, nil)
+	refreshRowsChangedVec = prometheus.NewDesc("octopus_netbox_refresh_rows_changed", "The number of rows actually added, modified, or removed by the last refresh", []string{"object_type"}, nil)
+	refreshCountVec       = prometheus.NewDesc("octopus_netbox_refresh_count", "The number of successful refreshes", []string{"type"}, nil)
+	lastFullResyncTime    = prometheus.NewDesc("octopus_netbox_last_full_resync_time", "Timestamp (epoch) of the last full reload of the Netbox data", nil, nil)
+)
+
+func (n *NetboxConnector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- refreshRowsFetchedVec
+	ch <- refreshRowsDeletedVec
+	ch <- refreshRowsChangedVec
+	ch <- refreshCountVec
+	ch <- lastFullResyncTime
+}
+
+func (n *NetboxConnector) Collect(ch chan<- prometheus.Metric) {
+	n.connectorMu.RLock()
+	defer n.connectorMu.RUnlock()
+
+	for objectType, s := range n.lastRefreshStats {
+		ch <- prometheus.MustNewConstMetric(refreshRowsFetchedVec, prometheus.GaugeValue, float64(s.fetched), objectType)
+		ch <- prometheus.MustNewConstMetric(refreshRowsDeletedVec, prometheus.GaugeValue, float64(s.deleted), objectType)
+		ch <- prometheus.MustNewConstMetric(refreshRowsChangedVec, prometheus.GaugeValue, float64(s.modified), objectType)
+	}
+
+	ch <- prometheus.MustNewConstMetric(refreshCountVec, prometheus.CounterValue, float64(n.fullRefreshCount), "full")
+	ch <- prometheus.MustNewConstMetric(refreshCountVec, prometheus.CounterValue, float64(n.incrementalRefreshCount), "incremental")
+	ch <- prometheus.MustNewConstMetric(lastFullResyncTime, prometheus.GaugeValue, float64(n.lastFullResync.Unix()))
+}
@@ -4,13 +4,15 @@
 
 package model
 
+import "time"
+
 const TableNameCircuitsCircuit = "circuits_circuit"
 
 // CircuitsCircuit mapped from table <circuits_circuit>
 type CircuitsCircuit struct {
 	ID                int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
 	// Created           time.Time `gorm:"column:created" json:"created"`
-	// LastUpdated       time.Time `gorm:"column:last_updated" json:"last_updated"`
+	LastUpdated       time.Time `gorm:"column:last_updated" json:"last_updated"`
 	Cid               string    `gorm:"column:cid;not null" json:"cid"`
 	// InstallDate       time.Time `gorm:"column:install_date" json:"install_date"`
 	// CommitRate        int32     `gorm:"column:commit_rate" json:"commit_rate"`
@@ -4,6 +4,8 @@
 
 package model
 
+import "time"
+
 const TableNameCircuitsCircuittermination = "circuits_circuittermination"
 
 // CircuitsCircuittermination mapped from table <circuits_circuittermination>
@@ -19,7 +21,7 @@ type CircuitsCircuittermination struct {
 	// CableID           int64     `gorm:"column:cable_id" json:"cable_id"`
 	// Description       string    `gorm:"column:description;not null" json:"description"`
 	// Created           time.Time `gorm:"column:created" json:"created"`
-	// LastUpdated       time.Time `gorm:"column:last_updated" json:"last_updated"`
+	LastUpdated       time.Time `gorm:"column:last_updated" json:"last_updated"`
 	// MarkConnected     bool      `gorm:"column:mark_connected;not null" json:"mark_connected"`
 	// ProviderNetworkID int64     `gorm:"column:provider_network_id" json:"provider_network_id"`
 	// CustomFieldData   string    `gorm:"column:custom_field_data;not null" json:"custom_field_data"`
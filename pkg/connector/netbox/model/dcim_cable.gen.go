@@ -4,13 +4,15 @@
 
 package model
 
+import "time"
+
 const TableNameDcimCable = "dcim_cable"
 
 // DcimCable mapped from table <dcim_cable>
 type DcimCable struct {
 	ID              int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
 	// Created         time.Time `gorm:"column:created" json:"created"`
-	// LastUpdated     time.Time `gorm:"column:last_updated" json:"last_updated"`
+	LastUpdated     time.Time `gorm:"column:last_updated" json:"last_updated"`
 	Type            string    `gorm:"column:type;not null" json:"type"`
 	Status          string    `gorm:"column:status;not null" json:"status"`
 	// Label           string    `gorm:"column:label;not null" json:"label"`
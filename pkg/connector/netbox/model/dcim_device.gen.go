@@ -1,12 +1,14 @@
 package model
 
+import "time"
+
 const TableNameDcimDevice = "dcim_device"
 
 // DcimDevice mapped from table <dcim_device>
 type DcimDevice struct {
 	ID int64 `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
 	// Created          time.Time `gorm:"column:created" json:"created"`
-	// LastUpdated      time.Time `gorm:"column:last_updated" json:"last_updated"`
+	LastUpdated      time.Time `gorm:"column:last_updated" json:"last_updated"`
 	Name     string  `gorm:"column:name" json:"name"`
 	Serial   string  `gorm:"column:serial;not null" json:"serial"`
 	Position float64 `gorm:"column:position" json:"position"`
@@ -4,6 +4,8 @@
 
 package model
 
+import "time"
+
 const TableNameDcimInterface = "dcim_interface"
 
 // DcimInterface mapped from table <dcim_interface>
@@ -26,7 +28,7 @@ type DcimInterface struct {
 	//PathID             int64     `gorm:"column:_path_id" json:"_path_id"`
 	// Created            time.Time `gorm:"column:created" json:"created"`
 	// CustomFieldData    string    `gorm:"column:custom_field_data;not null" json:"custom_field_data"`
-	// LastUpdated        time.Time `gorm:"column:last_updated" json:"last_updated"`
+	LastUpdated        time.Time `gorm:"column:last_updated" json:"last_updated"`
 	// MarkConnected      bool      `gorm:"column:mark_connected;not null" json:"mark_connected"`
 	ParentID           int64     `gorm:"column:parent_id" json:"parent_id"`
 	// Wwn                string    `gorm:"column:wwn" json:"wwn"`
@@ -4,6 +4,8 @@
 
 package model
 
+import "time"
+
 const TableNameDcimRearport = "dcim_rearport"
 
 // DcimRearport mapped from table <dcim_rearport>
@@ -19,7 +21,7 @@ type DcimRearport struct {
 	// Label           string    `gorm:"column:label;not null" json:"label"`
 	// Created         time.Time `gorm:"column:created" json:"created"`
 	// CustomFieldData string    `gorm:"column:custom_field_data;not null" json:"custom_field_data"`
-	// LastUpdated     time.Time `gorm:"column:last_updated" json:"last_updated"`
+	LastUpdated     time.Time `gorm:"column:last_updated" json:"last_updated"`
 	// MarkConnected   bool      `gorm:"column:mark_connected;not null" json:"mark_connected"`
 	// Color           string    `gorm:"column:color;not null" json:"color"`
 	// ModuleID        int64     `gorm:"column:module_id" json:"module_id"`
@@ -4,13 +4,15 @@
 
 package model
 
+import "time"
+
 const TableNameIpamIpaddress = "ipam_ipaddress"
 
 // IpamIpaddress mapped from table <ipam_ipaddress>
 type IpamIpaddress struct {
 	ID                   int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
 	// Created              time.Time `gorm:"column:created" json:"created"`
-	// LastUpdated          time.Time `gorm:"column:last_updated" json:"last_updated"`
+	LastUpdated          time.Time `gorm:"column:last_updated" json:"last_updated"`
 	Address              string    `gorm:"column:address;not null" json:"address"`
 	// Description          string    `gorm:"column:description;not null" json:"description"`
 	AssignedObjectID     int64     `gorm:"column:assigned_object_id" json:"assigned_object_id"`
@@ -4,13 +4,15 @@
 
 package model
 
+import "time"
+
 const TableNameIpamPrefix = "ipam_prefix"
 
 // IpamPrefix mapped from table <ipam_prefix>
 type IpamPrefix struct {
 	ID              int64      `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
 	// Created         time.Time `gorm:"column:created" json:"created"`
-	// LastUpdated     time.Time `gorm:"column:last_updated" json:"last_updated"`
+	LastUpdated     time.Time `gorm:"column:last_updated" json:"last_updated"`
 	Prefix          string    `gorm:"column:prefix;not null" json:"prefix"`
 	// Status          string    `gorm:"column:status;not null" json:"status"`
 	// Description     string    `gorm:"column:description;not null" json:"description"`
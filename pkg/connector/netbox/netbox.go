@@ -14,6 +14,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/cloudflare/octopus/pkg/connector"
 	dbModel "github.com/cloudflare/octopus/pkg/connector/netbox/model"
 	nbUtils "github.com/cloudflare/octopus/pkg/connector/netbox/utils"
 	"github.com/cloudflare/octopus/pkg/model"
@@ -26,6 +27,26 @@ import (
 const (
 	connectorName  = "Netbox"
 	updateInterval = time.Minute * 2
+
+	// All data is reloaded from scratch in this interval as a safety net for changes the incremental refresh can not see
+	// (e.g. renamed sites, roles, or device types which do not touch the last_updated of the devices referencing them)
+	fullResyncInterval = time.Hour
+
+	// Rows committed while a refresh was running may carry a last_updated older than the watermark, so we look back a bit
+	refreshOverlap = time.Minute
+)
+
+// Object types as used in the NetBox change log ("<app_label>.<model>")
+const (
+	objectTypeDevice             = "dcim.device"
+	objectTypeInterface          = "dcim.interface"
+	objectTypeIPAddress          = "ipam.ipaddress"
+	objectTypeCable              = "dcim.cable"
+	objectTypePrefix             = "ipam.prefix"
+	objectTypeCircuit            = "circuits.circuit"
+	objectTypeCircuitTermination = "circuits.circuittermination"
+	objectTypeFrontPort          = "dcim.frontport"
+	objectTypeRearPort           = "dcim.rearport"
 )
 
 type NetboxConnector struct {
@@ -34,6 +55,13 @@ type NetboxConnector struct {
 	loadDuration      time.Duration
 	loadTime          time.Time
 	refreshErrorCount atomic.Uint64
+	dataGeneration    atomic.Uint64
+
+	// Postgres channel to LISTEN on for change notifications (disabled if empty)
+	notifyChannel string
+	// refreshTrigger requests an immediate refresh, changes signals new data to the Octopus
+	refreshTrigger *connector.Notifier
+	changes        *connector.Notifier
 
 	devices             map[int64]*dbModel.DcimDevice
 	interfaces          map[int64]*dbModel.DcimInterface
@@ -44,24 +72,44 @@ type NetboxConnector struct {
 	circuitTerminations map[int64]*dbModel.CircuitsCircuittermination
 	frontPorts          map[int64]*dbModel.DcimFrontport
 	rearPorts           map[int64]*dbModel.DcimRearport
+
+	// max last_updated seen per object type
+	watermarks              map[string]time.Time
+	lastRefresh             time.Time
+	lastFullResync          time.Time
+	lastRefreshStats        map[string]tableRefreshStats
+	fullRefreshCount        uint64
+	incrementalRefreshCount uint64
+}
+
+type tableRefreshStats struct {
+	fetched  int
+	deleted  int
+	modified int
+}
+
+// changeListener is implemented by clients able to push change notifications (currently only the DB client)
+type changeListener interface {
+	Listen(channel string) (<-chan struct{}, error)
 }
 
 type NetboxClientI interface {
 	Connect() error
 	GetDBHost() string
-	GetDevices() ([]*dbModel.DcimDevice, error)
-	GetInterfaces() (map[int64]*dbModel.DcimInterface, error)
-	GetIPAddresses() ([]*dbModel.IpamIpaddress, error)
-	GetCables() ([]*dbModel.DcimCable, error)
-	GetPrefixes() ([]*dbModel.IpamPrefix, error)
+	GetDevices(since time.Time) ([]*dbModel.DcimDevice, error)
+	GetInterfaces(since time.Time) (map[int64]*dbModel.DcimInterface, error)
+	GetIPAddresses(since time.Time) ([]*dbModel.IpamIpaddress, error)
+	GetCables(since time.Time) ([]*dbModel.DcimCable, error)
+	GetPrefixes(since time.Time) ([]*dbModel.IpamPrefix, error)
 	GetDcimInterfaceTypeID() int32
 	GetCircuitsCircuitterminationTypeID() int32
 	GetDcimFrontPortTypeID() int32
 	GetDcimRearPortTypeID() int32
-	GetCircuits() ([]*dbModel.CircuitsCircuit, error)
-	GetCircuitTerminations() ([]*dbModel.CircuitsCircuittermination, error)
-	GetFrontPorts() ([]*dbModel.DcimFrontport, error)
-	GetRearPorts() ([]*dbModel.DcimRearport, error)
+	GetCircuits(since time.Time) ([]*dbModel.CircuitsCircuit, error)
+	GetCircuitTerminations(since time.Time) ([]*dbModel.CircuitsCircuittermination, error)
+	GetFrontPorts(since time.Time) ([]*dbModel.DcimFrontport, error)
+	GetRearPorts(since time.Time) ([]*dbModel.DcimRearport, error)
+	GetDeletedObjects(since time.Time) (map[string][]int64, error)
 }
 
 func NewConnector(host string, port uint, user string, password string, dbName string, useTLS bool, caCertPath string, logDBQueries bool) *NetboxConnector {
@@ -81,14 +129,43 @@ func NewConnector(host string, port uint, user string, password string, dbName s
 	})
 }
 
+// NewAPIConnector creates a NetboxConnector reading the data via the NetBox REST API instead of the DB
+func NewAPIConnector(baseURL string, token string) *NetboxConnector {
+	return newNetboxConnectorWithClient(newAPIClient(baseURL, token))
+}
+
 func newNetboxConnectorWithClient(apiClient NetboxClientI) *NetboxConnector {
 	return &NetboxConnector{
-		client: apiClient,
+		client:         apiClient,
+		watermarks:     make(map[string]time.Time),
+		refreshTrigger: connector.NewNotifier(),
+		changes:        connector.NewNotifier(),
 	}
 }
 
+// ListenForNotifications makes the connector refresh immediately whenever a notification is sent to the given Postgres channel.
+// It has to be called before StartRefreshRoutine and requires triggers on the NetBox tables issuing pg_notify().
+func (n *NetboxConnector) ListenForNotifications(channel string) error {
+	if _, ok := n.client.(changeListener); !ok {
+		return fmt.Errorf("change notifications are only supported when reading from the DB")
+	}
+
+	n.notifyChannel = channel
+	return nil
+}
+
+// TriggerRefresh requests an immediate refresh of the NetBox data
+func (n *NetboxConnector) TriggerRefresh() {
+	n.refreshTrigger.Notify()
+}
+
+func (n *NetboxConnector) Changes() <-chan struct{} {
+	return n.changes.C()
+}
+
 func (n *NetboxConnector) InitialLoad() error {
-	return n.update()
+	_, err := n.update()
+	return err
 }
 
 func (n *NetboxConnector) Healthy() bool {
@@ -118,6 +195,11 @@ func (n *NetboxConnector) GetUpdateErrorCount() uint64 {
 	return n.refreshErrorCount.Load()
 }
 
+// GetDataGeneration returns a number increased whenever a refresh changed the data
+func (n *NetboxConnector) GetDataGeneration() uint64 {
+	return n.dataGeneration.Load()
+}
+
 func (n *NetboxConnector) EnrichTopology(t *model.Topology) error {
 	n.connectorMu.RLock()
 	defer n.connectorMu.RUnlock()
@@ -178,9 +260,15 @@ func (n *NetboxConnector) _enrichTopology(t *model.Topology) error {
 	return nil
 }
 
+// sourceID identifies a NetBox object as source of topology objects, e.g. "dcim.device:42"
+func sourceID(objectType string, id int64) string {
+	return fmt.Sprintf("%s:%d", objectType, id)
+}
+
 func (n *NetboxConnector) addDevices(t *model.Topology) error {
 	for _, d := range n.devices {
 		topoDev := t.AddDeviceIfNotExists(d.Name)
+		topoDev.SourceID = sourceID(objectTypeDevice, d.ID)
 		s := t.AddSiteIfNotExists(d.Site.Name)
 
 		topoDev.Site = s
@@ -210,6 +298,7 @@ func (n *NetboxConnector) addInterfaces(t *model.Topology) error {
 		}
 
 		ifa := d.AddInterfaceItNotExists(nbIfa.Name)
+		ifa.SourceID = sourceID(objectTypeInterface, nbIfa.ID)
 		t.DevicesByInterfaceID[nbIfa.ID] = d
 		t.Interfaces[nbIfa.ID] = ifa
 
@@ -258,6 +347,7 @@ func (n *NetboxConnector) addInterfaceUnits(t *model.Topology) error {
 		}
 
 		u := ifa.AddUnitIfNotExists(vlanTag)
+		u.SourceID = sourceID(objectTypeInterface, nbIfa.ID)
 		t.DevicesByInterfaceID[nbIfa.ID] = d
 
 		md, err := nbUtils.GetMetaDataFromTags(nbIfa.Tags)
@@ -303,6 +393,7 @@ func (n *NetboxConnector) addIPAddresses(t *model.Topology) error {
 		}
 
 		ip := model.NewIP(*pfx)
+		ip.SourceID = sourceID(objectTypeIPAddress, nbIP.ID)
 		nbUtils.GetCustomFieldData(ip.MetaData, nbIP.CustomFieldData)
 
 		u := ifa.AddUnitIfNotExists(vt)
@@ -330,6 +421,7 @@ func (n *NetboxConnector) addPrefixes(t *model.Topology) error {
 
 		oPfx := model.NewPrefix(*pfx)
 		oPfx.MetaData = md
+		oPfx.SourceID = sourceID(objectTypePrefix, p.ID)
 		t.Prefixes[p.ID] = oPfx
 
 	}
@@ -441,8 +533,9 @@ func (n *NetboxConnector) addCables(t *model.Topology) error {
 		}
 
 		cable := model.Cable{
-			AEnd: *AEnd,
-			BEnd: *BEnd,
+			AEnd:     *AEnd,
+			BEnd:     *BEnd,
+			SourceID: sourceID(objectTypeCable, c.ID),
 		}
 
 		t.Cables[cable.String()] = &cable
@@ -453,7 +546,9 @@ func (n *NetboxConnector) addCables(t *model.Topology) error {
 
 func (n *NetboxConnector) addCircuits(t *model.Topology) error {
 	for _, c := range n.circuits {
-		t.Circuits[c.Cid] = model.NewCircuit(c.Cid, c.Provider.Slug, c.Type.Slug, c.Status)
+		ckt := model.NewCircuit(c.Cid, c.Provider.Slug, c.Type.Slug, c.Status)
+		ckt.SourceID = sourceID(objectTypeCircuit, c.ID)
+		t.Circuits[c.Cid] = ckt
 	}
 
 	return nil
@@ -509,13 +604,30 @@ func (n *NetboxConnector) addFrontPorts(t *model.Topology) error {
 }
 
 func (n *NetboxConnector) StartRefreshRoutine() {
+	if n.notifyChannel != "" {
+		go n.notificationRoutine()
+	}
+
 	go n.refreshRoutine()
 }
 
+func (n *NetboxConnector) notificationRoutine() {
+	notifications, err := n.client.(changeListener).Listen(n.notifyChannel)
+	if err != nil {
+		log.Errorf("Failed to listen for Netbox change notifications, falling back to periodic refreshes: %v", err)
+		return
+	}
+
+	log.Infof("Listening for Netbox change notifications on channel %q", n.notifyChannel)
+	for range notifications {
+		n.TriggerRefresh()
+	}
+}
+
 func (n *NetboxConnector) refreshRoutine() {
 	ticker := time.NewTicker(updateInterval)
 	for {
-		err := n.update()
+		changed, err := n.update()
 		if err != nil {
 			n.refreshErrorCount.Add(1)
 			log.Errorf("Failed to refresh Netbox data: %v", err)
@@ -523,96 +635,205 @@ func (n *NetboxConnector) refreshRoutine() {
 			log.Infof("Successfully refreshed Netbox data from %q", n.client.GetDBHost())
 		}
 
-		<-ticker.C
+		if changed {
+			n.changes.Notify()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-n.refreshTrigger.C():
+		}
 	}
 }
 
-func (n *NetboxConnector) update() error {
+// update loads the data changed since the last refresh (or all data if a full resync is due) and reports if anything changed
+func (n *NetboxConnector) update() (bool, error) {
 	startTime := time.Now()
+
+	n.connectorMu.RLock()
+	full := n.lastFullResync.IsZero() || startTime.Sub(n.lastFullResync) >= fullResyncInterval
+	watermarks := make(map[string]time.Time, len(n.watermarks))
+	for objectType, wm := range n.watermarks {
+		watermarks[objectType] = wm
+	}
+	lastRefresh := n.lastRefresh
+	n.connectorMu.RUnlock()
+
+	// since returns the time to fetch changes of the given object type from. A zero time fetches everything.
+	since := func(objectType string) time.Time {
+		if full || watermarks[objectType].IsZero() {
+			return time.Time{}
+		}
+
+		return watermarks[objectType].Add(-refreshOverlap)
+	}
+
 	err := n.client.Connect()
 	if err != nil {
-		return fmt.Errorf("failed to connect: %v", err)
+		return false, fmt.Errorf("failed to connect: %v", err)
 	}
 
-	devices, err := n.client.GetDevices()
+	devices, err := n.client.GetDevices(since(objectTypeDevice))
 	if err != nil {
-		return fmt.Errorf("unable to get devices: %v", err)
+		return false, fmt.Errorf("unable to get devices: %v", err)
 	}
 
-	interfaces, err := n.client.GetInterfaces()
+	interfaces, err := n.client.GetInterfaces(since(objectTypeInterface))
 	if err != nil {
-		return fmt.Errorf("unable to get interfaces: %v", err)
+		return false, fmt.Errorf("unable to get interfaces: %v", err)
 	}
 
-	ips, err := n.client.GetIPAddresses()
+	ips, err := n.client.GetIPAddresses(since(objectTypeIPAddress))
 	if err != nil {
-		return fmt.Errorf("unable to get IP addresses: %v", err)
+		return false, fmt.Errorf("unable to get IP addresses: %v", err)
 	}
 
-	cables, err := n.client.GetCables()
+	cables, err := n.client.GetCables(since(objectTypeCable))
 	if err != nil {
-		return fmt.Errorf("unable to get cables: %v", err)
+		return false, fmt.Errorf("unable to get cables: %v", err)
 	}
 
-	prefixes, err := n.client.GetPrefixes()
+	prefixes, err := n.client.GetPrefixes(since(objectTypePrefix))
 	if err != nil {
-		return fmt.Errorf("unable to get prefixes: %v", err)
+		return false, fmt.Errorf("unable to get prefixes: %v", err)
 	}
 
-	circuits, err := n.client.GetCircuits()
+	circuits, err := n.client.GetCircuits(since(objectTypeCircuit))
 	if err != nil {
-		return fmt.Errorf("unable to get circuits: %v", err)
+		return false, fmt.Errorf("unable to get circuits: %v", err)
 	}
 
-	cts, err := n.client.GetCircuitTerminations()
+	cts, err := n.client.GetCircuitTerminations(since(objectTypeCircuitTermination))
 	if err != nil {
-		return fmt.Errorf("unable to get circuit terminations: %v", err)
+		return false, fmt.Errorf("unable to get circuit terminations: %v", err)
 	}
 
-	fps, err := n.client.GetFrontPorts()
+	fps, err := n.client.GetFrontPorts(since(objectTypeFrontPort))
 	if err != nil {
-		return fmt.Errorf("unable to get front ports: %v", err)
+		return false, fmt.Errorf("unable to get front ports: %v", err)
 	}
 
-	rps, err := n.client.GetRearPorts()
+	rps, err := n.client.GetRearPorts(since(objectTypeRearPort))
 	if err != nil {
-		return fmt.Errorf("unable to get rear ports: %v", err)
+		return false, fmt.Errorf("unable to get rear ports: %v", err)
+	}
+
+	deleted := make(map[string][]int64)
+	if !full {
+		deleted, err = n.client.GetDeletedObjects(lastRefresh.Add(-refreshOverlap))
+		if err != nil {
+			return false, fmt.Errorf("unable to get deleted objects: %v", err)
+		}
 	}
 
 	n.connectorMu.Lock()
 	defer n.connectorMu.Unlock()
 
-	n.devices = make(map[int64]*dbModel.DcimDevice)
-	for _, d := range devices {
-		n.devices[d.ID] = d
+	if full {
+		n._reset()
+	}
+
+	modified := make(map[string]int)
+	n.devices, modified[objectTypeDevice] = mergeByID(n.devices, devices, deleted[objectTypeDevice], deviceRow)
+	n.interfaces, modified[objectTypeInterface] = mergeByID(n.interfaces, mapValues(interfaces), deleted[objectTypeInterface], interfaceRow)
+	n.ipAddresses, modified[objectTypeIPAddress] = mergeSortedByID(n.ipAddresses, ips, deleted[objectTypeIPAddress], ipAddressRow)
+	n.cables, modified[objectTypeCable] = mergeSortedByID(n.cables, cables, deleted[objectTypeCable], cableRow)
+	n.prefixes, modified[objectTypePrefix] = mergeSortedByID(n.prefixes, prefixes, deleted[objectTypePrefix], prefixRow)
+	n.circuits, modified[objectTypeCircuit] = mergeByID(n.circuits, circuits, deleted[objectTypeCircuit], circuitRow)
+	n.circuitTerminations, modified[objectTypeCircuitTermination] = mergeByID(n.circuitTerminations, cts, deleted[objectTypeCircuitTermination], circuitTerminationRow)
+	n.frontPorts, modified[objectTypeFrontPort] = mergeByID(n.frontPorts, fps, deleted[objectTypeFrontPort], frontPortRow)
+	n.rearPorts, modified[objectTypeRearPort] = mergeByID(n.rearPorts, rps, deleted[objectTypeRearPort], rearPortRow)
+	n._relinkInterfaces()
+
+	n._updateWatermark(objectTypeDevice, deviceRow.maxLastUpdated(devices))
+	n._updateWatermark(objectTypeInterface, interfaceRow.maxLastUpdated(mapValues(interfaces)))
+	n._updateWatermark(objectTypeIPAddress, ipAddressRow.maxLastUpdated(ips))
+	n._updateWatermark(objectTypeCable, cableRow.maxLastUpdated(cables))
+	n._updateWatermark(objectTypePrefix, prefixRow.maxLastUpdated(prefixes))
+	n._updateWatermark(objectTypeCircuit, circuitRow.maxLastUpdated(circuits))
+	n._updateWatermark(objectTypeCircuitTermination, circuitTerminationRow.maxLastUpdated(cts))
+	n._updateWatermark(objectTypeFrontPort, frontPortRow.maxLastUpdated(fps))
+	n._updateWatermark(objectTypeRearPort, rearPortRow.maxLastUpdated(rps))
+
+	// A full resync may carry changes of related objects (e.g. a renamed site) not visible in last_updated, so it always counts as a change
+	changed := full
+	stats := make(map[string]tableRefreshStats)
+	for objectType, fetched := range map[string]int{
+		objectTypeDevice:             len(devices),
+		objectTypeInterface:          len(interfaces),
+		objectTypeIPAddress:          len(ips),
+		objectTypeCable:              len(cables),
+		objectTypePrefix:             len(prefixes),
+		objectTypeCircuit:            len(circuits),
+		objectTypeCircuitTermination: len(cts),
+		objectTypeFrontPort:          len(fps),
+		objectTypeRearPort:           len(rps),
+	} {
+		stats[objectType] = tableRefreshStats{
+			fetched:  fetched,
+			deleted:  len(deleted[objectType]),
+			modified: modified[objectType],
+		}
+
+		if modified[objectType] > 0 {
+			changed = true
+		}
+	}
+
+	n.lastRefreshStats = stats
+	n.lastRefresh = startTime
+	if full {
+		n.lastFullResync = startTime
+		n.fullRefreshCount++
+	} else {
+		n.incrementalRefreshCount++
 	}
 
-	n.interfaces = interfaces
-	n.ipAddresses = ips
-	n.cables = cables
-	n.prefixes = prefixes
-	n.circuits = make(map[int64]*dbModel.CircuitsCircuit)
-	for _, ckt := range circuits {
-		n.circuits[ckt.ID] = ckt
-	}
+	n.loadDuration = time.Since(startTime)
+	n.loadTime = time.Now()
 
-	n.circuitTerminations = make(map[int64]*dbModel.CircuitsCircuittermination)
-	for _, ct := range cts {
-		n.circuitTerminations[ct.ID] = ct
+	if changed {
+		n.dataGeneration.Add(1)
 	}
 
-	n.frontPorts = make(map[int64]*dbModel.DcimFrontport)
-	for _, fp := range fps {
-		n.frontPorts[fp.ID] = fp
-	}
+	return changed, nil
+}
 
-	n.rearPorts = make(map[int64]*dbModel.DcimRearport)
-	for _, rp := range rps {
-		n.rearPorts[rp.ID] = rp
-	}
+// _reset drops all cached data and watermarks, so the next merge starts from scratch
+func (n *NetboxConnector) _reset() {
+	n.devices = nil
+	n.interfaces = nil
+	n.ipAddresses = nil
+	n.cables = nil
+	n.prefixes = nil
+	n.circuits = nil
+	n.circuitTerminations = nil
+	n.frontPorts = nil
+	n.rearPorts = nil
+	n.watermarks = make(map[string]time.Time)
+}
 
-	n.loadDuration = time.Since(startTime)
-	n.loadTime = time.Now()
+// _relinkInterfaces points the device, parent and LAG references of all interfaces to the cached objects.
+// Renaming a device or interface does not touch the last_updated of the interfaces referencing it, so the
+// references fetched with the interfaces may be outdated after an incremental refresh.
+func (n *NetboxConnector) _relinkInterfaces() {
+	for _, ifa := range n.interfaces {
+		if d, exists := n.devices[ifa.DeviceID]; exists {
+			ifa.Device.Name = d.Name
+		}
 
-	return nil
+		if parent, exists := n.interfaces[ifa.ParentID]; exists {
+			ifa.Parent = parent
+		}
+
+		if lag, exists := n.interfaces[ifa.LagID]; exists {
+			ifa.LAG = lag
+		}
+	}
+}
+
+func (n *NetboxConnector) _updateWatermark(objectType string, lastUpdated time.Time) {
+	if lastUpdated.After(n.watermarks[objectType]) {
+		n.watermarks[objectType] = lastUpdated
+	}
 }
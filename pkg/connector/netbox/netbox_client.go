@@ -9,6 +9,7 @@ package netbox
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cloudflare/octopus/pkg/connector/netbox/model"
 )
@@ -30,8 +31,25 @@ func (nbc *NetboxClient) GetDBHost() string {
 	return nbc.db.params.host
 }
 
-func (nbc *NetboxClient) GetDevices() ([]*model.DcimDevice, error) {
-	devices, err := nbc.db.getDevices()
+// Listen returns a channel signaling notifications sent to the given Postgres channel
+func (nbc *NetboxClient) Listen(channel string) (<-chan struct{}, error) {
+	notifications, err := nbc.db.listen(channel)
+	if err != nil {
+		return nil, fmt.Errorf("unable to listen on %q: %v", channel, err)
+	}
+
+	ret := make(chan struct{})
+	go func() {
+		for range notifications {
+			ret <- struct{}{}
+		}
+	}()
+
+	return ret, nil
+}
+
+func (nbc *NetboxClient) GetDevices(since time.Time) ([]*model.DcimDevice, error) {
+	devices, err := nbc.db.getDevices(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get devices: %v", err)
 	}
@@ -39,8 +57,8 @@ func (nbc *NetboxClient) GetDevices() ([]*model.DcimDevice, error) {
 	return devices, nil
 }
 
-func (nbc *NetboxClient) GetInterfaces() (map[int64]*model.DcimInterface, error) {
-	interfaces, err := nbc.db.getInterfaces()
+func (nbc *NetboxClient) GetInterfaces(since time.Time) (map[int64]*model.DcimInterface, error) {
+	interfaces, err := nbc.db.getInterfaces(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get interfaces: %v", err)
 	}
@@ -48,8 +66,8 @@ func (nbc *NetboxClient) GetInterfaces() (map[int64]*model.DcimInterface, error)
 	return interfaces, nil
 }
 
-func (nbc *NetboxClient) GetIPAddresses() ([]*model.IpamIpaddress, error) {
-	addrs, err := nbc.db.getIPAddresses()
+func (nbc *NetboxClient) GetIPAddresses(since time.Time) ([]*model.IpamIpaddress, error) {
+	addrs, err := nbc.db.getIPAddresses(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get ip addresses: %v", err)
 	}
@@ -57,8 +75,8 @@ func (nbc *NetboxClient) GetIPAddresses() ([]*model.IpamIpaddress, error) {
 	return addrs, nil
 }
 
-func (nbc *NetboxClient) GetCables() ([]*model.DcimCable, error) {
-	cables, err := nbc.db.getCables()
+func (nbc *NetboxClient) GetCables(since time.Time) ([]*model.DcimCable, error) {
+	cables, err := nbc.db.getCables(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get cables: %v", err)
 	}
@@ -66,8 +84,8 @@ func (nbc *NetboxClient) GetCables() ([]*model.DcimCable, error) {
 	return cables, nil
 }
 
-func (nbc *NetboxClient) GetPrefixes() ([]*model.IpamPrefix, error) {
-	prefixes, err := nbc.db.getPrefixes()
+func (nbc *NetboxClient) GetPrefixes(since time.Time) ([]*model.IpamPrefix, error) {
+	prefixes, err := nbc.db.getPrefixes(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get prefixes: %v", err)
 	}
@@ -75,8 +93,8 @@ func (nbc *NetboxClient) GetPrefixes() ([]*model.IpamPrefix, error) {
 	return prefixes, nil
 }
 
-func (nbc *NetboxClient) GetCircuits() ([]*model.CircuitsCircuit, error) {
-	circuits, err := nbc.db.getCircuits()
+func (nbc *NetboxClient) GetCircuits(since time.Time) ([]*model.CircuitsCircuit, error) {
+	circuits, err := nbc.db.getCircuits(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get circuits: %v", err)
 	}
@@ -84,8 +102,8 @@ func (nbc *NetboxClient) GetCircuits() ([]*model.CircuitsCircuit, error) {
 	return circuits, nil
 }
 
-func (nbc *NetboxClient) GetCircuitTerminations() ([]*model.CircuitsCircuittermination, error) {
-	cts, err := nbc.db.getCircuitTerminations()
+func (nbc *NetboxClient) GetCircuitTerminations(since time.Time) ([]*model.CircuitsCircuittermination, error) {
+	cts, err := nbc.db.getCircuitTerminations(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get circuit terminations: %v", err)
 	}
@@ -93,8 +111,8 @@ func (nbc *NetboxClient) GetCircuitTerminations() ([]*model.CircuitsCircuittermi
 	return cts, nil
 }
 
-func (nbc *NetboxClient) GetFrontPorts() ([]*model.DcimFrontport, error) {
-	fps, err := nbc.db.getFrontports()
+func (nbc *NetboxClient) GetFrontPorts(since time.Time) ([]*model.DcimFrontport, error) {
+	fps, err := nbc.db.getFrontports(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get front ports: %v", err)
 	}
@@ -102,8 +120,8 @@ func (nbc *NetboxClient) GetFrontPorts() ([]*model.DcimFrontport, error) {
 	return fps, nil
 }
 
-func (nbc *NetboxClient) GetRearPorts() ([]*model.DcimRearport, error) {
-	rps, err := nbc.db.getRearports()
+func (nbc *NetboxClient) GetRearPorts(since time.Time) ([]*model.DcimRearport, error) {
+	rps, err := nbc.db.getRearports(since)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get front ports: %v", err)
 	}
@@ -111,6 +129,15 @@ func (nbc *NetboxClient) GetRearPorts() ([]*model.DcimRearport, error) {
 	return rps, nil
 }
 
+func (nbc *NetboxClient) GetDeletedObjects(since time.Time) (map[string][]int64, error) {
+	deleted, err := nbc.db.getDeletedObjects(since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get deleted objects: %v", err)
+	}
+
+	return deleted, nil
+}
+
 func (nbc *NetboxClient) GetDcimInterfaceTypeID() int32 {
 	return nbc.db.contentTypeDcimInterface
 }
@@ -1049,3 +1049,154 @@ func TestEnrichment(t *testing.T) {
 		assert.Equal(t, test.expected, test.t.ToProto(), test.name)
 	}
 }
+
+// fakeNetboxClient serves objects from memory, honoring the since parameter like the DB and API clients do
+type fakeNetboxClient struct {
+	devices    []*dbModel.DcimDevice
+	interfaces []*dbModel.DcimInterface
+	deleted    map[string][]int64
+	since      []time.Time
+}
+
+func (f *fakeNetboxClient) Connect() error    { return nil }
+func (f *fakeNetboxClient) GetDBHost() string { return "fake" }
+
+func (f *fakeNetboxClient) GetDevices(since time.Time) ([]*dbModel.DcimDevice, error) {
+	f.since = append(f.since, since)
+	ret := make([]*dbModel.DcimDevice, 0)
+	for _, d := range f.devices {
+		if !d.LastUpdated.Before(since) {
+			ret = append(ret, d)
+		}
+	}
+
+	return ret, nil
+}
+
+func (f *fakeNetboxClient) GetInterfaces(since time.Time) (map[int64]*dbModel.DcimInterface, error) {
+	ret := make(map[int64]*dbModel.DcimInterface)
+	for _, ifa := range f.interfaces {
+		if !ifa.LastUpdated.Before(since) {
+			ret[ifa.ID] = ifa
+		}
+	}
+
+	return ret, nil
+}
+
+func (f *fakeNetboxClient) GetIPAddresses(since time.Time) ([]*dbModel.IpamIpaddress, error) {
+	return nil, nil
+}
+
+func (f *fakeNetboxClient) GetCables(since time.Time) ([]*dbModel.DcimCable, error) {
+	return nil, nil
+}
+
+func (f *fakeNetboxClient) GetPrefixes(since time.Time) ([]*dbModel.IpamPrefix, error) {
+	return nil, nil
+}
+
+func (f *fakeNetboxClient) GetCircuits(since time.Time) ([]*dbModel.CircuitsCircuit, error) {
+	return nil, nil
+}
+
+func (f *fakeNetboxClient) GetCircuitTerminations(since time.Time) ([]*dbModel.CircuitsCircuittermination, error) {
+	return nil, nil
+}
+
+func (f *fakeNetboxClient) GetFrontPorts(since time.Time) ([]*dbModel.DcimFrontport, error) {
+	return nil, nil
+}
+
+func (f *fakeNetboxClient) GetRearPorts(since time.Time) ([]*dbModel.DcimRearport, error) {
+	return nil, nil
+}
+
+func (f *fakeNetboxClient) GetDeletedObjects(since time.Time) (map[string][]int64, error) {
+	return f.deleted, nil
+}
+
+func (f *fakeNetboxClient) GetDcimInterfaceTypeID() int32              { return 2 }
+func (f *fakeNetboxClient) GetCircuitsCircuitterminationTypeID() int32 { return 5 }
+func (f *fakeNetboxClient) GetDcimFrontPortTypeID() int32              { return 6 }
+func (f *fakeNetboxClient) GetDcimRearPortTypeID() int32               { return 7 }
+
+func TestIncrementalUpdate(t *testing.T) {
+	t0 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newDevice := func(id int64, name string, lastUpdated time.Time) *dbModel.DcimDevice {
+		return &dbModel.DcimDevice{
+			ID:          id,
+			Name:        name,
+			LastUpdated: lastUpdated,
+			Site:        dbModel.DcimSite{Name: "DUS01"},
+		}
+	}
+
+	c := &fakeNetboxClient{
+		devices: []*dbModel.DcimDevice{
+			newDevice(1, "ccr01.dus01", t0),
+			newDevice(2, "edge01.dus01", t0.Add(time.Hour)),
+		},
+		interfaces: []*dbModel.DcimInterface{
+			{
+				ID:          10,
+				Name:        "et-0/0/0",
+				DeviceID:    1,
+				Device:      dbModel.DcimDevice{ID: 1, Name: "ccr01.dus01"},
+				LastUpdated: t0,
+			},
+		},
+	}
+
+	n := newNetboxConnectorWithClient(c)
+	changed, err := n.update()
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, time.Time{}, c.since[0], "initial load must fetch everything")
+	assert.Equal(t, 2, len(n.devices))
+	assert.Equal(t, uint64(1), n.fullRefreshCount)
+
+	// ccr01.dus01 gets renamed, edge01.dus01 gets deleted and a new device gets added
+	c.devices = []*dbModel.DcimDevice{
+		newDevice(1, "ccr02.dus01", t0.Add(2*time.Hour)),
+		newDevice(3, "edge02.dus01", t0.Add(2*time.Hour)),
+	}
+	c.deleted = map[string][]int64{
+		objectTypeDevice: {2},
+	}
+
+	changed, err = n.update()
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, t0.Add(time.Hour).Add(-refreshOverlap), c.since[1])
+	assert.Equal(t, uint64(1), n.incrementalRefreshCount)
+	assert.Equal(t, tableRefreshStats{fetched: 2, deleted: 1, modified: 3}, n.lastRefreshStats[objectTypeDevice])
+	assert.Equal(t, tableRefreshStats{fetched: 1}, n.lastRefreshStats[objectTypeInterface], "unchanged interface fetched again due to the overlap")
+	assert.Equal(t, uint64(2), n.GetDataGeneration())
+	assert.Equal(t, t0.Add(2*time.Hour), n.watermarks[objectTypeDevice])
+
+	topology := model.NewTopology()
+	assert.NoError(t, n.EnrichTopology(topology))
+	assert.Nil(t, topology.GetDevice("ccr01.dus01"))
+	assert.Nil(t, topology.GetDevice("edge01.dus01"))
+	assert.NotNil(t, topology.GetDevice("edge02.dus01"))
+	assert.NotNil(t, topology.GetDevice("ccr02.dus01").GetInterface("et-0/0/0"), "interface must follow the renamed device")
+
+	// Nothing changed since, the rows within the overlap are fetched again but don't change the data
+	c.deleted = nil
+	changed, err = n.update()
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, uint64(2), n.GetDataGeneration())
+
+	// A full resync drops everything not returned anymore
+	n.lastFullResync = time.Now().Add(-fullResyncInterval)
+	c.devices = c.devices[:1]
+	c.deleted = nil
+	changed, err = n.update()
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, time.Time{}, c.since[3])
+	assert.Equal(t, 1, len(n.devices))
+	assert.Equal(t, uint64(2), n.fullRefreshCount)
+}
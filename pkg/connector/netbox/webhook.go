@@ -0,0 +1,61 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package netbox
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	webhookSignatureHeader = "X-Hook-Signature"
+	maxWebhookBodySize     = 1 << 20
+)
+
+// WebhookHandler returns a HTTP handler receiving NetBox webhooks, triggering an immediate refresh.
+// If secret is set, requests have to carry a valid HMAC-SHA512 signature of the body as NetBox sends it.
+func (n *NetboxConnector) WebhookHandler(secret string) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			rw.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(req.Body, maxWebhookBodySize))
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if secret != "" && !validSignature(secret, body, req.Header.Get(webhookSignatureHeader)) {
+			log.Warnf("Rejecting Netbox webhook from %s with invalid signature", req.RemoteAddr)
+			rw.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		n.TriggerRefresh()
+		rw.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func validSignature(secret string, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,85 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package netbox
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	const secret = "s3cr3t"
+	const body = `{"event": "updated", "model": "device", "data": {"id": 1}}`
+
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write([]byte(body))
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name          string
+		secret        string
+		method        string
+		signature     string
+		expected      int
+		wantTriggered bool
+	}{
+		{
+			name:     "wrong method",
+			method:   http.MethodGet,
+			expected: http.StatusMethodNotAllowed,
+		},
+		{
+			name:          "no secret configured",
+			method:        http.MethodPost,
+			expected:      http.StatusNoContent,
+			wantTriggered: true,
+		},
+		{
+			name:      "invalid signature",
+			secret:    secret,
+			method:    http.MethodPost,
+			signature: "deadbeef",
+			expected:  http.StatusForbidden,
+		},
+		{
+			name:          "valid signature",
+			secret:        secret,
+			method:        http.MethodPost,
+			signature:     signature,
+			expected:      http.StatusNoContent,
+			wantTriggered: true,
+		},
+	}
+
+	for _, test := range tests {
+		n := newNetboxConnectorWithClient(&fakeNetboxClient{})
+
+		req := httptest.NewRequest(test.method, "/webhooks/netbox", strings.NewReader(body))
+		req.Header.Set(webhookSignatureHeader, test.signature)
+		rec := httptest.NewRecorder()
+		n.WebhookHandler(test.secret).ServeHTTP(rec, req)
+
+		assert.Equal(t, test.expected, rec.Code, test.name)
+
+		triggered := false
+		select {
+		case <-n.refreshTrigger.C():
+			triggered = true
+		default:
+		}
+
+		assert.Equal(t, test.wantTriggered, triggered, test.name)
+	}
+}
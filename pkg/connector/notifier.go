@@ -0,0 +1,33 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package connector
+
+// Notifier signals events to a single consumer. Notifications sent while the previous one has not been consumed yet
+// are coalesced, so the sender never blocks.
+type Notifier struct {
+	ch chan struct{}
+}
+
+func NewNotifier() *Notifier {
+	return &Notifier{
+		ch: make(chan struct{}, 1),
+	}
+}
+
+// Notify signals the consumer
+func (n *Notifier) Notify() {
+	select {
+	case n.ch <- struct{}{}:
+	default:
+	}
+}
+
+// C returns the channel the notifications are delivered on
+func (n *Notifier) C() <-chan struct{} {
+	return n.ch
+}
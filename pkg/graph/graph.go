@@ -0,0 +1,55 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+// Package graph serves the topology via GraphQL. The schema is defined in schema.graphqls and bound to the types
+// of pkg/model, the executor and the resolver stubs are generated with gqlgen (see gqlgen.yml).
+package graph
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/cloudflare/octopus/pkg/model"
+)
+
+// TopologySource provides the topology snapshots queries are answered from
+type TopologySource interface {
+	// GetTopology returns the current topology, nil if there is none yet
+	GetTopology() *model.Topology
+	// GetSnapshot returns the retained snapshot of the given generation, nil if there is none
+	GetSnapshot(generation uint64) *model.Topology
+	// GetSnapshotAt returns the latest retained snapshot built at or before the given time, nil if there is none
+	GetSnapshotAt(ts time.Time) *model.Topology
+}
+
+// Resolver resolves queries by walking the topology snapshots of the source
+type Resolver struct {
+	source TopologySource
+}
+
+// NewHandler returns the handler serving GraphQL queries via GET and POST.
+// Queries nesting deeper than maxDepth or more complex than maxComplexity are rejected before being executed.
+func NewHandler(source TopologySource, maxDepth int, maxComplexity int) http.Handler {
+	cfg := Config{
+		Resolvers: &Resolver{
+			source: source,
+		},
+	}
+	setListComplexity(&cfg.Complexity, &listSizer{source: source})
+
+	srv := handler.New(NewExecutableSchema(cfg))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(depthLimit{maxDepth: maxDepth})
+	srv.Use(extension.FixedComplexityLimit(maxComplexity))
+
+	return srv
+}
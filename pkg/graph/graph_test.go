@@ -0,0 +1,344 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+)
+
+type fakeSource struct {
+	topology *model.Topology
+}
+
+func (s *fakeSource) GetTopology() *model.Topology {
+	return s.topology
+}
+
+func (s *fakeSource) GetSnapshot(generation uint64) *model.Topology {
+	if s.topology != nil && s.topology.Generation == generation {
+		return s.topology
+	}
+
+	return nil
+}
+
+func (s *fakeSource) GetSnapshotAt(ts time.Time) *model.Topology {
+	if s.topology != nil && !s.topology.Timestamp.After(ts) {
+		return s.topology
+	}
+
+	return nil
+}
+
+func interfaceEnd(devName string, ifName string) model.CableEnd {
+	return model.CableEnd{
+		DeviceName:   devName,
+		EndpointName: ifName,
+		EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE,
+	}
+}
+
+// newTestTopology creates ccr01.dus01 with ae0 (et-0/0/0 and et-0/0/1) cabled to edge01.dus01
+func newTestTopology() *model.Topology {
+	t := model.NewTopology()
+	t.Generation = 42
+	t.Timestamp = time.Unix(1700000000, 0)
+
+	colo := t.AddColoIfNotExists(1, "dus01", "dus01-a")
+	site := t.AddSiteIfNotExists("DUS01")
+
+	for name, role := range map[string]string{"ccr01.dus01": "ccr", "edge01.dus01": "edge"} {
+		d := t.AddDeviceIfNotExists(name)
+		d.Role = role
+		d.Colo = colo
+		d.Site = site
+
+		d.AddInterfaceItNotExists("ae0")
+		for _, ifName := range []string{"et-0/0/0", "et-0/0/1"} {
+			d.AddInterfaceItNotExists(ifName).LAGMemberOf = "ae0"
+		}
+	}
+
+	ccr := t.GetDevice("ccr01.dus01")
+	ccr.MetaData.SemanticTags["NET:ASN"] = "13335"
+	ccr.GetInterface("ae0").AddIPAddressIfNotExists(model.NewVLANTag(0, 100), model.NewIP(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 31)))
+
+	for _, ifName := range []string{"et-0/0/0", "et-0/0/1"} {
+		c := &model.Cable{
+			AEnd: interfaceEnd("ccr01.dus01", ifName),
+			BEnd: interfaceEnd("edge01.dus01", ifName),
+		}
+		t.Cables[c.String()] = c
+	}
+
+	t.ComputeLogicalLinks()
+	return t
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func TestQueries(t *testing.T) {
+	tests := []struct {
+		name          string
+		topology      *model.Topology
+		query         string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:          "not ready",
+			query:         `{ topology { generation } }`,
+			expected:      `{"topology": null}`,
+			expectedError: "octopus not ready",
+		},
+		{
+			name:     "current topology",
+			topology: newTestTopology(),
+			query:    `{ topology { generation timestamp sites { name } colos { name pop { name } } } }`,
+			expected: `{"topology": {"generation": 42, "timestamp": "2023-11-14T22:13:20Z", "sites": [{"name": "DUS01"}], "colos": [{"name": "dus01", "pop": {"name": "dus01-a"}}]}}`,
+		},
+		{
+			name:          "unknown snapshot",
+			topology:      newTestTopology(),
+			query:         `{ topology(generation: 23) { generation } }`,
+			expected:      `{"topology": null}`,
+			expectedError: "snapshot not found",
+		},
+		{
+			name:     "device",
+			topology: newTestTopology(),
+			query: `{ topology(generation: 42) { device(name: "ccr01.dus01") {
+				name role colo { name } site { name }
+				metaData { semanticTag(key: "NET:ASN") }
+				interface(name: "ae0") { members { name } units { innerTag ipv4Addresses { address } } }
+			} } }`,
+			expected: `{"topology": {"device": {
+				"name": "ccr01.dus01", "role": "ccr", "colo": {"name": "dus01"}, "site": {"name": "DUS01"},
+				"metaData": {"semanticTag": "13335"},
+				"interface": {"members": [{"name": "et-0/0/0"}, {"name": "et-0/0/1"}], "units": [{"innerTag": 100, "ipv4Addresses": [{"address": "192.0.2.0/31"}]}]}
+			}}}`,
+		},
+		{
+			name:     "unknown device",
+			topology: newTestTopology(),
+			query:    `{ topology { device(name: "ccr42.dus01") { name } } }`,
+			expected: `{"topology": {"device": null}}`,
+		},
+		{
+			name:     "cable end traversal",
+			topology: newTestTopology(),
+			query: `{ topology { device(name: "ccr01.dus01") { interface(name: "et-0/0/1") {
+				lag { name }
+				peer { endpointName endpointType device { name role } interface { lag { name } } }
+				cable { aEnd { deviceName } bEnd { deviceName } }
+			} } } }`,
+			expected: `{"topology": {"device": {"interface": {
+				"lag": {"name": "ae0"},
+				"peer": {"endpointName": "et-0/0/1", "endpointType": "INTERFACE", "device": {"name": "edge01.dus01", "role": "edge"}, "interface": {"lag": {"name": "ae0"}}},
+				"cable": {"aEnd": {"deviceName": "ccr01.dus01"}, "bEnd": {"deviceName": "edge01.dus01"}}
+			}}}}`,
+		},
+		{
+			name:     "neighbors",
+			topology: newTestTopology(),
+			query:    `{ topology { device(name: "edge01.dus01") { neighbors(interface: "ae0") { localInterfaceName localLAG remoteLAG device { name } interface { name } } } } }`,
+			expected: `{"topology": {"device": {"neighbors": [
+				{"localInterfaceName": "et-0/0/0", "localLAG": "ae0", "remoteLAG": "ae0", "device": {"name": "ccr01.dus01"}, "interface": {"name": "et-0/0/0"}},
+				{"localInterfaceName": "et-0/0/1", "localLAG": "ae0", "remoteLAG": "ae0", "device": {"name": "ccr01.dus01"}, "interface": {"name": "et-0/0/1"}}
+			]}}}`,
+		},
+		{
+			name:     "device filter",
+			topology: newTestTopology(),
+			query:    `{ topology { devices(filter: {names: ["*.dus01"], nameMatch: GLOB, roles: ["edge"]}) { name } } }`,
+			expected: `{"topology": {"devices": [{"name": "edge01.dus01"}]}}`,
+		},
+		{
+			name:          "invalid device filter",
+			topology:      newTestTopology(),
+			query:         `{ topology { devices(filter: {names: ["("], nameMatch: REGEX}) { name } } }`,
+			expected:      `{"topology": null}`,
+			expectedError: "invalid regular expression",
+		},
+		{
+			name:          "too deep",
+			topology:      newTestTopology(),
+			query:         `{ topology { device(name: "ccr01.dus01") { interface(name: "et-0/0/0") { peer { device { interface(name: "et-0/0/0") { peer { device { name } } } } } } } } }`,
+			expected:      `null`,
+			expectedError: "operation has depth 9, which exceeds the limit of 8",
+		},
+		{
+			name:     "pagination",
+			topology: newTestTopology(),
+			query:    `{ topology { devices(first: 1, offset: 1) { name interfaces(first: 2) { name } } cables(offset: 1) { aEnd { endpointName } } } }`,
+			expected: `{"topology": {
+				"devices": [{"name": "edge01.dus01", "interfaces": [{"name": "ae0"}, {"name": "et-0/0/0"}]}],
+				"cables": [{"aEnd": {"endpointName": "et-0/0/1"}}]
+			}}`,
+		},
+		{
+			name:          "invalid pagination",
+			topology:      newTestTopology(),
+			query:         `{ topology { prefixes(first: -1) { prefix } } }`,
+			expected:      `{"topology": null}`,
+			expectedError: "first must not be negative",
+		},
+		{
+			// 2 devices with up to 3 interfaces with up to 1 unit, addresses count 10 times
+			name:          "too complex",
+			topology:      newTestTopology(),
+			query:         `{ topology { devices { interfaces { units { ipv4Addresses { address } ipv6Addresses { address } } } } } }`,
+			expected:      `null`,
+			expectedError: "operation has complexity 121, which exceeds the limit of 100",
+		},
+		{
+			name:     "complexity limited by first",
+			topology: newTestTopology(),
+			query:    `{ topology { devices(first: 1) { interfaces { units { ipv4Addresses { address } ipv6Addresses { address } } } } } }`,
+			expected: `{"topology": {"devices": [{"interfaces": [{"units": [{"ipv4Addresses": [{"address": "192.0.2.0/31"}], "ipv6Addresses": []}]}, {"units": []}, {"units": []}]}]}}`,
+		},
+	}
+
+	for _, test := range tests {
+		h := NewHandler(&fakeSource{topology: test.topology}, 8, 100)
+
+		body, _ := json.Marshal(map[string]string{"query": test.query})
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		resp := &response{}
+		err := json.Unmarshal(rec.Body.Bytes(), resp)
+		assert.NoError(t, err, test.name)
+		assert.JSONEq(t, test.expected, string(resp.Data), test.name)
+
+		if test.expectedError == "" {
+			assert.Empty(t, resp.Errors, test.name)
+			continue
+		}
+
+		if assert.Len(t, resp.Errors, 1, test.name) {
+			assert.Contains(t, resp.Errors[0].Message, test.expectedError, test.name)
+		}
+	}
+}
+
+// TestSchemaCoversModel fails if an exported field of a pkg/model type served via GraphQL has no field in schema.graphqls,
+// so the schema is extended (or the field deliberately left out below) whenever the model grows.
+func TestSchemaCoversModel(t *testing.T) {
+	schema := NewExecutableSchema(Config{}).Schema()
+
+	// Model fields served under another name
+	renamed := map[string]string{
+		"Topology.Nodes":        "devices",
+		"Interface.LAGMemberOf": "lag",
+	}
+
+	// Model fields deliberately not served via GraphQL
+	omitted := map[string]string{
+		"Topology.Interfaces":           "index of NetBox interface IDs",
+		"Topology.DevicesByInterfaceID": "index of NetBox interface IDs",
+		"Topology.MergeConflicts":       "served by GetMergeConflicts",
+		"Topology.LogicalLinks":         "served as neighbors of devices and interfaces",
+	}
+
+	for _, entity := range []string{"Colo", "Device", "Interface", "InterfaceUnit", "Cable", "Circuit", "Prefix"} {
+		omitted[entity+".Provenance"] = "served with include_provenance by the gRPC and REST APIs"
+	}
+
+	for _, entity := range []string{"Device", "Interface", "InterfaceUnit", "IP", "Cable", "Circuit", "Prefix"} {
+		omitted[entity+".SourceID"] = "served with include_provenance by the gRPC and REST APIs"
+	}
+
+	modelPkg := reflect.TypeOf(model.Topology{}).PkgPath()
+	seen := make(map[reflect.Type]bool)
+	queue := []reflect.Type{reflect.TypeOf(model.Topology{}), reflect.TypeOf(model.Neighbor{})}
+	checked := make(map[string]bool)
+
+	for len(queue) > 0 {
+		rt := queue[0]
+		queue = queue[1:]
+		if seen[rt] {
+			continue
+		}
+
+		seen[rt] = true
+		def := schema.Types[rt.Name()]
+		if !assert.NotNil(t, def, "model.%s has no type in the schema", rt.Name()) {
+			continue
+		}
+
+		for _, f := range reflect.VisibleFields(rt) {
+			if !f.IsExported() || f.Anonymous {
+				continue
+			}
+
+			name := rt.Name() + "." + f.Name
+			if _, exists := omitted[name]; exists {
+				checked[name] = true
+				continue
+			}
+
+			gqlName, exists := renamed[name]
+			if exists {
+				checked[name] = true
+			} else {
+				gqlName = f.Name
+			}
+
+			assert.True(t, hasField(def, gqlName), "%s has no field in the schema", name)
+
+			// Follow the field to the model types it references
+			ft := f.Type
+			for ft.Kind() == reflect.Pointer || ft.Kind() == reflect.Slice || ft.Kind() == reflect.Map {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct && ft.PkgPath() == modelPkg {
+				queue = append(queue, ft)
+			}
+		}
+	}
+
+	for name := range renamed {
+		assert.True(t, checked[name], "%s is renamed but no model field", name)
+	}
+
+	for name := range omitted {
+		assert.True(t, checked[name], "%s is omitted but no model field", name)
+	}
+}
+
+func hasField(def *ast.Definition, name string) bool {
+	for _, f := range def.Fields {
+		if strings.EqualFold(f.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,225 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package graph
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// Estimated number of items of the lists of an object not covered by listSizes (colos of a site, neighbors of a device, ...)
+	objectListSize = 10
+
+	errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+)
+
+// listSizes holds the number of items of the lists of a topology, which are the multipliers of the complexity of list fields
+type listSizes struct {
+	topology *model.Topology
+
+	sites    int
+	pops     int
+	colos    int
+	devices  int
+	cables   int
+	circuits int
+	prefixes int
+
+	// Maximum over all devices and interfaces respectively
+	interfaces int
+	frontPorts int
+	rearPorts  int
+	units      int
+}
+
+func newListSizes(t *model.Topology) *listSizes {
+	s := &listSizes{
+		topology: t,
+	}
+
+	if t == nil {
+		return s
+	}
+
+	s.sites = len(t.Sites)
+	s.pops = len(t.Pops)
+	s.colos = len(t.Colos)
+	s.devices = len(t.Nodes)
+	s.cables = len(t.Cables)
+	s.circuits = len(t.Circuits)
+	s.prefixes = len(t.Prefixes)
+
+	for _, d := range t.Nodes {
+		s.interfaces = max(s.interfaces, len(d.Interfaces))
+		s.frontPorts = max(s.frontPorts, len(d.FrontPorts))
+		s.rearPorts = max(s.rearPorts, len(d.RearPorts))
+
+		for _, ifa := range d.Interfaces {
+			s.units = max(s.units, len(ifa.Units))
+		}
+	}
+
+	return s
+}
+
+// listSizer provides the list sizes of the current topology, which are computed once per topology
+type listSizer struct {
+	source TopologySource
+
+	mu    sync.Mutex
+	sizes *listSizes
+}
+
+func (ls *listSizer) get() *listSizes {
+	topology := ls.source.GetTopology()
+
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	if ls.sizes == nil || ls.sizes.topology != topology {
+		ls.sizes = newListSizes(topology)
+	}
+
+	return ls.sizes
+}
+
+// listComplexity returns the complexity of a list of the given size, or of first items if that is smaller
+func listComplexity(childComplexity int, size int, first *int) int {
+	if first != nil && *first < size {
+		size = max(*first, 0)
+	}
+
+	if childComplexity > 0 && size > math.MaxInt/childComplexity {
+		return math.MaxInt
+	}
+
+	return size * childComplexity
+}
+
+// setListComplexity makes the complexity of list fields the complexity of their items times the number of items.
+// Lists of the topology and the potentially long lists of objects (interfaces, ports, units) are sized like the
+// current topology, which is the best estimate for retained snapshots as well, unless limited by their first argument.
+// Other fields have a complexity of 1 plus the complexity of their children.
+func setListComplexity(c *ComplexityRoot, ls *listSizer) {
+	objectList := func(childComplexity int) int {
+		return listComplexity(childComplexity, objectListSize, nil)
+	}
+
+	c.Topology.Sites = func(childComplexity int) int {
+		return listComplexity(childComplexity, ls.get().sites, nil)
+	}
+	c.Topology.Pops = func(childComplexity int) int {
+		return listComplexity(childComplexity, ls.get().pops, nil)
+	}
+	c.Topology.Colos = func(childComplexity int) int {
+		return listComplexity(childComplexity, ls.get().colos, nil)
+	}
+	c.Topology.Devices = func(childComplexity int, _ *DeviceFilterInput, first *int, _ *int) int {
+		return listComplexity(childComplexity, ls.get().devices, first)
+	}
+	c.Topology.Cables = func(childComplexity int, first *int, _ *int) int {
+		return listComplexity(childComplexity, ls.get().cables, first)
+	}
+	c.Topology.Circuits = func(childComplexity int, first *int, _ *int) int {
+		return listComplexity(childComplexity, ls.get().circuits, first)
+	}
+	c.Topology.Prefixes = func(childComplexity int, first *int, _ *int) int {
+		return listComplexity(childComplexity, ls.get().prefixes, first)
+	}
+
+	c.Device.Interfaces = func(childComplexity int, first *int, _ *int) int {
+		return listComplexity(childComplexity, ls.get().interfaces, first)
+	}
+	c.Device.FrontPorts = func(childComplexity int) int {
+		return listComplexity(childComplexity, ls.get().frontPorts, nil)
+	}
+	c.Device.RearPorts = func(childComplexity int) int {
+		return listComplexity(childComplexity, ls.get().rearPorts, nil)
+	}
+	c.Interface.Units = func(childComplexity int) int {
+		return listComplexity(childComplexity, ls.get().units, nil)
+	}
+
+	c.Site.Colos = objectList
+	c.Pop.Colos = objectList
+	c.Colo.Sites = objectList
+	c.Device.Neighbors = func(childComplexity int, _ *string) int {
+		return objectList(childComplexity)
+	}
+	c.Interface.Members = objectList
+	c.Interface.Neighbors = objectList
+	c.InterfaceUnit.IPv4Addresses = objectList
+	c.InterfaceUnit.IPv6Addresses = objectList
+}
+
+// depthLimit rejects operations nesting fields deeper than maxDepth.
+// Deeply nested objects are cheap by complexity as long as no lists are involved, but still expensive to resolve.
+type depthLimit struct {
+	maxDepth int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = depthLimit{}
+
+func (d depthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d depthLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d depthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	depth := selectionDepth(rc.Operation.SelectionSet)
+	if depth > d.maxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.maxDepth)
+		err.Extensions = map[string]any{
+			"code": errDepthLimit,
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// selectionDepth returns the maximum depth of nested fields, ignoring introspection
+func selectionDepth(selections ast.SelectionSet) int {
+	maxDepth := 0
+	for _, s := range selections {
+		depth := 0
+		switch s := s.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+
+			depth = 1 + selectionDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = selectionDepth(s.Definition.SelectionSet)
+			}
+		}
+
+		maxDepth = max(maxDepth, depth)
+	}
+
+	return maxDepth
+}
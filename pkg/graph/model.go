@@ -0,0 +1,186 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package graph
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// The objects below wrap their model counterparts together with the topology they are part of,
+// so resolvers can follow references by name (e.g. the device of a cable end) to other objects.
+
+type Device struct {
+	*model.Device
+	topology *model.Topology
+}
+
+type Interface struct {
+	*model.Interface
+	device *Device
+}
+
+type InterfaceUnit struct {
+	*model.InterfaceUnit
+	iface *Interface
+}
+
+type Cable struct {
+	*model.Cable
+	topology *model.Topology
+}
+
+type CableEnd struct {
+	model.CableEnd
+	topology *model.Topology
+}
+
+type Neighbor struct {
+	*model.Neighbor
+	topology *model.Topology
+}
+
+func newDevice(t *model.Topology, d *model.Device) *Device {
+	if d == nil {
+		return nil
+	}
+
+	return &Device{
+		Device:   d,
+		topology: t,
+	}
+}
+
+func (d *Device) iface(name string) *Interface {
+	i, exists := d.Interfaces[name]
+	if !exists {
+		return nil
+	}
+
+	return &Interface{
+		Interface: i,
+		device:    d,
+	}
+}
+
+func (i *Interface) end() model.CableEnd {
+	return model.CableEnd{
+		DeviceName:   i.device.Name,
+		EndpointName: i.Name,
+		EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE,
+	}
+}
+
+func neighbors(t *model.Topology, devName string, ifName string) ([]*Neighbor, error) {
+	ns, err := t.GetNeighbors(devName, ifName)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*Neighbor, 0, len(ns))
+	for _, n := range ns {
+		ret = append(ret, &Neighbor{
+			Neighbor: n,
+			topology: t,
+		})
+	}
+
+	return ret, nil
+}
+
+// deviceFilter converts the filter of the query like the gRPC API does, nil if no filter is given
+func deviceFilter(in *DeviceFilterInput) (*model.DeviceFilter, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	pf := &octopuspb.DeviceFilter{
+		Sites:       in.Sites,
+		Colos:       in.Colos,
+		Pops:        in.Pops,
+		Roles:       in.Roles,
+		Platforms:   in.Platforms,
+		Statuses:    in.Statuses,
+		DeviceTypes: in.DeviceTypes,
+		Tags:        in.Tags,
+	}
+
+	if in.Operator != nil {
+		pf.Operator = octopuspb.FilterOperator(octopuspb.FilterOperator_value["FILTER_OPERATOR_"+string(*in.Operator)])
+	}
+
+	matchType := octopuspb.NameMatchType_NAME_MATCH_TYPE_EXACT
+	if in.NameMatch != nil {
+		matchType = octopuspb.NameMatchType(octopuspb.NameMatchType_value["NAME_MATCH_TYPE_"+string(*in.NameMatch)])
+	}
+
+	for _, name := range in.Names {
+		pf.Names = append(pf.Names, &octopuspb.NameFilter{
+			Pattern:   name,
+			MatchType: matchType,
+		})
+	}
+
+	for _, st := range in.SemanticTags {
+		tf := &octopuspb.SemanticTagFilter{
+			Key: st.Key,
+		}
+
+		if st.Value != nil {
+			tf.Value = *st.Value
+		}
+
+		pf.SemanticTags = append(pf.SemanticTags, tf)
+	}
+
+	return model.DeviceFilterFromProto(pf)
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	return slices.Sorted(maps.Keys(m))
+}
+
+// sortedValues returns the values of the map ordered by their keys
+func sortedValues[K cmp.Ordered, V any](m map[K]V) []V {
+	ret := make([]V, 0, len(m))
+	for _, k := range sortedKeys(m) {
+		ret = append(ret, m[k])
+	}
+
+	return ret
+}
+
+// page returns the items selected by the first and offset arguments of a list field, all items if neither is given
+func page[T any](items []T, first *int, offset *int) ([]T, error) {
+	start := 0
+	if offset != nil {
+		if *offset < 0 {
+			return nil, fmt.Errorf("offset must not be negative")
+		}
+
+		start = min(*offset, len(items))
+	}
+
+	end := len(items)
+	if first != nil {
+		if *first < 0 {
+			return nil, fmt.Errorf("first must not be negative")
+		}
+
+		if *first < end-start {
+			end = start + *first
+		}
+	}
+
+	return items[start:end], nil
+}
@@ -0,0 +1,172 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Within each criterion any of the given values has to match (e.g. role is ccr OR edge).
+// All criteria set are combined using the operator, every tag and semantic tag being a criterion of its own
+// (e.g. with OR a device needs only one of the given tags).
+type DeviceFilterInput struct {
+	Operator     *FilterOperator           `json:"operator,omitempty"`
+	Names        []string                  `json:"names,omitempty"`
+	NameMatch    *NameMatchType            `json:"nameMatch,omitempty"`
+	Sites        []string                  `json:"sites,omitempty"`
+	Colos        []string                  `json:"colos,omitempty"`
+	Pops         []string                  `json:"pops,omitempty"`
+	Roles        []string                  `json:"roles,omitempty"`
+	Platforms    []string                  `json:"platforms,omitempty"`
+	Statuses     []string                  `json:"statuses,omitempty"`
+	DeviceTypes  []string                  `json:"deviceTypes,omitempty"`
+	Tags         []string                  `json:"tags,omitempty"`
+	SemanticTags []*SemanticTagFilterInput `json:"semanticTags,omitempty"`
+}
+
+type Query struct {
+}
+
+type SemanticTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type SemanticTagFilterInput struct {
+	Key string `json:"key"`
+	// If not set, any value matches as long as the key exists
+	Value *string `json:"value,omitempty"`
+}
+
+type CableEndpointType string
+
+const (
+	CableEndpointTypeUnspecified        CableEndpointType = "UNSPECIFIED"
+	CableEndpointTypeInterface          CableEndpointType = "INTERFACE"
+	CableEndpointTypeFrontPort          CableEndpointType = "FRONT_PORT"
+	CableEndpointTypeRearPort           CableEndpointType = "REAR_PORT"
+	CableEndpointTypeCircuitTermination CableEndpointType = "CIRCUIT_TERMINATION"
+)
+
+var AllCableEndpointType = []CableEndpointType{
+	CableEndpointTypeUnspecified,
+	CableEndpointTypeInterface,
+	CableEndpointTypeFrontPort,
+	CableEndpointTypeRearPort,
+	CableEndpointTypeCircuitTermination,
+}
+
+func (e CableEndpointType) IsValid() bool {
+	switch e {
+	case CableEndpointTypeUnspecified, CableEndpointTypeInterface, CableEndpointTypeFrontPort, CableEndpointTypeRearPort, CableEndpointTypeCircuitTermination:
+		return true
+	}
+	return false
+}
+
+func (e CableEndpointType) String() string {
+	return string(e)
+}
+
+func (e *CableEndpointType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CableEndpointType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CableEndpointType", str)
+	}
+	return nil
+}
+
+func (e CableEndpointType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FilterOperator string
+
+const (
+	FilterOperatorAnd FilterOperator = "AND"
+	FilterOperatorOr  FilterOperator = "OR"
+)
+
+var AllFilterOperator = []FilterOperator{
+	FilterOperatorAnd,
+	FilterOperatorOr,
+}
+
+func (e FilterOperator) IsValid() bool {
+	switch e {
+	case FilterOperatorAnd, FilterOperatorOr:
+		return true
+	}
+	return false
+}
+
+func (e FilterOperator) String() string {
+	return string(e)
+}
+
+func (e *FilterOperator) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FilterOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FilterOperator", str)
+	}
+	return nil
+}
+
+func (e FilterOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NameMatchType string
+
+const (
+	NameMatchTypeExact NameMatchType = "EXACT"
+	NameMatchTypeGlob  NameMatchType = "GLOB"
+	NameMatchTypeRegex NameMatchType = "REGEX"
+)
+
+var AllNameMatchType = []NameMatchType{
+	NameMatchTypeExact,
+	NameMatchTypeGlob,
+	NameMatchTypeRegex,
+}
+
+func (e NameMatchType) IsValid() bool {
+	switch e {
+	case NameMatchTypeExact, NameMatchTypeGlob, NameMatchTypeRegex:
+		return true
+	}
+	return false
+}
+
+func (e NameMatchType) String() string {
+	return string(e)
+}
+
+func (e *NameMatchType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NameMatchType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NameMatchType", str)
+	}
+	return nil
+}
+
+func (e NameMatchType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
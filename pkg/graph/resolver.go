@@ -0,0 +1,445 @@
+package graph
+
+// THIS CODE WILL BE UPDATED WITH SCHEMA CHANGES. PREVIOUS IMPLEMENTATION FOR SCHEMA CHANGES WILL BE KEPT IN THE COMMENT SECTION. IMPLEMENTATION FOR UNCHANGED SCHEMA WILL BE KEPT.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// AEnd is the resolver for the aEnd field.
+func (r *cableResolver) AEnd(ctx context.Context, obj *Cable) (*CableEnd, error) {
+	return &CableEnd{
+		CableEnd: obj.AEnd,
+		topology: obj.topology,
+	}, nil
+}
+
+// BEnd is the resolver for the bEnd field.
+func (r *cableResolver) BEnd(ctx context.Context, obj *Cable) (*CableEnd, error) {
+	return &CableEnd{
+		CableEnd: obj.BEnd,
+		topology: obj.topology,
+	}, nil
+}
+
+// EndpointType is the resolver for the endpointType field.
+func (r *cableEndResolver) EndpointType(ctx context.Context, obj *CableEnd) (CableEndpointType, error) {
+	return CableEndpointType(strings.TrimPrefix(obj.EndpointType.String(), "CABLE_ENDPOINT_TYPE_")), nil
+}
+
+// Device is the resolver for the device field.
+func (r *cableEndResolver) Device(ctx context.Context, obj *CableEnd) (*Device, error) {
+	if obj.EndpointType == octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION {
+		return nil, nil
+	}
+
+	return newDevice(obj.topology, obj.topology.GetDevice(obj.DeviceName)), nil
+}
+
+// Interface is the resolver for the interface field.
+func (r *cableEndResolver) Interface(ctx context.Context, obj *CableEnd) (*Interface, error) {
+	if obj.EndpointType != octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE {
+		return nil, nil
+	}
+
+	d := newDevice(obj.topology, obj.topology.GetDevice(obj.DeviceName))
+	if d == nil {
+		return nil, nil
+	}
+
+	return d.iface(obj.EndpointName), nil
+}
+
+// Cable is the resolver for the cable field.
+func (r *cableEndResolver) Cable(ctx context.Context, obj *CableEnd) (*Cable, error) {
+	c, _, exists := obj.topology.GetCableByEnd(obj.CableEnd)
+	if !exists {
+		return nil, nil
+	}
+
+	return &Cable{
+		Cable:    c,
+		topology: obj.topology,
+	}, nil
+}
+
+// Policy is the resolver for the policy field.
+func (r *connectorProvenanceResolver) Policy(ctx context.Context, obj *model.ConnectorProvenance) (string, error) {
+	return obj.Policy.String(), nil
+}
+
+// Contribution is the resolver for the contribution field.
+func (r *connectorProvenanceResolver) Contribution(ctx context.Context, obj *model.ConnectorProvenance) (string, error) {
+	return obj.Contribution.String(), nil
+}
+
+// Interfaces is the resolver for the interfaces field.
+func (r *deviceResolver) Interfaces(ctx context.Context, obj *Device, first *int, offset *int) ([]*Interface, error) {
+	names, err := page(sortedKeys(obj.Interfaces), first, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*Interface, 0, len(names))
+	for _, name := range names {
+		ret = append(ret, obj.iface(name))
+	}
+
+	return ret, nil
+}
+
+// Interface is the resolver for the interface field.
+func (r *deviceResolver) Interface(ctx context.Context, obj *Device, name string) (*Interface, error) {
+	return obj.iface(name), nil
+}
+
+// FrontPorts is the resolver for the frontPorts field.
+func (r *deviceResolver) FrontPorts(ctx context.Context, obj *Device) ([]*model.FrontPort, error) {
+	return sortedValues(obj.FrontPorts), nil
+}
+
+// RearPorts is the resolver for the rearPorts field.
+func (r *deviceResolver) RearPorts(ctx context.Context, obj *Device) ([]*model.RearPort, error) {
+	return sortedValues(obj.RearPorts), nil
+}
+
+// Neighbors is the resolver for the neighbors field.
+func (r *deviceResolver) Neighbors(ctx context.Context, obj *Device, interfaceArg *string) ([]*Neighbor, error) {
+	ifName := ""
+	if interfaceArg != nil {
+		ifName = *interfaceArg
+	}
+
+	return neighbors(obj.topology, obj.Name, ifName)
+}
+
+// Address is the resolver for the address field.
+func (r *iPResolver) Address(ctx context.Context, obj *model.IP) (string, error) {
+	return obj.Address.String(), nil
+}
+
+// Device is the resolver for the device field.
+func (r *interfaceResolver) Device(ctx context.Context, obj *Interface) (*Device, error) {
+	return obj.device, nil
+}
+
+// Lag is the resolver for the lag field.
+func (r *interfaceResolver) Lag(ctx context.Context, obj *Interface) (*Interface, error) {
+	if obj.LAGMemberOf == "" {
+		return nil, nil
+	}
+
+	return obj.device.iface(obj.LAGMemberOf), nil
+}
+
+// Members is the resolver for the members field.
+func (r *interfaceResolver) Members(ctx context.Context, obj *Interface) ([]*Interface, error) {
+	ret := make([]*Interface, 0)
+	for _, name := range sortedKeys(obj.device.Interfaces) {
+		if obj.device.Interfaces[name].LAGMemberOf == obj.Name {
+			ret = append(ret, obj.device.iface(name))
+		}
+	}
+
+	return ret, nil
+}
+
+// Units is the resolver for the units field.
+func (r *interfaceResolver) Units(ctx context.Context, obj *Interface) ([]*InterfaceUnit, error) {
+	tags := make([]model.VLANTag, 0, len(obj.Units))
+	for tag := range obj.Units {
+		tags = append(tags, tag)
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].OuterTag != tags[j].OuterTag {
+			return tags[i].OuterTag < tags[j].OuterTag
+		}
+
+		return tags[i].InnerTag < tags[j].InnerTag
+	})
+
+	ret := make([]*InterfaceUnit, 0, len(tags))
+	for _, tag := range tags {
+		ret = append(ret, &InterfaceUnit{
+			InterfaceUnit: obj.Units[tag],
+			iface:         obj,
+		})
+	}
+
+	return ret, nil
+}
+
+// Unit is the resolver for the unit field.
+func (r *interfaceResolver) Unit(ctx context.Context, obj *Interface, outerTag *int, innerTag int) (*InterfaceUnit, error) {
+	var outer uint16
+	if outerTag != nil {
+		outer = uint16(*outerTag)
+	}
+
+	u, exists := obj.Units[model.NewVLANTag(outer, uint16(innerTag))]
+	if !exists {
+		return nil, nil
+	}
+
+	return &InterfaceUnit{
+		InterfaceUnit: u,
+		iface:         obj,
+	}, nil
+}
+
+// Cable is the resolver for the cable field.
+func (r *interfaceResolver) Cable(ctx context.Context, obj *Interface) (*Cable, error) {
+	c, _, exists := obj.device.topology.GetCableByEnd(obj.end())
+	if !exists {
+		return nil, nil
+	}
+
+	return &Cable{
+		Cable:    c,
+		topology: obj.device.topology,
+	}, nil
+}
+
+// Peer is the resolver for the peer field.
+func (r *interfaceResolver) Peer(ctx context.Context, obj *Interface) (*CableEnd, error) {
+	_, farEnd, exists := obj.device.topology.GetCableByEnd(obj.end())
+	if !exists {
+		return nil, nil
+	}
+
+	return &CableEnd{
+		CableEnd: farEnd,
+		topology: obj.device.topology,
+	}, nil
+}
+
+// Neighbors is the resolver for the neighbors field.
+func (r *interfaceResolver) Neighbors(ctx context.Context, obj *Interface) ([]*Neighbor, error) {
+	return neighbors(obj.device.topology, obj.device.Name, obj.Name)
+}
+
+// Interface is the resolver for the interface field.
+func (r *interfaceUnitResolver) Interface(ctx context.Context, obj *InterfaceUnit) (*Interface, error) {
+	return obj.iface, nil
+}
+
+// SemanticTags is the resolver for the semanticTags field.
+func (r *metaDataResolver) SemanticTags(ctx context.Context, obj *model.MetaData) ([]*SemanticTag, error) {
+	ret := make([]*SemanticTag, 0, len(obj.SemanticTags))
+	for _, key := range sortedKeys(obj.SemanticTags) {
+		ret = append(ret, &SemanticTag{
+			Key:   key,
+			Value: obj.SemanticTags[key],
+		})
+	}
+
+	return ret, nil
+}
+
+// SemanticTag is the resolver for the semanticTag field.
+func (r *metaDataResolver) SemanticTag(ctx context.Context, obj *model.MetaData, key string) (*string, error) {
+	value, exists := obj.SemanticTags[key]
+	if !exists {
+		return nil, nil
+	}
+
+	return &value, nil
+}
+
+// Device is the resolver for the device field.
+func (r *neighborResolver) Device(ctx context.Context, obj *Neighbor) (*Device, error) {
+	return newDevice(obj.topology, obj.topology.GetDevice(obj.DeviceName)), nil
+}
+
+// Interface is the resolver for the interface field.
+func (r *neighborResolver) Interface(ctx context.Context, obj *Neighbor) (*Interface, error) {
+	d := newDevice(obj.topology, obj.topology.GetDevice(obj.DeviceName))
+	if d == nil {
+		return nil, nil
+	}
+
+	return d.iface(obj.InterfaceName), nil
+}
+
+// Prefix is the resolver for the prefix field.
+func (r *prefixResolver) Prefix(ctx context.Context, obj *model.Prefix) (string, error) {
+	return obj.Prefix.String(), nil
+}
+
+// Topology is the resolver for the topology field.
+func (r *queryResolver) Topology(ctx context.Context, generation *int, timestamp *time.Time) (*model.Topology, error) {
+	if r.source.GetTopology() == nil {
+		return nil, fmt.Errorf("octopus not ready")
+	}
+
+	var topology *model.Topology
+	switch {
+	case generation != nil && timestamp != nil:
+		return nil, fmt.Errorf("only one of generation and timestamp may be given")
+	case generation != nil:
+		topology = r.source.GetSnapshot(uint64(*generation))
+	case timestamp != nil:
+		topology = r.source.GetSnapshotAt(*timestamp)
+	default:
+		topology = r.source.GetTopology()
+	}
+
+	if topology == nil {
+		return nil, fmt.Errorf("snapshot not found")
+	}
+
+	return topology, nil
+}
+
+// Sites is the resolver for the sites field.
+func (r *topologyResolver) Sites(ctx context.Context, obj *model.Topology) ([]*model.Site, error) {
+	return sortedValues(obj.Sites), nil
+}
+
+// Site is the resolver for the site field.
+func (r *topologyResolver) Site(ctx context.Context, obj *model.Topology, name string) (*model.Site, error) {
+	return obj.Sites[name], nil
+}
+
+// Pops is the resolver for the pops field.
+func (r *topologyResolver) Pops(ctx context.Context, obj *model.Topology) ([]*model.Pop, error) {
+	return sortedValues(obj.Pops), nil
+}
+
+// Pop is the resolver for the pop field.
+func (r *topologyResolver) Pop(ctx context.Context, obj *model.Topology, name string) (*model.Pop, error) {
+	return obj.Pops[name], nil
+}
+
+// Colos is the resolver for the colos field.
+func (r *topologyResolver) Colos(ctx context.Context, obj *model.Topology) ([]*model.Colo, error) {
+	return sortedValues(obj.Colos), nil
+}
+
+// Colo is the resolver for the colo field.
+func (r *topologyResolver) Colo(ctx context.Context, obj *model.Topology, name string) (*model.Colo, error) {
+	for _, c := range obj.Colos {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Devices is the resolver for the devices field.
+func (r *topologyResolver) Devices(ctx context.Context, obj *model.Topology, filter *DeviceFilterInput, first *int, offset *int) ([]*Device, error) {
+	f, err := deviceFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := page(obj.FindDevices(f), first, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*Device, 0, len(devices))
+	for _, d := range devices {
+		ret = append(ret, newDevice(obj, d))
+	}
+
+	return ret, nil
+}
+
+// Device is the resolver for the device field.
+func (r *topologyResolver) Device(ctx context.Context, obj *model.Topology, name string) (*Device, error) {
+	return newDevice(obj, obj.GetDevice(name)), nil
+}
+
+// Cables is the resolver for the cables field.
+func (r *topologyResolver) Cables(ctx context.Context, obj *model.Topology, first *int, offset *int) ([]*Cable, error) {
+	cables, err := page(sortedValues(obj.Cables), first, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*Cable, 0, len(cables))
+	for _, c := range cables {
+		ret = append(ret, &Cable{
+			Cable:    c,
+			topology: obj,
+		})
+	}
+
+	return ret, nil
+}
+
+// Circuits is the resolver for the circuits field.
+func (r *topologyResolver) Circuits(ctx context.Context, obj *model.Topology, first *int, offset *int) ([]*model.Circuit, error) {
+	return page(sortedValues(obj.Circuits), first, offset)
+}
+
+// Circuit is the resolver for the circuit field.
+func (r *topologyResolver) Circuit(ctx context.Context, obj *model.Topology, cid string) (*model.Circuit, error) {
+	return obj.Circuits[cid], nil
+}
+
+// Prefixes is the resolver for the prefixes field.
+func (r *topologyResolver) Prefixes(ctx context.Context, obj *model.Topology, first *int, offset *int) ([]*model.Prefix, error) {
+	return page(sortedValues(obj.Prefixes), first, offset)
+}
+
+// Cable returns CableResolver implementation.
+func (r *Resolver) Cable() CableResolver { return &cableResolver{r} }
+
+// CableEnd returns CableEndResolver implementation.
+func (r *Resolver) CableEnd() CableEndResolver { return &cableEndResolver{r} }
+
+// ConnectorProvenance returns ConnectorProvenanceResolver implementation.
+func (r *Resolver) ConnectorProvenance() ConnectorProvenanceResolver {
+	return &connectorProvenanceResolver{r}
+}
+
+// Device returns DeviceResolver implementation.
+func (r *Resolver) Device() DeviceResolver { return &deviceResolver{r} }
+
+// IP returns IPResolver implementation.
+func (r *Resolver) IP() IPResolver { return &iPResolver{r} }
+
+// Interface returns InterfaceResolver implementation.
+func (r *Resolver) Interface() InterfaceResolver { return &interfaceResolver{r} }
+
+// InterfaceUnit returns InterfaceUnitResolver implementation.
+func (r *Resolver) InterfaceUnit() InterfaceUnitResolver { return &interfaceUnitResolver{r} }
+
+// MetaData returns MetaDataResolver implementation.
+func (r *Resolver) MetaData() MetaDataResolver { return &metaDataResolver{r} }
+
+// Neighbor returns NeighborResolver implementation.
+func (r *Resolver) Neighbor() NeighborResolver { return &neighborResolver{r} }
+
+// Prefix returns PrefixResolver implementation.
+func (r *Resolver) Prefix() PrefixResolver { return &prefixResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Topology returns TopologyResolver implementation.
+func (r *Resolver) Topology() TopologyResolver { return &topologyResolver{r} }
+
+type cableResolver struct{ *Resolver }
+type cableEndResolver struct{ *Resolver }
+type connectorProvenanceResolver struct{ *Resolver }
+type deviceResolver struct{ *Resolver }
+type iPResolver struct{ *Resolver }
+type interfaceResolver struct{ *Resolver }
+type interfaceUnitResolver struct{ *Resolver }
+type metaDataResolver struct{ *Resolver }
+type neighborResolver struct{ *Resolver }
+type prefixResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type topologyResolver struct{ *Resolver }
@@ -0,0 +1,57 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// The model uses fixed size integers, which are exposed as Int
+
+func MarshalUint16(i uint16) graphql.Marshaler {
+	return graphql.MarshalInt64(int64(i))
+}
+
+func UnmarshalUint16(v any) (uint16, error) {
+	i, err := unmarshalIntInRange(v, 0, math.MaxUint16)
+	return uint16(i), err
+}
+
+func MarshalUint8(i uint8) graphql.Marshaler {
+	return graphql.MarshalInt64(int64(i))
+}
+
+func UnmarshalUint8(v any) (uint8, error) {
+	i, err := unmarshalIntInRange(v, 0, math.MaxUint8)
+	return uint8(i), err
+}
+
+func MarshalInt16(i int16) graphql.Marshaler {
+	return graphql.MarshalInt64(int64(i))
+}
+
+func UnmarshalInt16(v any) (int16, error) {
+	i, err := unmarshalIntInRange(v, math.MinInt16, math.MaxInt16)
+	return int16(i), err
+}
+
+func unmarshalIntInRange(v any, min int64, max int64) (int64, error) {
+	i, err := graphql.UnmarshalInt64(v)
+	if err != nil {
+		return 0, err
+	}
+
+	if i < min || i > max {
+		return 0, fmt.Errorf("%d is out of range [%d, %d]", i, min, max)
+	}
+
+	return i, nil
+}
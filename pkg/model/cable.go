@@ -16,6 +16,11 @@ import (
 type Cable struct {
 	AEnd CableEnd
 	BEnd CableEnd
+
+	// ID of the object in the data source of the connector which added it to its partial topology
+	SourceID string
+	// Sources of the attributes, recorded when merging the partial topologies
+	Provenance Provenance
 }
 
 type CableEnd struct {
@@ -17,6 +17,11 @@ type Circuit struct {
 	Type     string
 	Status   string
 	MetaData *MetaData
+
+	// ID of the object in the data source of the connector which added it to its partial topology
+	SourceID string
+	// Sources of the attributes, recorded when merging the partial topologies
+	Provenance Provenance
 }
 
 func NewCircuit(CID string, provider string, cType string, status string) *Circuit {
@@ -26,6 +26,11 @@ type Device struct {
 	RearPorts  map[string]*RearPort
 
 	MetaData *MetaData
+
+	// ID of the object in the data source of the connector which added it to its partial topology
+	SourceID string
+	// Sources of the attributes, recorded when merging the partial topologies
+	Provenance Provenance
 }
 
 func NewDevice(name string) *Device {
@@ -53,6 +58,15 @@ func (d *Device) GetInterface(ifName string) *Interface {
 }
 
 func (d *Device) ToProto() *octopuspb.Device {
+	return d.toProto(false)
+}
+
+// ToProtoWithProvenance includes the provenance of the device and its interfaces and units
+func (d *Device) ToProtoWithProvenance() *octopuspb.Device {
+	return d.toProto(true)
+}
+
+func (d *Device) toProto(withProvenance bool) *octopuspb.Device {
 	if d == nil {
 		return nil
 	}
@@ -67,6 +81,10 @@ func (d *Device) ToProto() *octopuspb.Device {
 		MetaData: d.MetaData.ToProto(),
 	}
 
+	if withProvenance {
+		protoDev.Provenance = d.Provenance.ToProto()
+	}
+
 	if d.Colo != nil {
 		protoDev.ColoId = int32(d.Colo.Id)
 	}
@@ -78,7 +96,7 @@ func (d *Device) ToProto() *octopuspb.Device {
 	if len(d.Interfaces) > 0 {
 		protoDev.Interfaces = make([]*octopuspb.Interface, 0)
 		for _, iface := range d.Interfaces {
-			protoDev.Interfaces = append(protoDev.Interfaces, iface.ToProto())
+			protoDev.Interfaces = append(protoDev.Interfaces, iface.toProto(withProvenance))
 		}
 	}
 
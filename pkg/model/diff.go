@@ -0,0 +1,369 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// TopologyDiff holds all differences between two topologies, broken out by object type
+type TopologyDiff struct {
+	Devices     []*ObjectDiff
+	Interfaces  []*ObjectDiff
+	Units       []*ObjectDiff
+	IPAddresses []*ObjectDiff
+	Cables      []*ObjectDiff
+	Circuits    []*ObjectDiff
+	Prefixes    []*ObjectDiff
+}
+
+// ObjectDiff describes the difference of one object between two topologies.
+// Fields are only set for modified objects.
+type ObjectDiff struct {
+	Type   octopuspb.DiffType
+	Key    string
+	Fields []*FieldDiff
+}
+
+// FieldDiff holds the value of an attribute before and after the change.
+// Before/After are empty if the attribute did not exist in the respective topology.
+type FieldDiff struct {
+	Name   string
+	Before string
+	After  string
+}
+
+// flatObjects maps the key of every object to its attributes
+type flatObjects map[string]map[string]string
+
+// Diff computes the differences between topology old and topology new. Either may be nil.
+func Diff(old *Topology, new *Topology) *TopologyDiff {
+	o := old.flatten()
+	n := new.flatten()
+
+	return &TopologyDiff{
+		Devices:     diffObjects(o.devices, n.devices),
+		Interfaces:  diffObjects(o.interfaces, n.interfaces),
+		Units:       diffObjects(o.units, n.units),
+		IPAddresses: diffObjects(o.ipAddresses, n.ipAddresses),
+		Cables:      diffObjects(o.cables, n.cables),
+		Circuits:    diffObjects(o.circuits, n.circuits),
+		Prefixes:    diffObjects(o.prefixes, n.prefixes),
+	}
+}
+
+type flatTopology struct {
+	devices     flatObjects
+	interfaces  flatObjects
+	units       flatObjects
+	ipAddresses flatObjects
+	cables      flatObjects
+	circuits    flatObjects
+	prefixes    flatObjects
+}
+
+func (t *Topology) flatten() *flatTopology {
+	ft := &flatTopology{
+		devices:     make(flatObjects),
+		interfaces:  make(flatObjects),
+		units:       make(flatObjects),
+		ipAddresses: make(flatObjects),
+		cables:      make(flatObjects),
+		circuits:    make(flatObjects),
+		prefixes:    make(flatObjects),
+	}
+
+	if t == nil {
+		return ft
+	}
+
+	for _, d := range t.Nodes {
+		ft.devices[d.Name] = d.flatten()
+
+		for _, ifa := range d.Interfaces {
+			ifaKey := d.Name + ":" + ifa.Name
+			ft.interfaces[ifaKey] = ifa.flatten()
+
+			for vlanTag, u := range ifa.Units {
+				unitKey := fmt.Sprintf("%s.%d.%d", ifaKey, vlanTag.OuterTag, vlanTag.InnerTag)
+				ft.units[unitKey] = u.flatten()
+
+				for _, ips := range [][]IP{u.IPv4Addresses, u.IPv6Addresses} {
+					for _, ip := range ips {
+						fields := make(map[string]string)
+						ip.MetaData.flatten(fields)
+						ft.ipAddresses[unitKey+" "+ip.Address.String()] = fields
+					}
+				}
+			}
+		}
+	}
+
+	for key := range t.Cables {
+		ft.cables[key] = make(map[string]string)
+	}
+
+	for _, c := range t.Circuits {
+		ft.circuits[c.CID] = c.flatten()
+	}
+
+	for p, key := range t.prefixKeys() {
+		fields := make(map[string]string)
+		p.MetaData.flatten(fields)
+		ft.prefixes[key] = fields
+	}
+
+	return ft
+}
+
+func (d *Device) flatten() map[string]string {
+	fields := map[string]string{
+		"status":      d.Status,
+		"role":        d.Role,
+		"platform":    d.Platform,
+		"device_type": d.DeviceType,
+	}
+
+	// Colos are identified by their ID, their names needn't be unique
+	if d.Colo != nil {
+		fields["colo"] = d.Colo.Name
+		fields["colo_id"] = fmt.Sprintf("%d", d.Colo.Id)
+	}
+
+	// Sites have no ID, they are identified by their name (see Topology.AddSiteIfNotExists)
+	if d.Site != nil {
+		fields["site"] = d.Site.Name
+	}
+
+	for name, fp := range d.FrontPorts {
+		fields["front_ports."+name] = fmt.Sprintf("%s:%d", fp.RearPort, fp.RearPortPosition)
+	}
+
+	for name, rp := range d.RearPorts {
+		fields["rear_ports."+name] = fmt.Sprintf("%d", rp.Positions)
+	}
+
+	d.MetaData.flatten(fields)
+	return fields
+}
+
+func (iface *Interface) flatten() map[string]string {
+	fields := map[string]string{
+		"type":          iface.Type,
+		"lag_member_of": iface.LAGMemberOf,
+	}
+
+	iface.MetaData.flatten(fields)
+	return fields
+}
+
+func (unit *InterfaceUnit) flatten() map[string]string {
+	fields := map[string]string{
+		"id": fmt.Sprintf("%d", unit.ID),
+	}
+
+	unit.MetaData.flatten(fields)
+	return fields
+}
+
+func (c *Circuit) flatten() map[string]string {
+	fields := map[string]string{
+		"provider": c.Provider,
+		"type":     c.Type,
+		"status":   c.Status,
+	}
+
+	c.MetaData.flatten(fields)
+	return fields
+}
+
+func (m *MetaData) flatten(fields map[string]string) {
+	if m == nil {
+		return
+	}
+
+	if len(m.Tags) > 0 {
+		tags := make([]string, len(m.Tags))
+		copy(tags, m.Tags)
+		sort.Strings(tags)
+		fields["meta_data.tags"] = strings.Join(tags, ",")
+	}
+
+	for k, v := range m.SemanticTags {
+		fields["meta_data.semantic_tags."+k] = v
+	}
+
+	if m.CustomFieldData != "" {
+		fields["meta_data.custom_field_data"] = m.CustomFieldData
+	}
+}
+
+func diffObjects(old flatObjects, new flatObjects) []*ObjectDiff {
+	ret := make([]*ObjectDiff, 0)
+
+	for _, key := range sortedKeys(old) {
+		if _, exists := new[key]; !exists {
+			ret = append(ret, &ObjectDiff{
+				Type: octopuspb.DiffType_DIFF_TYPE_REMOVED,
+				Key:  key,
+			})
+		}
+	}
+
+	for _, key := range sortedKeys(new) {
+		oldFields, exists := old[key]
+		if !exists {
+			ret = append(ret, &ObjectDiff{
+				Type: octopuspb.DiffType_DIFF_TYPE_ADDED,
+				Key:  key,
+			})
+			continue
+		}
+
+		fields := diffFields(oldFields, new[key])
+		if len(fields) == 0 {
+			continue
+		}
+
+		ret = append(ret, &ObjectDiff{
+			Type:   octopuspb.DiffType_DIFF_TYPE_MODIFIED,
+			Key:    key,
+			Fields: fields,
+		})
+	}
+
+	return ret
+}
+
+func diffFields(old map[string]string, new map[string]string) []*FieldDiff {
+	names := make(map[string]struct{})
+	for name := range old {
+		names[name] = struct{}{}
+	}
+
+	for name := range new {
+		names[name] = struct{}{}
+	}
+
+	ret := make([]*FieldDiff, 0)
+	for _, name := range sortedKeys(names) {
+		if old[name] == new[name] {
+			continue
+		}
+
+		ret = append(ret, &FieldDiff{
+			Name:   name,
+			Before: old[name],
+			After:  new[name],
+		})
+	}
+
+	return ret
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// Empty returns true if there are no differences at all
+func (d *TopologyDiff) Empty() bool {
+	for _, diffs := range d.byType() {
+		if len(diffs.diffs) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Summary returns a human readable summary of the number of added, removed, and modified objects per type
+func (d *TopologyDiff) Summary() string {
+	parts := make([]string, 0)
+	for _, diffs := range d.byType() {
+		counts := make(map[octopuspb.DiffType]int)
+		for _, od := range diffs.diffs {
+			counts[od.Type]++
+		}
+
+		parts = append(parts, fmt.Sprintf("%s +%d -%d ~%d", diffs.name,
+			counts[octopuspb.DiffType_DIFF_TYPE_ADDED],
+			counts[octopuspb.DiffType_DIFF_TYPE_REMOVED],
+			counts[octopuspb.DiffType_DIFF_TYPE_MODIFIED]))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+type namedDiffs struct {
+	name  string
+	diffs []*ObjectDiff
+}
+
+func (d *TopologyDiff) byType() []namedDiffs {
+	return []namedDiffs{
+		{name: "devices", diffs: d.Devices},
+		{name: "interfaces", diffs: d.Interfaces},
+		{name: "units", diffs: d.Units},
+		{name: "ip_addresses", diffs: d.IPAddresses},
+		{name: "cables", diffs: d.Cables},
+		{name: "circuits", diffs: d.Circuits},
+		{name: "prefixes", diffs: d.Prefixes},
+	}
+}
+
+func (d *TopologyDiff) ToProto() *octopuspb.TopologyDiff {
+	if d == nil {
+		return nil
+	}
+
+	return &octopuspb.TopologyDiff{
+		Devices:     objectDiffsToProto(d.Devices),
+		Interfaces:  objectDiffsToProto(d.Interfaces),
+		Units:       objectDiffsToProto(d.Units),
+		IpAddresses: objectDiffsToProto(d.IPAddresses),
+		Cables:      objectDiffsToProto(d.Cables),
+		Circuits:    objectDiffsToProto(d.Circuits),
+		Prefixes:    objectDiffsToProto(d.Prefixes),
+	}
+}
+
+func objectDiffsToProto(diffs []*ObjectDiff) []*octopuspb.ObjectDiff {
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	ret := make([]*octopuspb.ObjectDiff, 0, len(diffs))
+	for _, od := range diffs {
+		protoDiff := &octopuspb.ObjectDiff{
+			Type: od.Type,
+			Key:  od.Key,
+		}
+
+		for _, fd := range od.Fields {
+			protoDiff.Fields = append(protoDiff.Fields, &octopuspb.FieldDiff{
+				Name:   fd.Name,
+				Before: fd.Before,
+				After:  fd.After,
+			})
+		}
+
+		ret = append(ret, protoDiff)
+	}
+
+	return ret
+}
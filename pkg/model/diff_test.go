@@ -0,0 +1,241 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"testing"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	oldTopology := NewTopology()
+	dev := oldTopology.AddDeviceIfNotExists("ccr01.dus01")
+	dev.Role = "ccr"
+	dev.MetaData.SemanticTags["NET:ASN"] = "13335"
+	dev.AddInterfaceItNotExists("et-0/0/0").AddIPAddressIfNotExists(NewVLANTag(0, 0), NewIP(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 1), 31)))
+	oldTopology.AddDeviceIfNotExists("ccr02.dus01")
+	oldTopology.Circuits["CID-1"] = NewCircuit("CID-1", "acme", "transport", "active")
+
+	newTopology := NewTopology()
+	dev = newTopology.AddDeviceIfNotExists("ccr01.dus01")
+	dev.Role = "edge"
+	dev.MetaData.SemanticTags["NET:ASN"] = "13335"
+	dev.MetaData.Tags = []string{"b", "a"}
+	dev.AddInterfaceItNotExists("et-0/0/0").AddIPAddressIfNotExists(NewVLANTag(0, 0), NewIP(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 3), 31)))
+	newTopology.Circuits["CID-1"] = NewCircuit("CID-1", "acme", "transport", "decommissioned")
+	newTopology.Cables["foo"] = &Cable{}
+
+	tests := []struct {
+		name     string
+		old      *Topology
+		new      *Topology
+		expected *TopologyDiff
+		summary  string
+	}{
+		{
+			name: "nil vs. nil",
+			expected: &TopologyDiff{
+				Devices:     []*ObjectDiff{},
+				Interfaces:  []*ObjectDiff{},
+				Units:       []*ObjectDiff{},
+				IPAddresses: []*ObjectDiff{},
+				Cables:      []*ObjectDiff{},
+				Circuits:    []*ObjectDiff{},
+				Prefixes:    []*ObjectDiff{},
+			},
+			summary: "devices +0 -0 ~0, interfaces +0 -0 ~0, units +0 -0 ~0, ip_addresses +0 -0 ~0, cables +0 -0 ~0, circuits +0 -0 ~0, prefixes +0 -0 ~0",
+		},
+		{
+			name: "changes",
+			old:  oldTopology,
+			new:  newTopology,
+			expected: &TopologyDiff{
+				Devices: []*ObjectDiff{
+					{
+						Type: octopuspb.DiffType_DIFF_TYPE_REMOVED,
+						Key:  "ccr02.dus01",
+					},
+					{
+						Type: octopuspb.DiffType_DIFF_TYPE_MODIFIED,
+						Key:  "ccr01.dus01",
+						Fields: []*FieldDiff{
+							{
+								Name:  "meta_data.tags",
+								After: "a,b",
+							},
+							{
+								Name:   "role",
+								Before: "ccr",
+								After:  "edge",
+							},
+						},
+					},
+				},
+				Interfaces: []*ObjectDiff{},
+				Units:      []*ObjectDiff{},
+				IPAddresses: []*ObjectDiff{
+					{
+						Type: octopuspb.DiffType_DIFF_TYPE_REMOVED,
+						Key:  "ccr01.dus01:et-0/0/0.0.0 192.0.2.1/31",
+					},
+					{
+						Type: octopuspb.DiffType_DIFF_TYPE_ADDED,
+						Key:  "ccr01.dus01:et-0/0/0.0.0 192.0.2.3/31",
+					},
+				},
+				Cables: []*ObjectDiff{
+					{
+						Type: octopuspb.DiffType_DIFF_TYPE_ADDED,
+						Key:  "foo",
+					},
+				},
+				Circuits: []*ObjectDiff{
+					{
+						Type: octopuspb.DiffType_DIFF_TYPE_MODIFIED,
+						Key:  "CID-1",
+						Fields: []*FieldDiff{
+							{
+								Name:   "status",
+								Before: "active",
+								After:  "decommissioned",
+							},
+						},
+					},
+				},
+				Prefixes: []*ObjectDiff{},
+			},
+			summary: "devices +0 -1 ~1, interfaces +0 -0 ~0, units +0 -0 ~0, ip_addresses +1 -1 ~0, cables +1 -0 ~0, circuits +0 -0 ~1, prefixes +0 -0 ~0",
+		},
+	}
+
+	for _, test := range tests {
+		diff := Diff(test.old, test.new)
+		assert.Equal(t, test.expected, diff, test.name)
+		assert.Equal(t, test.summary, diff.Summary(), test.name)
+		assert.Equal(t, test.old == test.new, diff.Empty(), test.name)
+	}
+}
+
+func TestDiffDeviceColo(t *testing.T) {
+	newTopology := func(coloID uint16, coloName string, site string) *Topology {
+		topology := NewTopology()
+		dev := topology.AddDeviceIfNotExists("ccr01.dus01")
+		dev.Colo = topology.AddColoIfNotExists(coloID, coloName, "dus-a")
+		dev.Site = topology.AddSiteIfNotExists(site)
+		return topology
+	}
+
+	tests := []struct {
+		name     string
+		old      *Topology
+		new      *Topology
+		expected []*FieldDiff
+	}{
+		{
+			name: "same colo",
+			old:  newTopology(1, "dus01", "DUS01"),
+			new:  newTopology(1, "dus01", "DUS01"),
+		},
+		{
+			name:     "colo of the same name",
+			old:      newTopology(1, "dus01", "DUS01"),
+			new:      newTopology(2, "dus01", "DUS01"),
+			expected: []*FieldDiff{{Name: "colo_id", Before: "1", After: "2"}},
+		},
+		{
+			name:     "colo without name",
+			old:      newTopology(1, "", "DUS01"),
+			new:      newTopology(2, "", "DUS01"),
+			expected: []*FieldDiff{{Name: "colo_id", Before: "1", After: "2"}},
+		},
+		{
+			name: "site and renamed colo",
+			old:  newTopology(1, "dus01", "DUS01"),
+			new:  newTopology(1, "dus02", "DUS02"),
+			expected: []*FieldDiff{
+				{Name: "colo", Before: "dus01", After: "dus02"},
+				{Name: "site", Before: "DUS01", After: "DUS02"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		devices := Diff(test.old, test.new).Devices
+		if test.expected == nil {
+			assert.Empty(t, devices, test.name)
+			continue
+		}
+
+		if assert.Len(t, devices, 1, test.name) {
+			assert.Equal(t, test.expected, devices[0].Fields, test.name)
+		}
+	}
+}
+
+func TestDiffDuplicatePrefixes(t *testing.T) {
+	newPrefix := func(objectID string, tag string) *Prefix {
+		p := NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(10, 0, 0, 0), 8))
+		p.MetaData.Tags = []string{tag}
+		p.Provenance = Provenance{"prefix": {Connector: "NetBox", ObjectID: objectID}}
+		return p
+	}
+
+	oldTopology := NewTopology()
+	oldTopology.Prefixes[1] = newPrefix("ipam.prefix:1", "vrf-a")
+	oldTopology.Prefixes[2] = newPrefix("ipam.prefix:2", "vrf-b")
+
+	// IDs differ once restored from a snapshot, the sources stay the same
+	newTopology := NewTopology()
+	newTopology.Prefixes[0] = newPrefix("ipam.prefix:2", "vrf-c")
+	newTopology.Prefixes[1] = newPrefix("ipam.prefix:1", "vrf-a")
+
+	assert.Equal(t, []*ObjectDiff{
+		{
+			Type: octopuspb.DiffType_DIFF_TYPE_MODIFIED,
+			Key:  "10.0.0.0/8 (NetBox ipam.prefix:2)",
+			Fields: []*FieldDiff{
+				{
+					Name:   "meta_data.tags",
+					Before: "vrf-b",
+					After:  "vrf-c",
+				},
+			},
+		},
+	}, Diff(oldTopology, newTopology).Prefixes)
+
+	// The key of the remaining prefix doesn't change once it is unique
+	removed := NewTopology()
+	removed.Prefixes[0] = newPrefix("ipam.prefix:2", "vrf-b")
+	assert.Equal(t, []*ObjectDiff{
+		{
+			Type: octopuspb.DiffType_DIFF_TYPE_REMOVED,
+			Key:  "10.0.0.0/8 (NetBox ipam.prefix:1)",
+		},
+	}, Diff(oldTopology, removed).Prefixes)
+}
+
+func TestPrefixKeys(t *testing.T) {
+	topology := NewTopology()
+	unique := NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24))
+	topology.Prefixes[1] = unique
+
+	// Duplicates without distinct sources fall back to their ID
+	first := NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(10, 0, 0, 0), 8))
+	second := NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(10, 0, 0, 0), 8))
+	topology.Prefixes[2] = first
+	topology.Prefixes[3] = second
+
+	assert.Equal(t, map[*Prefix]string{
+		unique: "192.0.2.0/24",
+		first:  "10.0.0.0/8",
+		second: "10.0.0.0/8 #3",
+	}, topology.prefixKeys())
+}
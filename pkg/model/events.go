@@ -0,0 +1,131 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"google.golang.org/protobuf/proto"
+)
+
+// Objects are sorted by kind before being compared, so that parents are added before and deleted after their children.
+const (
+	eventKindDevice = iota
+	eventKindInterface
+	eventKindUnit
+	eventKindCircuit
+	eventKindCable
+	eventKindPrefix
+)
+
+// TopologyEvents computes the list of events which transform topology old into topology new.
+// If old is nil, all objects of new are reported as added.
+func TopologyEvents(old *Topology, new *Topology) []*octopuspb.TopologyEvent {
+	oldObjects := old.eventObjects()
+	newObjects := new.eventObjects()
+
+	ret := make([]*octopuspb.TopologyEvent, 0)
+
+	// Deletions are reported children first
+	for kind := eventKindPrefix; kind >= eventKindDevice; kind-- {
+		for _, key := range sortedKeys(oldObjects[kind]) {
+			if _, exists := newObjects[kind][key]; exists {
+				continue
+			}
+
+			o := oldObjects[kind][key]
+			o.Type = octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_DELETE
+			ret = append(ret, o)
+		}
+	}
+
+	for kind := eventKindDevice; kind <= eventKindPrefix; kind++ {
+		for _, key := range sortedKeys(newObjects[kind]) {
+			n := newObjects[kind][key]
+			o, exists := oldObjects[kind][key]
+			if !exists {
+				n.Type = octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_ADD
+				ret = append(ret, n)
+				continue
+			}
+
+			if proto.Equal(o, n) {
+				continue
+			}
+
+			n.Type = octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_UPDATE
+			ret = append(ret, n)
+		}
+	}
+
+	return ret
+}
+
+// eventObjects returns an event for every object of the topology, grouped by kind and indexed by key
+func (t *Topology) eventObjects() []map[string]*octopuspb.TopologyEvent {
+	ret := make([]map[string]*octopuspb.TopologyEvent, eventKindPrefix+1)
+	for i := range ret {
+		ret[i] = make(map[string]*octopuspb.TopologyEvent)
+	}
+
+	if t == nil {
+		return ret
+	}
+
+	add := func(kind int, key string, event *octopuspb.TopologyEvent) {
+		ret[kind][key] = event
+	}
+
+	for _, dev := range t.Nodes {
+		protoDev := dev.ToProto()
+		protoDev.Interfaces = nil
+		sortDevicePorts(protoDev)
+		add(eventKindDevice, dev.Name, &octopuspb.TopologyEvent{
+			Object: &octopuspb.TopologyEvent_Device{Device: protoDev},
+		})
+
+		for _, ifa := range dev.Interfaces {
+			protoIfa := ifa.ToProto()
+			protoIfa.Units = nil
+			ifaKey := dev.Name + ":" + ifa.Name
+			add(eventKindInterface, ifaKey, &octopuspb.TopologyEvent{
+				DeviceName: dev.Name,
+				Object:     &octopuspb.TopologyEvent_Interface{Interface: protoIfa},
+			})
+
+			for vlanTag, u := range ifa.Units {
+				add(eventKindUnit, fmt.Sprintf("%s:%d.%d", ifaKey, vlanTag.OuterTag, vlanTag.InnerTag), &octopuspb.TopologyEvent{
+					DeviceName:    dev.Name,
+					InterfaceName: ifa.Name,
+					Object:        &octopuspb.TopologyEvent_Unit{Unit: u.ToProto()},
+				})
+			}
+		}
+	}
+
+	for cid, ckt := range t.Circuits {
+		add(eventKindCircuit, cid, &octopuspb.TopologyEvent{
+			Object: &octopuspb.TopologyEvent_Circuit{Circuit: ckt.ToProto()},
+		})
+	}
+
+	for key, c := range t.Cables {
+		add(eventKindCable, key, &octopuspb.TopologyEvent{
+			Object: &octopuspb.TopologyEvent_Cable{Cable: c.ToProto()},
+		})
+	}
+
+	for pfx, key := range t.prefixKeys() {
+		add(eventKindPrefix, key, &octopuspb.TopologyEvent{
+			Object: &octopuspb.TopologyEvent_Prefix{Prefix: pfx.ToProto()},
+		})
+	}
+
+	return ret
+}
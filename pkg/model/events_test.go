@@ -0,0 +1,155 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"testing"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTopologyEvents(t *testing.T) {
+	oldTopology := NewTopology()
+	dev := oldTopology.AddDeviceIfNotExists("ccr01.dus01")
+	dev.Role = "ccr"
+	dev.AddInterfaceItNotExists("et-0/0/0")
+	dev.AddInterfaceItNotExists("et-0/0/1").AddUnitIfNotExists(NewVLANTag(0, 100))
+	oldTopology.Circuits["CID-1"] = NewCircuit("CID-1", "acme", "transport", "active")
+
+	newTopology := NewTopology()
+	dev = newTopology.AddDeviceIfNotExists("ccr01.dus01")
+	dev.Role = "ccr"
+	dev.AddInterfaceItNotExists("et-0/0/0").Type = "100gbase-x-qsfp28"
+	dev.AddInterfaceItNotExists("et-0/0/1")
+	newTopology.AddDeviceIfNotExists("ccr02.dus01")
+	newTopology.Circuits["CID-1"] = NewCircuit("CID-1", "acme", "transport", "active")
+	newTopology.Prefixes[1] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24))
+
+	tests := []struct {
+		name     string
+		old      *Topology
+		new      *Topology
+		expected []*octopuspb.TopologyEvent
+	}{
+		{
+			name:     "nil to empty",
+			old:      nil,
+			new:      NewTopology(),
+			expected: []*octopuspb.TopologyEvent{},
+		},
+		{
+			name:     "unchanged",
+			old:      oldTopology,
+			new:      oldTopology,
+			expected: []*octopuspb.TopologyEvent{},
+		},
+		{
+			name: "nil to device",
+			old:  nil,
+			new: func() *Topology {
+				t := NewTopology()
+				t.AddDeviceIfNotExists("foo").AddInterfaceItNotExists("bar")
+				return t
+			}(),
+			expected: []*octopuspb.TopologyEvent{
+				{
+					Type: octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_ADD,
+					Object: &octopuspb.TopologyEvent_Device{
+						Device: &octopuspb.Device{
+							Name: "foo",
+						},
+					},
+				},
+				{
+					Type:       octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_ADD,
+					DeviceName: "foo",
+					Object: &octopuspb.TopologyEvent_Interface{
+						Interface: &octopuspb.Interface{
+							Name: "bar",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "changes",
+			old:  oldTopology,
+			new:  newTopology,
+			expected: []*octopuspb.TopologyEvent{
+				{
+					Type:          octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_DELETE,
+					DeviceName:    "ccr01.dus01",
+					InterfaceName: "et-0/0/1",
+					Object: &octopuspb.TopologyEvent_Unit{
+						Unit: &octopuspb.InterfaceUnit{
+							Id:       100,
+							InnerTag: 100,
+						},
+					},
+				},
+				{
+					Type: octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_ADD,
+					Object: &octopuspb.TopologyEvent_Device{
+						Device: &octopuspb.Device{
+							Name: "ccr02.dus01",
+						},
+					},
+				},
+				{
+					Type:       octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_UPDATE,
+					DeviceName: "ccr01.dus01",
+					Object: &octopuspb.TopologyEvent_Interface{
+						Interface: &octopuspb.Interface{
+							Name: "et-0/0/0",
+							Type: "100gbase-x-qsfp28",
+						},
+					},
+				},
+				{
+					Type: octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_ADD,
+					Object: &octopuspb.TopologyEvent_Prefix{
+						Prefix: &octopuspb.Prefix{
+							Prefix: bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24).ToProto(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		// assert.Equal can't compare protos as it also compares their internal state, so proto.Equal is used instead
+		events := TopologyEvents(test.old, test.new)
+		assert.True(t, proto.Equal(&octopuspb.TopologyChanges{Events: test.expected}, &octopuspb.TopologyChanges{Events: events}), "%s: unexpected events %v", test.name, events)
+	}
+}
+
+func TestTopologyEventsDuplicatePrefixes(t *testing.T) {
+	newPrefix := func(objectID string) *Prefix {
+		p := NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(10, 0, 0, 0), 8))
+		p.Provenance = Provenance{"prefix": {Connector: "NetBox", ObjectID: objectID}}
+		return p
+	}
+
+	topology := NewTopology()
+	topology.Prefixes[1] = newPrefix("ipam.prefix:1")
+	topology.Prefixes[2] = newPrefix("ipam.prefix:2")
+
+	// Both prefixes are added, neither hides the other
+	events := TopologyEvents(nil, topology)
+	assert.Len(t, events, 2)
+
+	removed := NewTopology()
+	removed.Prefixes[0] = newPrefix("ipam.prefix:2")
+	events = TopologyEvents(topology, removed)
+	assert.Len(t, events, 1)
+	assert.Equal(t, octopuspb.TopologyEventType_TOPOLOGY_EVENT_TYPE_DELETE, events[0].Type)
+}
@@ -0,0 +1,491 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// ExportFormat is a graph file format the topology can be exported to
+type ExportFormat uint8
+
+const (
+	ExportGraphML ExportFormat = iota
+	ExportDOT
+	ExportGEXF
+	// JSON Graph Format
+	ExportJGF
+)
+
+type exportFormatInfo struct {
+	name        string
+	extension   string
+	contentType string
+}
+
+var exportFormats = map[ExportFormat]exportFormatInfo{
+	ExportGraphML: {name: "graphml", extension: "graphml", contentType: "application/graphml+xml"},
+	ExportDOT:     {name: "dot", extension: "dot", contentType: "text/vnd.graphviz"},
+	ExportGEXF:    {name: "gexf", extension: "gexf", contentType: "application/gexf+xml"},
+	ExportJGF:     {name: "jgf", extension: "json", contentType: "application/json"},
+}
+
+func (f ExportFormat) String() string {
+	return exportFormats[f].name
+}
+
+// Extension returns the file extension of the format
+func (f ExportFormat) Extension() string {
+	return exportFormats[f].extension
+}
+
+// ContentType returns the MIME type of the format
+func (f ExportFormat) ContentType() string {
+	return exportFormats[f].contentType
+}
+
+// ExportFormatFromString returns the format of the given name (graphml, dot, gexf or jgf)
+func ExportFormatFromString(s string) (ExportFormat, error) {
+	for format, info := range exportFormats {
+		if info.name == s {
+			return format, nil
+		}
+	}
+
+	return ExportGraphML, fmt.Errorf("unknown export format %q", s)
+}
+
+// ExportFormatFromProto converts the given protobuf format, failing on unknown values
+func ExportFormatFromProto(pf octopuspb.ExportFormat) (ExportFormat, error) {
+	format := ExportFormat(pf)
+	if _, exists := exportFormats[format]; !exists {
+		return ExportGraphML, fmt.Errorf("unknown export format %d", pf)
+	}
+
+	return format, nil
+}
+
+// ExportOptions selects which parts of the topology are exported
+type ExportOptions struct {
+	// Only devices matching the filter are exported, all devices if nil
+	Filter *DeviceFilter
+
+	// Export interfaces, front and rear ports, and circuits as nodes connected to their device.
+	// Otherwise cables and paths are attached to the devices directly, and cables to circuits are left out.
+	Interfaces bool
+	Ports      bool
+	Circuits   bool
+
+	// Export cables and the paths between interfaces through patch panels and circuits (LogicalLinks) as edges
+	Cables bool
+	Paths  bool
+}
+
+// ExportOptionsFromProto converts the given export request into ExportOptions
+func ExportOptionsFromProto(req *octopuspb.ExportTopologyRequest) (*ExportOptions, error) {
+	filter, err := DeviceFilterFromProto(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &ExportOptions{
+		Filter:     filter,
+		Interfaces: req.IncludeInterfaces,
+		Ports:      req.IncludePorts,
+		Circuits:   req.IncludeCircuits,
+	}
+
+	switch req.Edges {
+	case octopuspb.ExportEdges_EXPORT_EDGES_CABLES:
+		opts.Cables = true
+	case octopuspb.ExportEdges_EXPORT_EDGES_PATHS:
+		opts.Paths = true
+	case octopuspb.ExportEdges_EXPORT_EDGES_ALL:
+		opts.Cables = true
+		opts.Paths = true
+	default:
+		return nil, fmt.Errorf("unknown export edges %d", req.Edges)
+	}
+
+	return opts, nil
+}
+
+// Graph is the topology as undirected graph, the common ground of all export formats
+type Graph struct {
+	Generation uint64
+	Timestamp  time.Time
+	Nodes      []*GraphNode
+	Edges      []*GraphEdge
+}
+
+// GraphNode is a device, interface, front or rear port, or circuit.
+// The kind attribute tells which one it is.
+type GraphNode struct {
+	ID         string
+	Label      string
+	Attributes map[string]string
+}
+
+// GraphEdge is a cable, a path, or the connection between a device and its interfaces or ports.
+// The kind attribute tells which one it is.
+type GraphEdge struct {
+	ID         string
+	Source     string
+	Target     string
+	Label      string
+	Attributes map[string]string
+}
+
+// Export writes the graph of the topology in the given format
+func (t *Topology) Export(w io.Writer, format ExportFormat, opts *ExportOptions) error {
+	return t.ExportGraph(opts).Write(w, format)
+}
+
+// ExportGraph converts the topology into a graph according to the given options
+func (t *Topology) ExportGraph(opts *ExportOptions) *Graph {
+	b := &graphBuilder{
+		t:    t,
+		opts: opts,
+		g: &Graph{
+			Generation: t.Generation,
+			Timestamp:  t.Timestamp,
+			Nodes:      make([]*GraphNode, 0),
+			Edges:      make([]*GraphEdge, 0),
+		},
+		devices:  make(map[string]struct{}),
+		circuits: make(map[string]struct{}),
+	}
+
+	for _, d := range t.FindDevices(opts.Filter) {
+		b.addDevice(d)
+	}
+
+	if opts.Cables {
+		for _, key := range sortedKeys(t.Cables) {
+			b.addCable(key, t.Cables[key])
+		}
+	}
+
+	if opts.Paths {
+		for _, key := range sortedKeys(t.LogicalLinks) {
+			b.addPath(key, t.LogicalLinks[key])
+		}
+	}
+
+	return b.g
+}
+
+// Write writes the graph in the given format
+func (g *Graph) Write(w io.Writer, format ExportFormat) error {
+	switch format {
+	case ExportGraphML:
+		return g.writeGraphML(w)
+	case ExportDOT:
+		return g.writeDOT(w)
+	case ExportGEXF:
+		return g.writeGEXF(w)
+	case ExportJGF:
+		return g.writeJGF(w)
+	}
+
+	return fmt.Errorf("unknown export format %d", format)
+}
+
+// attributeNames returns the sorted names of all attributes of the nodes and edges
+func (g *Graph) attributeNames() ([]string, []string) {
+	nodeAttrs := make(map[string]struct{})
+	for _, n := range g.Nodes {
+		for name := range n.Attributes {
+			nodeAttrs[name] = struct{}{}
+		}
+	}
+
+	edgeAttrs := make(map[string]struct{})
+	for _, e := range g.Edges {
+		for name := range e.Attributes {
+			edgeAttrs[name] = struct{}{}
+		}
+	}
+
+	return sortedKeys(nodeAttrs), sortedKeys(edgeAttrs)
+}
+
+type graphBuilder struct {
+	t    *Topology
+	opts *ExportOptions
+	g    *Graph
+
+	// Names of the exported devices and CIDs of the exported circuits
+	devices  map[string]struct{}
+	circuits map[string]struct{}
+}
+
+func interfaceNodeID(devName string, ifName string) string {
+	return devName + ":" + ifName
+}
+
+func frontPortNodeID(devName string, portName string) string {
+	return devName + ":front:" + portName
+}
+
+func rearPortNodeID(devName string, portName string) string {
+	return devName + ":rear:" + portName
+}
+
+func circuitNodeID(cid string) string {
+	return "circuit:" + cid
+}
+
+func (b *graphBuilder) addNode(id string, label string, attrs map[string]string) {
+	b.g.Nodes = append(b.g.Nodes, &GraphNode{
+		ID:         id,
+		Label:      label,
+		Attributes: withoutEmptyValues(attrs),
+	})
+}
+
+func (b *graphBuilder) addEdge(id string, source string, target string, label string, attrs map[string]string) {
+	b.g.Edges = append(b.g.Edges, &GraphEdge{
+		ID:         id,
+		Source:     source,
+		Target:     target,
+		Label:      label,
+		Attributes: withoutEmptyValues(attrs),
+	})
+}
+
+func (b *graphBuilder) addDevice(d *Device) {
+	b.devices[d.Name] = struct{}{}
+
+	attrs := map[string]string{
+		"kind":        "device",
+		"status":      d.Status,
+		"role":        d.Role,
+		"platform":    d.Platform,
+		"device_type": d.DeviceType,
+	}
+
+	if d.Site != nil {
+		attrs["site"] = d.Site.Name
+	}
+
+	if d.Colo != nil {
+		attrs["colo"] = d.Colo.Name
+		attrs["region"] = d.Colo.Region
+		if d.Colo.Pop != nil {
+			attrs["pop"] = d.Colo.Pop.Name
+		}
+	}
+
+	addMetaDataAttributes(attrs, d.MetaData)
+	b.addNode(d.Name, d.Name, attrs)
+
+	if b.opts.Interfaces {
+		for _, ifName := range sortedKeys(d.Interfaces) {
+			b.addInterface(d, d.Interfaces[ifName])
+		}
+	}
+
+	if b.opts.Ports {
+		for _, name := range sortedKeys(d.RearPorts) {
+			rp := d.RearPorts[name]
+			id := rearPortNodeID(d.Name, rp.Name)
+			b.addNode(id, id, map[string]string{
+				"kind":      "rear_port",
+				"device":    d.Name,
+				"name":      rp.Name,
+				"positions": strconv.Itoa(int(rp.Positions)),
+			})
+			b.addEdge(d.Name+"--"+id, d.Name, id, "", map[string]string{"kind": "port"})
+		}
+
+		for _, name := range sortedKeys(d.FrontPorts) {
+			fp := d.FrontPorts[name]
+			id := frontPortNodeID(d.Name, fp.Name)
+			b.addNode(id, id, map[string]string{
+				"kind":               "front_port",
+				"device":             d.Name,
+				"name":               fp.Name,
+				"rear_port":          fp.RearPort,
+				"rear_port_position": strconv.FormatUint(uint64(fp.RearPortPosition), 10),
+			})
+			b.addEdge(d.Name+"--"+id, d.Name, id, "", map[string]string{"kind": "port"})
+
+			if _, exists := d.RearPorts[fp.RearPort]; exists {
+				rearID := rearPortNodeID(d.Name, fp.RearPort)
+				b.addEdge(id+"--"+rearID, id, rearID, "", map[string]string{
+					"kind":     "port_mapping",
+					"position": strconv.FormatUint(uint64(fp.RearPortPosition), 10),
+				})
+			}
+		}
+	}
+}
+
+func (b *graphBuilder) addInterface(d *Device, ifa *Interface) {
+	addrs := make([]string, 0)
+	for _, u := range ifa.Units {
+		for _, ip := range u.IPv4Addresses {
+			addrs = append(addrs, ip.Address.String())
+		}
+
+		for _, ip := range u.IPv6Addresses {
+			addrs = append(addrs, ip.Address.String())
+		}
+	}
+	sort.Strings(addrs)
+
+	attrs := map[string]string{
+		"kind":           "interface",
+		"device":         d.Name,
+		"name":           ifa.Name,
+		"interface_type": ifa.Type,
+		"lag":            ifa.LAGMemberOf,
+		"addresses":      strings.Join(addrs, ","),
+	}
+	addMetaDataAttributes(attrs, ifa.MetaData)
+
+	id := interfaceNodeID(d.Name, ifa.Name)
+	b.addNode(id, id, attrs)
+	b.addEdge(d.Name+"--"+id, d.Name, id, "", map[string]string{"kind": "interface"})
+}
+
+func (b *graphBuilder) addCircuit(cid string) {
+	if _, exists := b.circuits[cid]; exists {
+		return
+	}
+
+	b.circuits[cid] = struct{}{}
+	attrs := map[string]string{
+		"kind": "circuit",
+		"cid":  cid,
+	}
+
+	c, exists := b.t.Circuits[cid]
+	if exists {
+		attrs["provider"] = c.Provider
+		attrs["circuit_type"] = c.Type
+		attrs["status"] = c.Status
+		addMetaDataAttributes(attrs, c.MetaData)
+	}
+
+	b.addNode(circuitNodeID(cid), cid, attrs)
+}
+
+// endNode returns the ID of the node the given end is attached to, false if it isn't exported
+func (b *graphBuilder) endNode(ce CableEnd) (string, bool) {
+	if ce.EndpointType == octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION {
+		return circuitNodeID(ce.DeviceName), b.opts.Circuits
+	}
+
+	if _, exists := b.devices[ce.DeviceName]; !exists {
+		return "", false
+	}
+
+	d := b.t.GetDevice(ce.DeviceName)
+	switch ce.EndpointType {
+	case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE:
+		if _, exists := d.Interfaces[ce.EndpointName]; b.opts.Interfaces && exists {
+			return interfaceNodeID(ce.DeviceName, ce.EndpointName), true
+		}
+	case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT:
+		if _, exists := d.FrontPorts[ce.EndpointName]; b.opts.Ports && exists {
+			return frontPortNodeID(ce.DeviceName, ce.EndpointName), true
+		}
+	case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT:
+		if _, exists := d.RearPorts[ce.EndpointName]; b.opts.Ports && exists {
+			return rearPortNodeID(ce.DeviceName, ce.EndpointName), true
+		}
+	}
+
+	return ce.DeviceName, true
+}
+
+// addEndNodes returns the nodes of both ends, adding circuit nodes as needed. False if any end isn't exported.
+func (b *graphBuilder) addEndNodes(a CableEnd, z CableEnd) (string, string, bool) {
+	source, ok := b.endNode(a)
+	if !ok {
+		return "", "", false
+	}
+
+	target, ok := b.endNode(z)
+	if !ok {
+		return "", "", false
+	}
+
+	for _, ce := range []CableEnd{a, z} {
+		if ce.EndpointType == octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION {
+			b.addCircuit(ce.DeviceName)
+		}
+	}
+
+	return source, target, true
+}
+
+func endName(ce CableEnd) string {
+	return ce.DeviceName + ":" + ce.EndpointName
+}
+
+func (b *graphBuilder) addCable(key string, c *Cable) {
+	source, target, ok := b.addEndNodes(c.AEnd, c.BEnd)
+	if !ok {
+		return
+	}
+
+	b.addEdge(key, source, target, endName(c.AEnd)+" -- "+endName(c.BEnd), map[string]string{
+		"kind":  "cable",
+		"a_end": endName(c.AEnd),
+		"b_end": endName(c.BEnd),
+	})
+}
+
+func (b *graphBuilder) addPath(key string, ll *LogicalLink) {
+	source, target, ok := b.addEndNodes(ll.AEnd, ll.BEnd)
+	if !ok {
+		return
+	}
+
+	cables, circuits := b.t.cablesAndCircuits(ll)
+	b.addEdge("path:"+key, source, target, key, map[string]string{
+		"kind":     "path",
+		"a_end":    endName(ll.AEnd),
+		"b_end":    endName(ll.BEnd),
+		"direct":   strconv.FormatBool(len(ll.Path) == 1),
+		"cables":   strings.Join(cables, ","),
+		"circuits": strings.Join(circuits, ","),
+	})
+}
+
+// addMetaDataAttributes adds the tags and semantic tags (as semantic_tags.<key>) to the attributes
+func addMetaDataAttributes(attrs map[string]string, md *MetaData) {
+	if md == nil {
+		return
+	}
+
+	attrs["tags"] = strings.Join(md.Tags, ",")
+	for key, value := range md.SemanticTags {
+		attrs["semantic_tags."+key] = value
+	}
+}
+
+func withoutEmptyValues(attrs map[string]string) map[string]string {
+	for key, value := range attrs {
+		if value == "" {
+			delete(attrs, key)
+		}
+	}
+
+	return attrs
+}
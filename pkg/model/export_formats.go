@@ -0,0 +1,405 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const exportGraphID = "topology"
+
+/*
+ * GraphML (http://graphml.graphdrawing.org), e.g. for yEd and NetworkX
+ */
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Data        []graphMLData `xml:"data"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string        `xml:"id,attr"`
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func (g *Graph) writeGraphML(w io.Writer) error {
+	doc := &graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Graph: graphMLGraph{
+			ID:          exportGraphID,
+			EdgeDefault: "undirected",
+			Nodes:       make([]graphMLNode, 0, len(g.Nodes)),
+			Edges:       make([]graphMLEdge, 0, len(g.Edges)),
+		},
+	}
+
+	// Keys are referenced by ID, so attribute names don't need to be valid XML IDs
+	keyIDs := map[string]map[string]string{
+		"graph": make(map[string]string),
+		"node":  make(map[string]string),
+		"edge":  make(map[string]string),
+	}
+
+	addKey := func(scope string, name string) {
+		id := "d" + strconv.Itoa(len(doc.Keys))
+		keyIDs[scope][name] = id
+		doc.Keys = append(doc.Keys, graphMLKey{ID: id, For: scope, Name: name, Type: "string"})
+	}
+
+	data := func(scope string, label string, attrs map[string]string) []graphMLData {
+		ret := make([]graphMLData, 0, len(attrs)+1)
+		if label != "" {
+			ret = append(ret, graphMLData{Key: keyIDs[scope]["label"], Value: label})
+		}
+
+		for _, name := range sortedKeys(attrs) {
+			ret = append(ret, graphMLData{Key: keyIDs[scope][name], Value: attrs[name]})
+		}
+
+		return ret
+	}
+
+	nodeAttrs, edgeAttrs := g.attributeNames()
+	for _, name := range []string{"generation", "timestamp"} {
+		addKey("graph", name)
+	}
+
+	for _, name := range append([]string{"label"}, nodeAttrs...) {
+		addKey("node", name)
+	}
+
+	for _, name := range append([]string{"label"}, edgeAttrs...) {
+		addKey("edge", name)
+	}
+
+	doc.Graph.Data = data("graph", "", g.metaData())
+	for _, n := range g.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID:   n.ID,
+			Data: data("node", n.Label, n.Attributes),
+		})
+	}
+
+	for _, e := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			ID:     e.ID,
+			Source: e.Source,
+			Target: e.Target,
+			Data:   data("edge", e.Label, e.Attributes),
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+/*
+ * GEXF 1.3 (https://gexf.net), e.g. for Gephi
+ */
+
+type gexf struct {
+	XMLName xml.Name  `xml:"gexf"`
+	XMLNS   string    `xml:"xmlns,attr"`
+	Version string    `xml:"version,attr"`
+	Meta    gexfMeta  `xml:"meta"`
+	Graph   gexfGraph `xml:"graph"`
+}
+
+type gexfMeta struct {
+	LastModified string `xml:"lastmodifieddate,attr"`
+	Creator      string `xml:"creator"`
+	Description  string `xml:"description"`
+}
+
+type gexfGraph struct {
+	DefaultEdgeType string           `xml:"defaultedgetype,attr"`
+	Mode            string           `xml:"mode,attr"`
+	Attributes      []gexfAttributes `xml:"attributes"`
+	Nodes           []gexfNode       `xml:"nodes>node"`
+	Edges           []gexfEdge       `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	ID    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfNode struct {
+	ID        string         `xml:"id,attr"`
+	Label     string         `xml:"label,attr"`
+	AttValues []gexfAttValue `xml:"attvalues>attvalue"`
+}
+
+type gexfEdge struct {
+	ID        string         `xml:"id,attr"`
+	Source    string         `xml:"source,attr"`
+	Target    string         `xml:"target,attr"`
+	Label     string         `xml:"label,attr,omitempty"`
+	AttValues []gexfAttValue `xml:"attvalues>attvalue"`
+}
+
+type gexfAttValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+func (g *Graph) writeGEXF(w io.Writer) error {
+	doc := &gexf{
+		XMLNS:   "http://gexf.net/1.3",
+		Version: "1.3",
+		Meta: gexfMeta{
+			LastModified: g.Timestamp.UTC().Format(time.DateOnly),
+			Creator:      "Octopus",
+			Description:  fmt.Sprintf("Topology generation %d built at %s", g.Generation, g.Timestamp.UTC().Format(time.RFC3339)),
+		},
+		Graph: gexfGraph{
+			DefaultEdgeType: "undirected",
+			Mode:            "static",
+			Nodes:           make([]gexfNode, 0, len(g.Nodes)),
+			Edges:           make([]gexfEdge, 0, len(g.Edges)),
+		},
+	}
+
+	nodeAttrs, edgeAttrs := g.attributeNames()
+	for _, class := range []struct {
+		name  string
+		attrs []string
+	}{{"node", nodeAttrs}, {"edge", edgeAttrs}} {
+		attributes := gexfAttributes{
+			Class:      class.name,
+			Attributes: make([]gexfAttribute, 0, len(class.attrs)),
+		}
+
+		for i, name := range class.attrs {
+			attributes.Attributes = append(attributes.Attributes, gexfAttribute{ID: strconv.Itoa(i), Title: name, Type: "string"})
+		}
+
+		doc.Graph.Attributes = append(doc.Graph.Attributes, attributes)
+	}
+
+	attValues := func(names []string, attrs map[string]string) []gexfAttValue {
+		ret := make([]gexfAttValue, 0, len(attrs))
+		for i, name := range names {
+			if value, exists := attrs[name]; exists {
+				ret = append(ret, gexfAttValue{For: strconv.Itoa(i), Value: value})
+			}
+		}
+
+		return ret
+	}
+
+	for _, n := range g.Nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, gexfNode{
+			ID:        n.ID,
+			Label:     n.Label,
+			AttValues: attValues(nodeAttrs, n.Attributes),
+		})
+	}
+
+	for _, e := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, gexfEdge{
+			ID:        e.ID,
+			Source:    e.Source,
+			Target:    e.Target,
+			Label:     e.Label,
+			AttValues: attValues(edgeAttrs, e.Attributes),
+		})
+	}
+
+	return writeXML(w, doc)
+}
+
+func writeXML(w io.Writer, doc any) error {
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err = enc.Encode(doc)
+	if err != nil {
+		return fmt.Errorf("unable to encode graph: %v", err)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+/*
+ * DOT (https://graphviz.org/doc/info/lang.html) for Graphviz
+ */
+
+func (g *Graph) writeDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "graph %s {\n", dotID(exportGraphID))
+	md := g.metaData()
+	for _, name := range sortedKeys(md) {
+		fmt.Fprintf(bw, "\t%s=%s;\n", dotID(name), dotID(md[name]))
+	}
+
+	for _, n := range g.Nodes {
+		fmt.Fprintf(bw, "\t%s%s;\n", dotID(n.ID), dotAttributes(n.Label, n.Attributes))
+	}
+
+	for _, e := range g.Edges {
+		attrs := make(map[string]string, len(e.Attributes)+1)
+		for name, value := range e.Attributes {
+			attrs[name] = value
+		}
+		attrs["id"] = e.ID
+
+		fmt.Fprintf(bw, "\t%s -- %s%s;\n", dotID(e.Source), dotID(e.Target), dotAttributes(e.Label, attrs))
+	}
+
+	fmt.Fprint(bw, "}\n")
+	return bw.Flush()
+}
+
+func dotID(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func dotAttributes(label string, attrs map[string]string) string {
+	list := make([]string, 0, len(attrs)+1)
+	if label != "" {
+		list = append(list, "label="+dotID(label))
+	}
+
+	for _, name := range sortedKeys(attrs) {
+		list = append(list, dotID(name)+"="+dotID(attrs[name]))
+	}
+
+	if len(list) == 0 {
+		return ""
+	}
+
+	return " [" + strings.Join(list, ", ") + "]"
+}
+
+/*
+ * JSON Graph Format v2 (https://jsongraphformat.info)
+ */
+
+type jgf struct {
+	Graph jgfGraph `json:"graph"`
+}
+
+type jgfGraph struct {
+	ID       string             `json:"id"`
+	Type     string             `json:"type"`
+	Directed bool               `json:"directed"`
+	Metadata map[string]string  `json:"metadata"`
+	Nodes    map[string]jgfNode `json:"nodes"`
+	Edges    []jgfEdge          `json:"edges"`
+}
+
+type jgfNode struct {
+	Label    string            `json:"label,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+type jgfEdge struct {
+	ID       string            `json:"id"`
+	Source   string            `json:"source"`
+	Target   string            `json:"target"`
+	Relation string            `json:"relation,omitempty"`
+	Directed bool              `json:"directed"`
+	Label    string            `json:"label,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+func (g *Graph) writeJGF(w io.Writer) error {
+	doc := &jgf{
+		Graph: jgfGraph{
+			ID:       exportGraphID,
+			Type:     "network",
+			Metadata: g.metaData(),
+			Nodes:    make(map[string]jgfNode, len(g.Nodes)),
+			Edges:    make([]jgfEdge, 0, len(g.Edges)),
+		},
+	}
+
+	for _, n := range g.Nodes {
+		doc.Graph.Nodes[n.ID] = jgfNode{
+			Label:    n.Label,
+			Metadata: n.Attributes,
+		}
+	}
+
+	for _, e := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, jgfEdge{
+			ID:       e.ID,
+			Source:   e.Source,
+			Target:   e.Target,
+			Relation: e.Attributes["kind"],
+			Label:    e.Label,
+			Metadata: e.Attributes,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(doc)
+	if err != nil {
+		return fmt.Errorf("unable to encode graph: %v", err)
+	}
+
+	return nil
+}
+
+// metaData returns the attributes of the graph itself
+func (g *Graph) metaData() map[string]string {
+	return map[string]string{
+		"generation": strconv.FormatUint(g.Generation, 10),
+		"timestamp":  g.Timestamp.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,243 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTopologyForExport() *Topology {
+	t := newTestTopologyWithPaths()
+	t.Generation = 42
+	t.Timestamp = time.Unix(1700000000, 0)
+
+	for name, role := range map[string]string{"ccr01.dus01": "ccr", "ccr01.ams01": "ccr", "edge01.dus01": "edge", "pp01.dus01": "patch-panel", "pp02.dus01": "patch-panel"} {
+		t.GetDevice(name).Role = role
+	}
+
+	t.GetDevice("ccr01.dus01").MetaData.SemanticTags["NET:ASN"] = "13335"
+	t.ComputeLogicalLinks()
+	return t
+}
+
+func exactNames(names ...string) []*NameMatcher {
+	ret := make([]*NameMatcher, 0, len(names))
+	for _, name := range names {
+		nm, _ := NewNameMatcher(name, octopuspb.NameMatchType_NAME_MATCH_TYPE_EXACT)
+		ret = append(ret, nm)
+	}
+
+	return ret
+}
+
+func graphNodeIDs(g *Graph) []string {
+	ret := make([]string, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		ret = append(ret, n.ID)
+	}
+
+	sort.Strings(ret)
+	return ret
+}
+
+func graphEdges(g *Graph) []string {
+	ret := make([]string, 0, len(g.Edges))
+	for _, e := range g.Edges {
+		ret = append(ret, e.Attributes["kind"]+": "+e.Source+" -- "+e.Target)
+	}
+
+	sort.Strings(ret)
+	return ret
+}
+
+func TestExportGraph(t *testing.T) {
+	topology := newTestTopologyForExport()
+
+	tests := []struct {
+		name          string
+		opts          *ExportOptions
+		expectedNodes []string
+		expectedEdges []string
+	}{
+		{
+			name:          "devices only",
+			opts:          &ExportOptions{},
+			expectedNodes: []string{"ccr01.ams01", "ccr01.dus01", "edge01.dus01", "pp01.dus01", "pp02.dus01"},
+			expectedEdges: []string{},
+		},
+		{
+			name:          "cables between devices",
+			opts:          &ExportOptions{Cables: true},
+			expectedNodes: []string{"ccr01.ams01", "ccr01.dus01", "edge01.dus01", "pp01.dus01", "pp02.dus01"},
+			expectedEdges: []string{
+				"cable: ccr01.dus01 -- edge01.dus01",
+				"cable: ccr01.dus01 -- pp01.dus01",
+				"cable: ccr01.dus01 -- pp01.dus01",
+				"cable: pp01.dus01 -- pp02.dus01",
+			},
+		},
+		{
+			name:          "paths between devices",
+			opts:          &ExportOptions{Paths: true},
+			expectedNodes: []string{"ccr01.ams01", "ccr01.dus01", "edge01.dus01", "pp01.dus01", "pp02.dus01"},
+			expectedEdges: []string{
+				"path: ccr01.ams01 -- ccr01.dus01",
+				"path: ccr01.dus01 -- edge01.dus01",
+			},
+		},
+		{
+			name: "filtered",
+			opts: &ExportOptions{
+				Filter: &DeviceFilter{Roles: []string{"ccr"}},
+				Cables: true,
+				Paths:  true,
+			},
+			expectedNodes: []string{"ccr01.ams01", "ccr01.dus01"},
+			expectedEdges: []string{"path: ccr01.ams01 -- ccr01.dus01"},
+		},
+		{
+			name: "interfaces, ports and circuits",
+			opts: &ExportOptions{
+				Filter:     &DeviceFilter{Names: exactNames("ccr01.ams01")},
+				Interfaces: true,
+				Ports:      true,
+				Circuits:   true,
+				Cables:     true,
+			},
+			expectedNodes: []string{"ccr01.ams01", "ccr01.ams01:et-0/0/0", "circuit:CID-1"},
+			expectedEdges: []string{
+				"cable: ccr01.ams01:et-0/0/0 -- circuit:CID-1",
+				"interface: ccr01.ams01 -- ccr01.ams01:et-0/0/0",
+			},
+		},
+		{
+			name: "patch panel ports",
+			opts: &ExportOptions{
+				Filter: &DeviceFilter{Names: exactNames("pp01.dus01")},
+				Ports:  true,
+			},
+			expectedNodes: []string{"pp01.dus01", "pp01.dus01:front:1", "pp01.dus01:front:2", "pp01.dus01:rear:R1"},
+			expectedEdges: []string{
+				"port: pp01.dus01 -- pp01.dus01:front:1",
+				"port: pp01.dus01 -- pp01.dus01:front:2",
+				"port: pp01.dus01 -- pp01.dus01:rear:R1",
+				"port_mapping: pp01.dus01:front:1 -- pp01.dus01:rear:R1",
+				"port_mapping: pp01.dus01:front:2 -- pp01.dus01:rear:R1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		g := topology.ExportGraph(test.opts)
+		assert.Equal(t, uint64(42), g.Generation, test.name)
+		assert.Equal(t, test.expectedNodes, graphNodeIDs(g), test.name)
+		assert.Equal(t, test.expectedEdges, graphEdges(g), test.name)
+	}
+}
+
+func TestExportGraphAttributes(t *testing.T) {
+	topology := newTestTopologyForExport()
+	g := topology.ExportGraph(&ExportOptions{
+		Filter: &DeviceFilter{Names: exactNames("ccr01.dus01", "ccr01.ams01")},
+		Paths:  true,
+	})
+
+	for _, n := range g.Nodes {
+		if n.ID == "ccr01.dus01" {
+			assert.Equal(t, map[string]string{
+				"kind":                  "device",
+				"role":                  "ccr",
+				"semantic_tags.NET:ASN": "13335",
+			}, n.Attributes)
+		}
+	}
+
+	if assert.Len(t, g.Edges, 1) {
+		assert.Equal(t, "path", g.Edges[0].Attributes["kind"])
+		assert.Equal(t, "false", g.Edges[0].Attributes["direct"])
+		assert.Equal(t, "CID-1", g.Edges[0].Attributes["circuits"])
+		assert.Len(t, strings.Split(g.Edges[0].Attributes["cables"], ","), 4)
+	}
+}
+
+func TestExportFormats(t *testing.T) {
+	topology := newTestTopologyForExport()
+	opts := &ExportOptions{Interfaces: true, Cables: true, Paths: true}
+	g := topology.ExportGraph(opts)
+
+	for _, format := range []ExportFormat{ExportGraphML, ExportDOT, ExportGEXF, ExportJGF} {
+		buf := &bytes.Buffer{}
+		err := topology.Export(buf, format, opts)
+		if !assert.NoError(t, err, format.String()) {
+			continue
+		}
+
+		switch format {
+		case ExportGraphML:
+			doc := &graphML{}
+			assert.NoError(t, xml.Unmarshal(buf.Bytes(), doc), format.String())
+			assert.Len(t, doc.Graph.Nodes, len(g.Nodes), format.String())
+			assert.Len(t, doc.Graph.Edges, len(g.Edges), format.String())
+		case ExportGEXF:
+			doc := &gexf{}
+			assert.NoError(t, xml.Unmarshal(buf.Bytes(), doc), format.String())
+			assert.Equal(t, "2023-11-14", doc.Meta.LastModified, format.String())
+			assert.Len(t, doc.Graph.Nodes, len(g.Nodes), format.String())
+			assert.Len(t, doc.Graph.Edges, len(g.Edges), format.String())
+		case ExportJGF:
+			doc := &jgf{}
+			assert.NoError(t, json.Unmarshal(buf.Bytes(), doc), format.String())
+			assert.Equal(t, "42", doc.Graph.Metadata["generation"], format.String())
+			assert.Len(t, doc.Graph.Nodes, len(g.Nodes), format.String())
+			assert.Len(t, doc.Graph.Edges, len(g.Edges), format.String())
+		case ExportDOT:
+			dot := buf.String()
+			assert.True(t, strings.HasPrefix(dot, `graph "topology" {`), format.String())
+			assert.Contains(t, dot, `"ccr01.dus01:et-0/0/1" -- "edge01.dus01:et-0/0/1"`, format.String())
+			assert.Equal(t, len(g.Edges), strings.Count(dot, " -- \""), format.String())
+		}
+	}
+}
+
+func TestExportFormatFromString(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantFail bool
+		expected ExportFormat
+	}{
+		{name: "graphml", expected: ExportGraphML},
+		{name: "dot", expected: ExportDOT},
+		{name: "gexf", expected: ExportGEXF},
+		{name: "jgf", expected: ExportJGF},
+		{name: "svg", wantFail: true},
+	}
+
+	for _, test := range tests {
+		f, err := ExportFormatFromString(test.name)
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			continue
+		}
+
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, f, test.name)
+	}
+}
+
+func TestDOTID(t *testing.T) {
+	assert.Equal(t, `"a \"b\" \\c"`, dotID(`a "b" \c`))
+}
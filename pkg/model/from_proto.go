@@ -0,0 +1,196 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+)
+
+// TopologyFromProto restores a Topology from its proto representation, e.g. a snapshot persisted to disk (including the provenance if present).
+// Logical links, the adjacency and the IP index are recomputed. Connector specific IDs (of interfaces and prefixes) are not
+// part of the proto, prefixes get IDs by their order instead.
+func TopologyFromProto(pt *octopuspb.Topology) (*Topology, error) {
+	if pt == nil {
+		return nil, fmt.Errorf("no topology given")
+	}
+
+	t := NewTopology()
+	t.Timestamp = time.Unix(int64(pt.Timestamp), 0)
+	t.Generation = pt.Generation
+	t.Stale = pt.Stale
+
+	for _, pcp := range pt.Connectors {
+		t.Connectors = append(t.Connectors, connectorProvenanceFromProto(pcp))
+	}
+
+	for _, pmc := range pt.MergeConflicts {
+		t.MergeConflicts = append(t.MergeConflicts, mergeConflictFromProto(pmc))
+	}
+
+	for _, s := range pt.Sites {
+		t.AddSiteIfNotExists(s.Name)
+	}
+
+	for _, p := range pt.Pops {
+		t.AddPopIfNotExists(p.Name)
+	}
+
+	for _, c := range pt.Colos {
+		colo := t.AddColoIfNotExists(uint16(c.Id), c.Name, c.Pop)
+		colo.Status = c.Status
+		colo.Region = c.Region
+		colo.Tier = uint8(c.Tier)
+		colo.Animal = c.Animal
+		colo.IsMCP = c.IsMcp
+		colo.IsFedramp = c.IsFedramp
+		colo.Provenance = provenanceFromProto(c.Provenance)
+
+		for _, siteName := range c.Sites {
+			site := t.AddSiteIfNotExists(siteName)
+			colo.Sites = append(colo.Sites, site)
+			site.Colos = append(site.Colos, colo)
+		}
+	}
+
+	for _, pd := range pt.Devices {
+		err := t.addDeviceFromProto(pd)
+		if err != nil {
+			return nil, fmt.Errorf("device %q: %v", pd.Name, err)
+		}
+	}
+
+	for _, pc := range pt.Cables {
+		if pc.AEnd == nil || pc.BEnd == nil {
+			return nil, fmt.Errorf("cable without ends")
+		}
+
+		c := &Cable{
+			AEnd: cableEndFromProto(pc.AEnd),
+			BEnd: cableEndFromProto(pc.BEnd),
+
+			Provenance: provenanceFromProto(pc.Provenance),
+		}
+		t.Cables[c.String()] = c
+	}
+
+	for _, pc := range pt.Circuits {
+		c := NewCircuit(pc.Cid, pc.Provider, pc.Type, pc.Status)
+		c.MetaData = metaDataFromProto(pc.MetaData)
+		c.Provenance = provenanceFromProto(pc.Provenance)
+		t.Circuits[c.CID] = c
+	}
+
+	for i, pp := range pt.Prefixes {
+		if pp.Prefix == nil {
+			return nil, fmt.Errorf("prefix without prefix")
+		}
+
+		p := NewPrefix(*bnet.NewPrefixFromProtoPrefix(pp.Prefix))
+		p.MetaData = metaDataFromProto(pp.MetaData)
+		p.Provenance = provenanceFromProto(pp.Provenance)
+		t.Prefixes[int64(i)] = p
+	}
+
+	t.ComputeLogicalLinks()
+	t.IndexIPs()
+
+	return t, nil
+}
+
+func (t *Topology) addDeviceFromProto(pd *octopuspb.Device) error {
+	d := t.AddDeviceIfNotExists(pd.Name)
+	d.Status = pd.Status
+	d.Role = pd.Role
+	d.Platform = pd.Platform
+	d.DeviceType = pd.DeviceType
+	d.MetaData = metaDataFromProto(pd.MetaData)
+	d.Provenance = provenanceFromProto(pd.Provenance)
+
+	if pd.ColoId != 0 {
+		d.Colo = t.GetColo(uint16(pd.ColoId))
+	}
+
+	if pd.SiteName != "" {
+		d.Site = t.AddSiteIfNotExists(pd.SiteName)
+	}
+
+	for _, pi := range pd.Interfaces {
+		ifa := d.AddInterfaceItNotExists(pi.Name)
+		ifa.Type = pi.Type
+		ifa.LAGMemberOf = pi.LagMemberOf
+		ifa.MetaData = metaDataFromProto(pi.MetaData)
+		ifa.Provenance = provenanceFromProto(pi.Provenance)
+
+		for _, pu := range pi.Units {
+			u := ifa.AddUnitIfNotExists(NewVLANTag(uint16(pu.OuterTag), uint16(pu.InnerTag)))
+			u.ID = pu.Id
+			u.MetaData = metaDataFromProto(pu.MetaData)
+			u.Provenance = provenanceFromProto(pu.Provenance)
+
+			for _, addrs := range [][]*octopuspb.IPAddress{pu.Ipv4Addresses, pu.Ipv6Addresses} {
+				for _, pa := range addrs {
+					if pa.IP == nil {
+						return fmt.Errorf("IP address without IP on %s", pi.Name)
+					}
+
+					ip := NewIP(*bnet.NewPrefixFromProtoPrefix(pa.IP))
+					ip.MetaData = metaDataFromProto(pa.MetaData)
+					ifa.AddIPAddressIfNotExists(u.VLANTag, ip)
+				}
+			}
+		}
+	}
+
+	for _, pfp := range pd.FrontPorts {
+		d.FrontPorts[pfp.Name] = &FrontPort{
+			Name:             pfp.Name,
+			RearPort:         pfp.RearPort,
+			RearPortPosition: pfp.RearPortPosition,
+		}
+	}
+
+	for _, prp := range pd.RearPorts {
+		d.RearPorts[prp.Name] = &RearPort{
+			Name:      prp.Name,
+			Positions: int16(prp.Positions),
+		}
+	}
+
+	return nil
+}
+
+func cableEndFromProto(ce *octopuspb.CableEnd) CableEnd {
+	return CableEnd{
+		DeviceName:   ce.DeviceName,
+		EndpointName: ce.EndpointName,
+		EndpointType: ce.EndpointType,
+	}
+}
+
+func metaDataFromProto(pm *octopuspb.MetaData) *MetaData {
+	md := NewMetaData()
+	if pm == nil {
+		return md
+	}
+
+	if pm.Tags != nil {
+		md.Tags = pm.Tags
+	}
+
+	if pm.SemanticTags != nil {
+		md.SemanticTags = pm.SemanticTags
+	}
+
+	md.CustomFieldData = pm.CustomFieldData
+	return md
+}
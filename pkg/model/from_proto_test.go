@@ -0,0 +1,77 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+)
+
+func TestTopologyFromProto(t *testing.T) {
+	topology := newTestTopologyWithPaths()
+	topology.Timestamp = time.Unix(1700000000, 0)
+	topology.Generation = 42
+	topology.Connectors = []*ConnectorProvenance{
+		{Connector: "NetBox", Policy: ConnectorRequired, LoadTime: time.Unix(1699999940, 0)},
+		{Connector: "File", Policy: ConnectorOptionalSkip, Contribution: ContributionSkipped},
+	}
+
+	colo := topology.AddColoIfNotExists(1, "DUS", "dus01")
+	colo.Region = "Europe"
+	colo.Sites = append(colo.Sites, topology.AddSiteIfNotExists("DUS01"))
+
+	ccr := topology.GetDevice("ccr01.dus01")
+	ccr.Role = "ccr"
+	ccr.Colo = colo
+	ccr.Site = topology.Sites["DUS01"]
+	ccr.MetaData.SemanticTags["NET:ASN"] = "13335"
+	ccr.Provenance = Provenance{"role": {Connector: "NetBox", ObjectID: "dcim.device:1"}}
+	topology.MergeConflicts = []*MergeConflict{
+		{
+			Entity:        "device",
+			Key:           "ccr01.dus01",
+			Field:         "role",
+			Strategy:      MergeFirstWriterWins,
+			Resolution:    ResolutionKept,
+			Existing:      Source{Connector: "NetBox", ObjectID: "dcim.device:1"},
+			ExistingValue: "ccr",
+			Incoming:      Source{Connector: "File", ObjectID: "lab.yaml"},
+			IncomingValue: "lab",
+		},
+	}
+	ccr.GetInterface("et-0/0/1").LAGMemberOf = "ae0"
+
+	ae0 := ccr.AddInterfaceItNotExists("ae0")
+	ip := NewIP(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 31))
+	ip.MetaData.CustomFieldData = `{"foo": "bar"}`
+	ae0.AddIPAddressIfNotExists(NewVLANTag(0, 100), ip)
+	ae0.AddIPAddressIfNotExists(NewVLANTag(0, 100), NewIP(bnet.NewPfx(bnet.IPv6FromBlocks(0x2001, 0xdb8, 0, 0, 0, 0, 0, 0), 127)))
+
+	topology.Prefixes[23] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24))
+	topology.ComputeLogicalLinks()
+
+	expected := topology.ToProtoWithProvenance()
+	restored, err := TopologyFromProto(expected)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, restored.ToProtoWithProvenance())
+
+	// Derived data is rebuilt
+	assert.Equal(t, len(topology.LogicalLinks), len(restored.LogicalLinks))
+	res, err := restored.LookupIP(bnet.IPv4FromOctets(192, 0, 2, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res.Owners))
+	assert.Equal(t, 1, len(res.Prefixes))
+
+	_, err = TopologyFromProto(&octopuspb.Topology{Cables: []*octopuspb.Cable{{}}})
+	assert.Error(t, err)
+}
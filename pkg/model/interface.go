@@ -17,6 +17,11 @@ type Interface struct {
 	LAGMemberOf string
 	Units       map[VLANTag]*InterfaceUnit
 	MetaData    *MetaData
+
+	// ID of the object in the data source of the connector which added it to its partial topology
+	SourceID string
+	// Sources of the attributes, recorded when merging the partial topologies
+	Provenance Provenance
 }
 
 type VLANTag struct {
@@ -37,6 +42,11 @@ type InterfaceUnit struct {
 	IPv4Addresses []IP
 	IPv6Addresses []IP
 	MetaData      *MetaData
+
+	// ID of the object in the data source of the connector which added it to its partial topology
+	SourceID string
+	// Sources of the attributes, recorded when merging the partial topologies
+	Provenance Provenance
 }
 
 func newInterface(name string) *Interface {
@@ -79,6 +89,10 @@ func (iface *Interface) AddIPAddressIfNotExists(vlanTag VLANTag, newIP IP) {
 }
 
 func (iface *Interface) ToProto() *octopuspb.Interface {
+	return iface.toProto(false)
+}
+
+func (iface *Interface) toProto(withProvenance bool) *octopuspb.Interface {
 	if iface == nil {
 		return nil
 	}
@@ -90,10 +104,14 @@ func (iface *Interface) ToProto() *octopuspb.Interface {
 		MetaData:    iface.MetaData.ToProto(),
 	}
 
+	if withProvenance {
+		protoIface.Provenance = iface.Provenance.ToProto()
+	}
+
 	if len(iface.Units) > 0 {
 		protoIface.Units = make([]*octopuspb.InterfaceUnit, 0)
 		for _, unit := range iface.Units {
-			protoIface.Units = append(protoIface.Units, unit.ToProto())
+			protoIface.Units = append(protoIface.Units, unit.toProto(withProvenance))
 		}
 	}
 
@@ -101,6 +119,10 @@ func (iface *Interface) ToProto() *octopuspb.Interface {
 }
 
 func (unit *InterfaceUnit) ToProto() *octopuspb.InterfaceUnit {
+	return unit.toProto(false)
+}
+
+func (unit *InterfaceUnit) toProto(withProvenance bool) *octopuspb.InterfaceUnit {
 	if unit == nil {
 		return nil
 	}
@@ -112,6 +134,10 @@ func (unit *InterfaceUnit) ToProto() *octopuspb.InterfaceUnit {
 		InnerTag: uint32(unit.InnerTag),
 	}
 
+	if withProvenance {
+		protoUnit.Provenance = unit.Provenance.ToProto()
+	}
+
 	if len(unit.IPv4Addresses) > 0 {
 		protoUnit.Ipv4Addresses = make([]*octopuspb.IPAddress, 0)
 		for _, IP := range unit.IPv4Addresses {
@@ -17,6 +17,9 @@ import (
 type IP struct {
 	Address  bnet.Prefix
 	MetaData *MetaData
+
+	// ID of the object in the data source of the connector which added it to its partial topology
+	SourceID string
 }
 
 func NewIP(ip bnet.Prefix) IP {
@@ -0,0 +1,289 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"sort"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// IPOwner is an interface unit an IP address is configured on
+type IPOwner struct {
+	DeviceName    string
+	InterfaceName string
+	Unit          *InterfaceUnit
+	IP            IP
+}
+
+// IPLookupResult holds the owners of the looked up address or prefix and all prefixes covering it, most specific first
+type IPLookupResult struct {
+	Owners   []*IPOwner
+	Prefixes []*Prefix
+}
+
+// ipIndex is a binary trie per address family, holding prefixes and interface addresses
+type ipIndex struct {
+	v4 *ipIndexNode
+	v6 *ipIndexNode
+}
+
+type ipIndexNode struct {
+	children [2]*ipIndexNode
+	prefixes []*Prefix
+	owners   []*IPOwner
+}
+
+func newIPIndex() *ipIndex {
+	return &ipIndex{
+		v4: &ipIndexNode{},
+		v6: &ipIndexNode{},
+	}
+}
+
+func (idx *ipIndex) root(addr bnet.IP) *ipIndexNode {
+	if addr.IsIPv4() {
+		return idx.v4
+	}
+
+	return idx.v6
+}
+
+// node returns the node of the given prefix. If create is false and the node doesn't exist, the deepest existing node on the way is returned.
+func (idx *ipIndex) node(pfx bnet.Prefix, create bool) (*ipIndexNode, bool) {
+	addr := pfx.Addr()
+	n := idx.root(addr)
+	for pos := uint8(1); pos <= pfx.Len(); pos++ {
+		bit := 0
+		if addr.BitAtPosition(pos) {
+			bit = 1
+		}
+
+		if n.children[bit] == nil {
+			if !create {
+				return n, false
+			}
+
+			n.children[bit] = &ipIndexNode{}
+		}
+
+		n = n.children[bit]
+	}
+
+	return n, true
+}
+
+func (idx *ipIndex) addPrefix(p *Prefix) {
+	n, _ := idx.node(p.Prefix, true)
+	n.prefixes = append(n.prefixes, p)
+}
+
+func (idx *ipIndex) addOwner(o *IPOwner) {
+	addr := o.IP.Address.Addr()
+	n, _ := idx.node(bnet.NewPfx(addr, maxPrefixLength(addr)), true)
+	n.owners = append(n.owners, o)
+}
+
+// coveringPrefixes returns all prefixes containing the given prefix, most specific first
+func (idx *ipIndex) coveringPrefixes(pfx bnet.Prefix) []*Prefix {
+	addr := pfx.Addr()
+	n := idx.root(addr)
+	res := append([]*Prefix{}, n.prefixes...)
+	for pos := uint8(1); pos <= pfx.Len(); pos++ {
+		bit := 0
+		if addr.BitAtPosition(pos) {
+			bit = 1
+		}
+
+		n = n.children[bit]
+		if n == nil {
+			break
+		}
+
+		res = append(res, n.prefixes...)
+	}
+
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return res
+}
+
+// ownersWithin returns the owners of all addresses contained in the given prefix
+func (idx *ipIndex) ownersWithin(pfx bnet.Prefix) []*IPOwner {
+	res := make([]*IPOwner, 0)
+	n, exists := idx.node(pfx, false)
+	if !exists {
+		return res
+	}
+
+	var walk func(n *ipIndexNode)
+	walk = func(n *ipIndexNode) {
+		res = append(res, n.owners...)
+		for _, c := range n.children {
+			if c != nil {
+				walk(c)
+			}
+		}
+	}
+
+	walk(n)
+	return res
+}
+
+func maxPrefixLength(addr bnet.IP) uint8 {
+	if addr.IsIPv4() {
+		return 32
+	}
+
+	return 128
+}
+
+// IndexIPs builds the index of all prefixes and interface addresses used by LookupIP and LookupPrefix
+func (t *Topology) IndexIPs() {
+	idx := newIPIndex()
+
+	ids := make([]int64, 0, len(t.Prefixes))
+	for id := range t.Prefixes {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	for _, id := range ids {
+		idx.addPrefix(t.Prefixes[id])
+	}
+
+	for _, devName := range sortedKeys(t.Nodes) {
+		d := t.Nodes[devName]
+		for _, ifName := range sortedKeys(d.Interfaces) {
+			ifa := d.Interfaces[ifName]
+			for _, u := range sortedUnits(ifa) {
+				for _, ips := range [][]IP{u.IPv4Addresses, u.IPv6Addresses} {
+					for _, ip := range ips {
+						idx.addOwner(&IPOwner{
+							DeviceName:    devName,
+							InterfaceName: ifName,
+							Unit:          u,
+							IP:            ip,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	t.ipIndex = idx
+}
+
+func sortedUnits(ifa *Interface) []*InterfaceUnit {
+	units := make([]*InterfaceUnit, 0, len(ifa.Units))
+	for _, u := range ifa.Units {
+		units = append(units, u)
+	}
+
+	sort.Slice(units, func(i, j int) bool {
+		if units[i].OuterTag != units[j].OuterTag {
+			return units[i].OuterTag < units[j].OuterTag
+		}
+
+		return units[i].InnerTag < units[j].InnerTag
+	})
+
+	return units
+}
+
+// LookupIP returns the interface units the given address is configured on and the prefixes covering it
+func (t *Topology) LookupIP(addr bnet.IP) (*IPLookupResult, error) {
+	if t.ipIndex == nil {
+		return nil, fmt.Errorf("IP index not built")
+	}
+
+	pfx := bnet.NewPfx(addr, maxPrefixLength(addr))
+	n, exists := t.ipIndex.node(pfx, false)
+
+	res := &IPLookupResult{
+		Owners:   make([]*IPOwner, 0),
+		Prefixes: t.ipIndex.coveringPrefixes(pfx),
+	}
+
+	if exists {
+		res.Owners = append(res.Owners, n.owners...)
+	}
+
+	return res, nil
+}
+
+// LookupPrefix returns the interface units with addresses within the given prefix and the prefixes covering it, including itself
+func (t *Topology) LookupPrefix(pfx bnet.Prefix) (*IPLookupResult, error) {
+	if t.ipIndex == nil {
+		return nil, fmt.Errorf("IP index not built")
+	}
+
+	if pfx.Len() > maxPrefixLength(pfx.Addr()) {
+		return nil, fmt.Errorf("invalid prefix length %d", pfx.Len())
+	}
+
+	pfx = bnet.NewPfx(pfx.BaseAddr(), pfx.Len())
+	return &IPLookupResult{
+		Owners:   t.ipIndex.ownersWithin(pfx),
+		Prefixes: t.ipIndex.coveringPrefixes(pfx),
+	}, nil
+}
+
+func (o *IPOwner) ToProto() *octopuspb.IPOwner {
+	if o == nil {
+		return nil
+	}
+
+	return &octopuspb.IPOwner{
+		DeviceName:    o.DeviceName,
+		InterfaceName: o.InterfaceName,
+		UnitId:        o.Unit.ID,
+		OuterTag:      uint32(o.Unit.OuterTag),
+		InnerTag:      uint32(o.Unit.InnerTag),
+		Address:       o.IP.ToProto(),
+	}
+}
+
+func (r *IPLookupResult) ownersToProto() []*octopuspb.IPOwner {
+	ret := make([]*octopuspb.IPOwner, 0, len(r.Owners))
+	for _, o := range r.Owners {
+		ret = append(ret, o.ToProto())
+	}
+
+	return ret
+}
+
+func (r *IPLookupResult) prefixesToProto() []*octopuspb.Prefix {
+	ret := make([]*octopuspb.Prefix, 0, len(r.Prefixes))
+	for _, p := range r.Prefixes {
+		ret = append(ret, p.ToProto())
+	}
+
+	return ret
+}
+
+func (r *IPLookupResult) ToLookupIPResponse() *octopuspb.LookupIPResponse {
+	return &octopuspb.LookupIPResponse{
+		Owners:   r.ownersToProto(),
+		Prefixes: r.prefixesToProto(),
+	}
+}
+
+func (r *IPLookupResult) ToLookupPrefixResponse() *octopuspb.LookupPrefixResponse {
+	return &octopuspb.LookupPrefixResponse{
+		Owners:   r.ownersToProto(),
+		Prefixes: r.prefixesToProto(),
+	}
+}
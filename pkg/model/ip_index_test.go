@@ -0,0 +1,123 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"testing"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPLookup(t *testing.T) {
+	topology := NewTopology()
+
+	ccr := topology.AddDeviceIfNotExists("ccr01.dus01")
+	ifa := ccr.AddInterfaceItNotExists("et-0/0/0")
+	ifa.AddIPAddressIfNotExists(NewVLANTag(0, 100), NewIP(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 1), 31)))
+	ifa.AddIPAddressIfNotExists(NewVLANTag(0, 100), NewIP(bnet.NewPfx(bnet.IPv6FromBlocks(0x2001, 0xdb8, 0, 0, 0, 0, 0, 1), 127)))
+	u := ifa.Units[NewVLANTag(0, 100)]
+
+	edge := topology.AddDeviceIfNotExists("edge01.dus01")
+	edgeIfa := edge.AddInterfaceItNotExists("et-0/0/0")
+	edgeIfa.AddIPAddressIfNotExists(NewVLANTag(0, 0), NewIP(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 31)))
+	edgeUnit := edgeIfa.Units[NewVLANTag(0, 0)]
+
+	p16 := NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 0, 0), 16))
+	p24 := NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24))
+	p25 := NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 128), 25))
+	p32 := NewPrefix(bnet.NewPfx(bnet.IPv6FromBlocks(0x2001, 0xdb8, 0, 0, 0, 0, 0, 0), 32))
+	topology.Prefixes[1] = p24
+	topology.Prefixes[2] = p16
+	topology.Prefixes[3] = p25
+	topology.Prefixes[4] = p32
+
+	topology.IndexIPs()
+
+	tests := []struct {
+		name     string
+		addr     bnet.IP
+		expected *IPLookupResult
+	}{
+		{
+			name: "IPv4 interface address",
+			addr: bnet.IPv4FromOctets(192, 0, 2, 1),
+			expected: &IPLookupResult{
+				Owners: []*IPOwner{
+					{
+						DeviceName:    "ccr01.dus01",
+						InterfaceName: "et-0/0/0",
+						Unit:          u,
+						IP:            u.IPv4Addresses[0],
+					},
+				},
+				Prefixes: []*Prefix{p24, p16},
+			},
+		},
+		{
+			name: "IPv6 interface address",
+			addr: bnet.IPv6FromBlocks(0x2001, 0xdb8, 0, 0, 0, 0, 0, 1),
+			expected: &IPLookupResult{
+				Owners: []*IPOwner{
+					{
+						DeviceName:    "ccr01.dus01",
+						InterfaceName: "et-0/0/0",
+						Unit:          u,
+						IP:            u.IPv6Addresses[0],
+					},
+				},
+				Prefixes: []*Prefix{p32},
+			},
+		},
+		{
+			name: "unused address",
+			addr: bnet.IPv4FromOctets(192, 0, 2, 200),
+			expected: &IPLookupResult{
+				Owners:   []*IPOwner{},
+				Prefixes: []*Prefix{p25, p24, p16},
+			},
+		},
+		{
+			name: "unknown address",
+			addr: bnet.IPv4FromOctets(198, 51, 100, 1),
+			expected: &IPLookupResult{
+				Owners:   []*IPOwner{},
+				Prefixes: []*Prefix{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := topology.LookupIP(test.addr)
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, res, test.name)
+	}
+
+	res, err := topology.LookupPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24))
+	assert.NoError(t, err)
+	assert.Equal(t, &IPLookupResult{
+		Owners: []*IPOwner{
+			{
+				DeviceName:    "edge01.dus01",
+				InterfaceName: "et-0/0/0",
+				Unit:          edgeUnit,
+				IP:            edgeUnit.IPv4Addresses[0],
+			},
+			{
+				DeviceName:    "ccr01.dus01",
+				InterfaceName: "et-0/0/0",
+				Unit:          u,
+				IP:            u.IPv4Addresses[0],
+			},
+		},
+		Prefixes: []*Prefix{p24, p16},
+	}, res)
+
+	_, err = topology.LookupPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 33))
+	assert.Error(t, err)
+}
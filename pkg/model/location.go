@@ -32,6 +32,9 @@ type Colo struct {
 	IsFedramp bool
 	Pop       *Pop
 	Sites     []*Site
+
+	// Sources of the attributes, recorded when merging the partial topologies
+	Provenance Provenance
 }
 
 func NewSite(name string) *Site {
@@ -78,6 +81,15 @@ func (p *Pop) ToProto() *octopuspb.Pop {
 }
 
 func (c *Colo) ToProto() *octopuspb.Colo {
+	return c.toProto(false)
+}
+
+// ToProtoWithProvenance includes the provenance of the colo
+func (c *Colo) ToProtoWithProvenance() *octopuspb.Colo {
+	return c.toProto(true)
+}
+
+func (c *Colo) toProto(withProvenance bool) *octopuspb.Colo {
 	if c == nil {
 		return nil
 	}
@@ -99,5 +111,9 @@ func (c *Colo) ToProto() *octopuspb.Colo {
 		colo.Sites[i] = site.Name
 	}
 
+	if withProvenance {
+		colo.Provenance = c.Provenance.ToProto()
+	}
+
 	return colo
 }
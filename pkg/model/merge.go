@@ -0,0 +1,613 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// merger merges the partial topology of a connector into the topology
+type merger struct {
+	t         *Topology
+	connector string
+	policies  *MergePolicies
+}
+
+// Merge adds the data of the (partial) topology src, built by the given connector, to t:
+//   - Attributes set in src and t to different values are resolved by the merge policies (src wins by default)
+//   - Site, pop and colo references are resolved by name / ID within t
+//   - Devices, interfaces, units, IP addresses, ports and circuits are matched by name / VLAN tag / address / CID
+//   - Prefixes are matched by connector and ID only, prefixes of different connectors are kept apart even if they share an ID
+//   - Tags are combined by default, semantic tags are resolved by key
+//   - Cables of src conflict with all cables of t connected to either of their ends
+//
+// Conflicts are added to t.MergeConflicts, the ones of fields configured as MergeErrorOnConflict keep the value of t.
+// The provenance of every attribute set from src is recorded along with the source object IDs set by the connector.
+// The objects of src are copied, so src can not be used to modify t afterwards.
+// Logical links, the adjacency and the IP index are not merged and have to be computed after the last merge.
+func (t *Topology) Merge(src *Topology, connector string, policies *MergePolicies) {
+	if policies == nil {
+		policies = DefaultMergePolicies()
+	}
+
+	m := &merger{
+		t:         t,
+		connector: connector,
+		policies:  policies,
+	}
+
+	for name := range src.Sites {
+		t.AddSiteIfNotExists(name)
+	}
+
+	for name := range src.Pops {
+		t.AddPopIfNotExists(name)
+	}
+
+	for _, c := range src.Colos {
+		m.mergeColo(c)
+	}
+
+	for _, d := range src.Nodes {
+		m.mergeDevice(d)
+	}
+
+	for id, d := range src.DevicesByInterfaceID {
+		t.DevicesByInterfaceID[id] = t.Nodes[d.Name]
+	}
+
+	for id, ifa := range src.Interfaces {
+		d, exists := src.DevicesByInterfaceID[id]
+		if !exists {
+			continue
+		}
+
+		t.Interfaces[id] = t.Nodes[d.Name].Interfaces[ifa.Name]
+	}
+
+	for cid, c := range src.Circuits {
+		m.mergeCircuit(cid, c)
+	}
+
+	// Sorted, so the IDs given to prefixes whose ID is taken don't depend on the map order
+	prefixIDs := make([]int64, 0, len(src.Prefixes))
+	for id := range src.Prefixes {
+		prefixIDs = append(prefixIDs, id)
+	}
+
+	slices.Sort(prefixIDs)
+	nextPrefixID := max(t.maxPrefixID(), src.maxPrefixID()) + 1
+	for _, id := range prefixIDs {
+		m.mergePrefix(id, src.Prefixes[id], &nextPrefixID)
+	}
+
+	m.mergeCables(src.Cables)
+	sortMergeConflicts(t.MergeConflicts)
+}
+
+// resolve decides if the incoming value replaces the existing one and records a conflict if they differ.
+// field is the name the policy is configured for, attr the provenance key of the existing value.
+func (m *merger) resolve(entity string, key string, field string, attr string, p provenanceRecorder, existingValue string, incomingValue string) bool {
+	if existingValue == "" {
+		return true
+	}
+
+	existing := p.provenance[attr]
+	strategy := m.policies.strategy(entity, field)
+	replace := m.policies.replaces(strategy, m.connector, existing.Connector)
+	if existingValue == incomingValue {
+		return replace
+	}
+
+	resolution := ResolutionKept
+	if replace {
+		resolution = ResolutionReplaced
+	} else if strategy == MergeErrorOnConflict {
+		resolution = ResolutionError
+	}
+
+	m.t.MergeConflicts = append(m.t.MergeConflicts, &MergeConflict{
+		Entity:        entity,
+		Key:           key,
+		Field:         field,
+		Strategy:      strategy,
+		Resolution:    resolution,
+		Existing:      existing,
+		ExistingValue: existingValue,
+		Incoming:      p.source,
+		IncomingValue: incomingValue,
+	})
+
+	return replace
+}
+
+// mergeString sets dst to value if not empty and the merge policy of the field allows it
+func (m *merger) mergeString(entity string, key string, field string, p provenanceRecorder, dst *string, value string) {
+	if value == "" {
+		return
+	}
+
+	if m.resolve(entity, key, field, field, p, *dst, value) {
+		*dst = value
+		p.set(field)
+	}
+}
+
+func (m *merger) mergeColo(src *Colo) {
+	const entity = "colo"
+
+	popName := ""
+	if src.Pop != nil {
+		popName = src.Pop.Name
+	}
+
+	c, exists := m.t.Colos[src.Id]
+	if !exists {
+		c = m.t.AddColoIfNotExists(src.Id, "", popName)
+	}
+
+	key := strconv.Itoa(int(c.Id))
+	p := recordTo(&c.Provenance, m.connector, "")
+	if !exists {
+		p.set("id")
+		if src.Pop != nil {
+			p.set("pop")
+		}
+	} else if src.Pop != nil && m.resolve(entity, key, "pop", "pop", p, c.Pop.Name, popName) {
+		if c.Pop.Name != popName {
+			c.Pop.Colos = slices.DeleteFunc(c.Pop.Colos, func(other *Colo) bool {
+				return other == c
+			})
+
+			c.Pop = m.t.AddPopIfNotExists(popName)
+			c.Pop.Colos = append(c.Pop.Colos, c)
+		}
+
+		p.set("pop")
+	}
+
+	m.mergeString(entity, key, "name", p, &c.Name, src.Name)
+	m.mergeString(entity, key, "status", p, &c.Status, src.Status)
+	m.mergeString(entity, key, "region", p, &c.Region, src.Region)
+	m.mergeString(entity, key, "animal", p, &c.Animal, src.Animal)
+
+	if src.Tier != 0 {
+		existing := ""
+		if c.Tier != 0 {
+			existing = strconv.Itoa(int(c.Tier))
+		}
+
+		if m.resolve(entity, key, "tier", "tier", p, existing, strconv.Itoa(int(src.Tier))) {
+			c.Tier = src.Tier
+			p.set("tier")
+		}
+	}
+
+	// Connectors can't tell a flag being unset from it being false, so only set flags are merged
+	m.mergeFlag(entity, key, "is_mcp", p, &c.IsMCP, src.IsMCP)
+	m.mergeFlag(entity, key, "is_fedramp", p, &c.IsFedramp, src.IsFedramp)
+
+	for _, srcSite := range src.Sites {
+		if slices.ContainsFunc(c.Sites, func(s *Site) bool { return s.Name == srcSite.Name }) {
+			continue
+		}
+
+		site := m.t.AddSiteIfNotExists(srcSite.Name)
+		c.Sites = append(c.Sites, site)
+		site.Colos = append(site.Colos, c)
+	}
+}
+
+// mergeFlag sets dst if value is set and the merge policy of the field allows it
+func (m *merger) mergeFlag(entity string, key string, field string, p provenanceRecorder, dst *bool, value bool) {
+	if !value {
+		return
+	}
+
+	existing := ""
+	if *dst {
+		existing = strconv.FormatBool(*dst)
+	}
+
+	if m.resolve(entity, key, field, field, p, existing, strconv.FormatBool(value)) {
+		*dst = value
+		p.set(field)
+	}
+}
+
+func (m *merger) mergeDevice(src *Device) {
+	const entity = "device"
+
+	d, exists := m.t.Nodes[src.Name]
+	if !exists {
+		d = m.t.AddDeviceIfNotExists(src.Name)
+	}
+
+	key := d.Name
+	p := recordTo(&d.Provenance, m.connector, src.SourceID)
+	if !exists {
+		p.set("name")
+	}
+
+	m.mergeString(entity, key, "status", p, &d.Status, src.Status)
+	m.mergeString(entity, key, "role", p, &d.Role, src.Role)
+	m.mergeString(entity, key, "platform", p, &d.Platform, src.Platform)
+	m.mergeString(entity, key, "device_type", p, &d.DeviceType, src.DeviceType)
+
+	if src.Site != nil {
+		existing := ""
+		if d.Site != nil {
+			existing = d.Site.Name
+		}
+
+		if m.resolve(entity, key, "site", "site", p, existing, src.Site.Name) {
+			d.Site = m.t.AddSiteIfNotExists(src.Site.Name)
+			p.set("site")
+		}
+	}
+
+	if src.Colo != nil {
+		existing := ""
+		if d.Colo != nil {
+			existing = strconv.Itoa(int(d.Colo.Id))
+		}
+
+		if m.resolve(entity, key, "colo", "colo", p, existing, strconv.Itoa(int(src.Colo.Id))) {
+			d.Colo = m.t.GetColo(src.Colo.Id)
+			p.set("colo")
+		}
+	}
+
+	d.MetaData = m.mergeMetaData(entity, key, d.MetaData, src.MetaData, p, "meta_data.")
+
+	for _, srcIfa := range src.Interfaces {
+		m.mergeInterface(d, srcIfa)
+	}
+
+	for name, fp := range src.FrontPorts {
+		m.mergeFrontPort(d, p, name, fp)
+	}
+
+	for name, rp := range src.RearPorts {
+		m.mergeRearPort(d, p, name, rp)
+	}
+}
+
+// mergeFrontPort merges the front port of a device, its provenance is recorded on the device
+func (m *merger) mergeFrontPort(d *Device, p provenanceRecorder, name string, src *FrontPort) {
+	const entity = "front_port"
+
+	fp, exists := d.FrontPorts[name]
+	if !exists {
+		fp = &FrontPort{Name: src.Name}
+		d.FrontPorts[name] = fp
+	}
+
+	key := d.Name + ":" + name
+	attr := "front_ports." + name
+	set := !exists
+	if src.RearPort != "" && m.resolve(entity, key, "rear_port", attr, p, fp.RearPort, src.RearPort) {
+		fp.RearPort = src.RearPort
+		set = true
+	}
+
+	if src.RearPortPosition != 0 {
+		existing := ""
+		if fp.RearPortPosition != 0 {
+			existing = strconv.FormatUint(uint64(fp.RearPortPosition), 10)
+		}
+
+		if m.resolve(entity, key, "rear_port_position", attr, p, existing, strconv.FormatUint(uint64(src.RearPortPosition), 10)) {
+			fp.RearPortPosition = src.RearPortPosition
+			set = true
+		}
+	}
+
+	if set {
+		p.set(attr)
+	}
+}
+
+// mergeRearPort merges the rear port of a device, its provenance is recorded on the device
+func (m *merger) mergeRearPort(d *Device, p provenanceRecorder, name string, src *RearPort) {
+	const entity = "rear_port"
+
+	rp, exists := d.RearPorts[name]
+	if !exists {
+		rp = &RearPort{Name: src.Name}
+		d.RearPorts[name] = rp
+	}
+
+	key := d.Name + ":" + name
+	attr := "rear_ports." + name
+	set := !exists
+	if src.Positions != 0 {
+		existing := ""
+		if rp.Positions != 0 {
+			existing = strconv.Itoa(int(rp.Positions))
+		}
+
+		if m.resolve(entity, key, "positions", attr, p, existing, strconv.Itoa(int(src.Positions))) {
+			rp.Positions = src.Positions
+			set = true
+		}
+	}
+
+	if set {
+		p.set(attr)
+	}
+}
+
+func (m *merger) mergeInterface(d *Device, src *Interface) {
+	const entity = "interface"
+
+	ifa, exists := d.Interfaces[src.Name]
+	if !exists {
+		ifa = d.AddInterfaceItNotExists(src.Name)
+	}
+
+	key := d.Name + ":" + ifa.Name
+	p := recordTo(&ifa.Provenance, m.connector, src.SourceID)
+	if !exists {
+		p.set("name")
+	}
+
+	m.mergeString(entity, key, "type", p, &ifa.Type, src.Type)
+	m.mergeString(entity, key, "lag_member_of", p, &ifa.LAGMemberOf, src.LAGMemberOf)
+	ifa.MetaData = m.mergeMetaData(entity, key, ifa.MetaData, src.MetaData, p, "meta_data.")
+
+	for vt, srcUnit := range src.Units {
+		m.mergeUnit(key, ifa, vt, srcUnit)
+	}
+}
+
+func (m *merger) mergeUnit(ifaKey string, ifa *Interface, vt VLANTag, src *InterfaceUnit) {
+	const entity = "unit"
+
+	_, exists := ifa.Units[vt]
+	u := ifa.AddUnitIfNotExists(vt)
+	key := fmt.Sprintf("%s[%d/%d]", ifaKey, vt.OuterTag, vt.InnerTag)
+	p := recordTo(&u.Provenance, m.connector, src.SourceID)
+
+	if src.ID != 0 {
+		// New units get an ID derived from their VLAN tag, which isn't a conflicting value
+		existing := ""
+		if exists && u.ID != 0 {
+			existing = strconv.FormatUint(uint64(u.ID), 10)
+		}
+
+		if m.resolve(entity, key, "id", "id", p, existing, strconv.FormatUint(uint64(src.ID), 10)) {
+			u.ID = src.ID
+			p.set("id")
+		}
+	}
+
+	u.MetaData = m.mergeMetaData(entity, key, u.MetaData, src.MetaData, p, "meta_data.")
+	u.IPv4Addresses = m.mergeIPs(key, u.IPv4Addresses, src.IPv4Addresses, p)
+	u.IPv6Addresses = m.mergeIPs(key, u.IPv6Addresses, src.IPv6Addresses, p)
+}
+
+// mergeIPs adds the addresses of src not present in dst yet and merges the meta data of the ones present.
+// The provenance is recorded on the unit, using the source object ID of the IP address if set.
+func (m *merger) mergeIPs(unitKey string, dst []IP, src []IP, p provenanceRecorder) []IP {
+	for _, srcIP := range src {
+		ipRecorder := p
+		if srcIP.SourceID != "" {
+			ipRecorder.source.ObjectID = srcIP.SourceID
+		}
+
+		addr := srcIP.Address.String()
+		attr := "ip_addresses." + addr
+		ipRecorder.set(attr)
+
+		i := slices.IndexFunc(dst, func(ip IP) bool {
+			return ip.Address.Equal(&srcIP.Address)
+		})
+
+		if i < 0 {
+			dst = append(dst, NewIP(srcIP.Address))
+			i = len(dst) - 1
+		}
+
+		dst[i].MetaData = m.mergeMetaData("ip", unitKey+" "+addr, dst[i].MetaData, srcIP.MetaData, ipRecorder, attr+".meta_data.")
+	}
+
+	return dst
+}
+
+func (m *merger) mergeCircuit(cid string, src *Circuit) {
+	const entity = "circuit"
+
+	c, exists := m.t.Circuits[cid]
+	if !exists {
+		c = NewCircuit(src.CID, "", "", "")
+		m.t.Circuits[cid] = c
+	}
+
+	p := recordTo(&c.Provenance, m.connector, src.SourceID)
+	if !exists {
+		p.set("cid")
+	}
+
+	m.mergeString(entity, cid, "provider", p, &c.Provider, src.Provider)
+	m.mergeString(entity, cid, "type", p, &c.Type, src.Type)
+	m.mergeString(entity, cid, "status", p, &c.Status, src.Status)
+	c.MetaData = m.mergeMetaData(entity, cid, c.MetaData, src.MetaData, p, "meta_data.")
+}
+
+// mergePrefix merges the prefix into the one added by the same connector under the same ID. Prefixes of different connectors
+// are different objects, even if they share an ID or address (e.g. in different VRFs), so they are never merged. A prefix whose
+// ID is taken by a prefix of another connector is added under nextID, which is increased.
+func (m *merger) mergePrefix(id int64, src *Prefix, nextID *int64) {
+	const entity = "prefix"
+
+	source := prefixSource{connector: m.connector, id: id}
+	mergedID, exists := m.t.prefixIDs[source]
+	if !exists {
+		mergedID = id
+		if _, taken := m.t.Prefixes[id]; taken {
+			mergedID = *nextID
+			*nextID++
+		}
+
+		m.t.prefixIDs[source] = mergedID
+		m.t.Prefixes[mergedID] = NewPrefix(src.Prefix)
+	}
+
+	p := m.t.Prefixes[mergedID]
+	key := p.Prefix.String()
+	r := recordTo(&p.Provenance, m.connector, src.SourceID)
+	if !exists {
+		r.set("prefix")
+	}
+
+	p.Tags = m.mergeTags(entity, key, "tags", p.Tags, src.Tags, r, "tags.")
+	p.MetaData = m.mergeMetaData(entity, key, p.MetaData, src.MetaData, r, "meta_data.")
+}
+
+// mergeCables adds the given cables. Each conflicts with the cables of t connected to either of its ends, which are
+// replaced if the policy allows it for all of them. Otherwise the existing cables are kept and the cable is dropped.
+// Only cables present before the merge are considered, so conflicting cables within the same topology are all kept.
+func (m *merger) mergeCables(cables map[string]*Cable) {
+	const entity = "cable"
+
+	existingByEnd := make(map[CableEnd][]string, len(m.t.Cables)*2)
+	for key, c := range m.t.Cables {
+		existingByEnd[c.AEnd] = append(existingByEnd[c.AEnd], key)
+		existingByEnd[c.BEnd] = append(existingByEnd[c.BEnd], key)
+	}
+
+	// Stable order, so the conflicts recorded don't depend on the map order
+	keys := make([]string, 0, len(cables))
+	for key := range cables {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		c := cables[key]
+		existingKeys := append(slices.Clone(existingByEnd[c.AEnd]), existingByEnd[c.BEnd]...)
+		slices.Sort(existingKeys)
+		existingKeys = slices.Compact(existingKeys)
+
+		replace := true
+		for _, existingKey := range existingKeys {
+			existing, present := m.t.Cables[existingKey]
+			if !present {
+				continue
+			}
+
+			ep := recordTo(&existing.Provenance, m.connector, c.SourceID)
+			if !m.resolve(entity, existingKey, "ends", "a_end", ep, existingKey, key) {
+				replace = false
+			}
+		}
+
+		if !replace {
+			continue
+		}
+
+		for _, existingKey := range existingKeys {
+			delete(m.t.Cables, existingKey)
+		}
+
+		merged := &Cable{
+			AEnd: c.AEnd,
+			BEnd: c.BEnd,
+		}
+
+		p := recordTo(&merged.Provenance, m.connector, c.SourceID)
+		p.set("a_end")
+		p.set("b_end")
+		m.t.Cables[key] = merged
+	}
+}
+
+// mergeMetaData merges the tags, semantic tags and custom field data of src into dst.
+// It returns dst, which is created if nil. The provenance is recorded with the given attribute prefix.
+func (m *merger) mergeMetaData(entity string, key string, dst *MetaData, src *MetaData, p provenanceRecorder, prefix string) *MetaData {
+	if dst == nil {
+		dst = NewMetaData()
+	}
+
+	if src == nil {
+		return dst
+	}
+
+	dst.Tags = m.mergeTags(entity, key, "meta_data.tags", dst.Tags, src.Tags, p, prefix+"tags.")
+	if dst.SemanticTags == nil && len(src.SemanticTags) > 0 {
+		dst.SemanticTags = make(map[string]string, len(src.SemanticTags))
+	}
+
+	for k, v := range src.SemanticTags {
+		attr := prefix + "semantic_tags." + k
+		if m.resolve(entity, key, "meta_data.semantic_tags", attr, p, dst.SemanticTags[k], v) {
+			dst.SemanticTags[k] = v
+			p.set(attr)
+		}
+	}
+
+	if src.CustomFieldData != "" {
+		attr := prefix + "custom_field_data"
+		if m.resolve(entity, key, "meta_data.custom_field_data", attr, p, dst.CustomFieldData, src.CustomFieldData) {
+			dst.CustomFieldData = src.CustomFieldData
+			p.set(attr)
+		}
+	}
+
+	return dst
+}
+
+// mergeTags adds the tags not present in dst yet if the policy is MergeUnion, otherwise the tags are resolved as a whole.
+// The source of the first existing tag is taken as the source of the existing tags.
+func (m *merger) mergeTags(entity string, key string, field string, dst []string, src []string, p provenanceRecorder, prefix string) []string {
+	if len(src) == 0 {
+		return dst
+	}
+
+	if m.policies.strategy(entity, field) == MergeUnion {
+		for _, tag := range src {
+			if !slices.Contains(dst, tag) {
+				dst = append(dst, tag)
+			}
+
+			p.set(prefix + tag)
+		}
+
+		return dst
+	}
+
+	existingAttr := ""
+	if len(dst) > 0 {
+		existingAttr = prefix + dst[0]
+	}
+
+	if !m.resolve(entity, key, field, existingAttr, p, tagsValue(dst), tagsValue(src)) {
+		return dst
+	}
+
+	for _, tag := range dst {
+		delete(p.provenance, prefix+tag)
+	}
+
+	for _, tag := range src {
+		p.set(prefix + tag)
+	}
+
+	return slices.Clone(src)
+}
+
+func tagsValue(tags []string) string {
+	sorted := slices.Clone(tags)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,220 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// MergeStrategy defines which value is used if connectors set an attribute to different values
+type MergeStrategy uint8
+
+const (
+	// The value of the later connector is used
+	MergeLastWriterWins MergeStrategy = iota
+	// The value of the earlier connector is kept
+	MergeFirstWriterWins
+	// The value of the connector listed first in the priority is used, later connectors win ties
+	MergePriority
+	// Tags of all connectors are combined, for other attributes the later connector wins
+	MergeUnion
+	// Conflicting values fail the topology build
+	MergeErrorOnConflict
+)
+
+var mergeStrategyNames = map[MergeStrategy]string{
+	MergeLastWriterWins:  "last-writer-wins",
+	MergeFirstWriterWins: "first-writer-wins",
+	MergePriority:        "priority",
+	MergeUnion:           "union",
+	MergeErrorOnConflict: "error-on-conflict",
+}
+
+func (s MergeStrategy) String() string {
+	return mergeStrategyNames[s]
+}
+
+// MergeStrategyFromString parses the strategy names returned by String
+func MergeStrategyFromString(s string) (MergeStrategy, error) {
+	for strategy, name := range mergeStrategyNames {
+		if name == s {
+			return strategy, nil
+		}
+	}
+
+	return MergeLastWriterWins, fmt.Errorf("unknown merge strategy %q", s)
+}
+
+// MergeResolution tells how a conflict has been resolved
+type MergeResolution uint8
+
+const (
+	ResolutionReplaced MergeResolution = iota
+	ResolutionKept
+	ResolutionError
+)
+
+var mergeResolutionNames = map[MergeResolution]string{
+	ResolutionReplaced: "replaced",
+	ResolutionKept:     "kept",
+	ResolutionError:    "error",
+}
+
+func (r MergeResolution) String() string {
+	return mergeResolutionNames[r]
+}
+
+// MergePolicies configure the merge strategy by entity ("colo", "device", "interface", "unit", "ip", "front_port", "rear_port", "cable", "circuit") and field.
+// The strategy of a field is looked up as "<entity>.<field>" (e.g. "device.role"), then "<entity>", then Default.
+// Tags ("meta_data.tags", "tags") and semantic tags ("meta_data.semantic_tags") are combined (union) unless configured as "<entity>.<field>".
+type MergePolicies struct {
+	Default MergeStrategy
+	Fields  map[string]MergeStrategy
+	// Connector names, highest priority first, used by MergePriority. Connectors not listed have the lowest priority.
+	Priority []string
+}
+
+// DefaultMergePolicies let later connectors override the attributes of earlier ones and combine tags
+func DefaultMergePolicies() *MergePolicies {
+	return &MergePolicies{
+		Default: MergeLastWriterWins,
+		Fields:  make(map[string]MergeStrategy),
+	}
+}
+
+func (mp *MergePolicies) strategy(entity string, field string) MergeStrategy {
+	strategy, exists := mp.Fields[entity+"."+field]
+	if exists {
+		return strategy
+	}
+
+	switch field {
+	case "tags", "meta_data.tags", "meta_data.semantic_tags":
+		return MergeUnion
+	}
+
+	strategy, exists = mp.Fields[entity]
+	if exists {
+		return strategy
+	}
+
+	return mp.Default
+}
+
+// replaces decides if the value of connector incoming replaces the one of connector existing
+func (mp *MergePolicies) replaces(strategy MergeStrategy, incoming string, existing string) bool {
+	switch strategy {
+	case MergeFirstWriterWins, MergeErrorOnConflict:
+		return false
+	case MergePriority:
+		return mp.rank(incoming) <= mp.rank(existing)
+	}
+
+	return true
+}
+
+func (mp *MergePolicies) rank(connector string) int {
+	i := slices.Index(mp.Priority, connector)
+	if i < 0 {
+		return len(mp.Priority)
+	}
+
+	return i
+}
+
+// MergeConflict is an attribute two connectors set to different values
+type MergeConflict struct {
+	Entity string
+	// Identifies the object, e.g. "ccr01.dus01:et-0/0/0" for an interface
+	Key        string
+	Field      string
+	Strategy   MergeStrategy
+	Resolution MergeResolution
+
+	Existing      Source
+	ExistingValue string
+	Incoming      Source
+	IncomingValue string
+}
+
+func (mc *MergeConflict) String() string {
+	return fmt.Sprintf("%s %s %s: %q (%s) vs. %q (%s), %s (%s)", mc.Entity, mc.Key, mc.Field,
+		mc.ExistingValue, mc.Existing.Connector, mc.IncomingValue, mc.Incoming.Connector, mc.Resolution, mc.Strategy)
+}
+
+func (mc *MergeConflict) ToProto() *octopuspb.MergeConflict {
+	return &octopuspb.MergeConflict{
+		Entity:     mc.Entity,
+		Key:        mc.Key,
+		Field:      mc.Field,
+		Strategy:   octopuspb.MergeStrategy(mc.Strategy),
+		Resolution: octopuspb.MergeResolution(mc.Resolution),
+		Existing: &octopuspb.Source{
+			Connector: mc.Existing.Connector,
+			ObjectId:  mc.Existing.ObjectID,
+		},
+		ExistingValue: mc.ExistingValue,
+		Incoming: &octopuspb.Source{
+			Connector: mc.Incoming.Connector,
+			ObjectId:  mc.Incoming.ObjectID,
+		},
+		IncomingValue: mc.IncomingValue,
+	}
+}
+
+func mergeConflictFromProto(pmc *octopuspb.MergeConflict) *MergeConflict {
+	mc := &MergeConflict{
+		Entity:        pmc.Entity,
+		Key:           pmc.Key,
+		Field:         pmc.Field,
+		Strategy:      MergeStrategy(pmc.Strategy),
+		Resolution:    MergeResolution(pmc.Resolution),
+		ExistingValue: pmc.ExistingValue,
+		IncomingValue: pmc.IncomingValue,
+	}
+
+	if pmc.Existing != nil {
+		mc.Existing = Source{Connector: pmc.Existing.Connector, ObjectID: pmc.Existing.ObjectId}
+	}
+
+	if pmc.Incoming != nil {
+		mc.Incoming = Source{Connector: pmc.Incoming.Connector, ObjectID: pmc.Incoming.ObjectId}
+	}
+
+	return mc
+}
+
+// BlockingConflicts returns the conflicts of fields configured as MergeErrorOnConflict
+func (t *Topology) BlockingConflicts() []*MergeConflict {
+	ret := make([]*MergeConflict, 0)
+	for _, mc := range t.MergeConflicts {
+		if mc.Resolution == ResolutionError {
+			ret = append(ret, mc)
+		}
+	}
+
+	return ret
+}
+
+func sortMergeConflicts(conflicts []*MergeConflict) {
+	slices.SortStableFunc(conflicts, func(a, b *MergeConflict) int {
+		if c := strings.Compare(a.Entity, b.Entity); c != 0 {
+			return c
+		}
+
+		if c := strings.Compare(a.Key, b.Key); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Field, b.Field)
+	})
+}
@@ -0,0 +1,363 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"testing"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	// What the NetBox connector would contribute
+	base := newTestTopologyWithPaths()
+	ccr := base.GetDevice("ccr01.dus01")
+	ccr.Role = "ccr"
+	ccr.Status = "active"
+	ccr.Site = base.AddSiteIfNotExists("DUS01")
+	ccr.Colo = base.AddColoIfNotExists(1, "DUS", "dus01")
+	ccr.MetaData.Tags = []string{"netbox"}
+	ccr.MetaData.SemanticTags["NET:ASN"] = "13335"
+	ccr.SourceID = "dcim.device:1"
+	base.Interfaces[42] = ccr.GetInterface("et-0/0/1")
+	base.DevicesByInterfaceID[42] = ccr
+	base.Prefixes[23] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24))
+
+	// What the file connector would contribute
+	overlay := NewTopology()
+	ccrOverlay := overlay.AddDeviceIfNotExists("ccr01.dus01")
+	ccrOverlay.Status = "planned"
+	ccrOverlay.SourceID = "lab.yaml"
+	ccrOverlay.MetaData.Tags = []string{"lab"}
+	ccrOverlay.MetaData.SemanticTags["NET:ASN"] = "209242"
+	ccrOverlay.AddInterfaceItNotExists("et-0/0/1").AddIPAddressIfNotExists(NewVLANTag(0, 0), NewIP(bnet.NewPfx(bnet.IPv4FromOctets(198, 51, 100, 0), 31)))
+	overlay.AddDeviceIfNotExists("edge02.dus01").AddInterfaceItNotExists("et-0/0/0")
+	addCable(overlay, interfaceEnd("ccr01.dus01", "et-0/0/1"), interfaceEnd("edge02.dus01", "et-0/0/0"))
+	overlay.Prefixes[-1] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(198, 51, 100, 0), 24))
+
+	merged := NewTopology()
+	merged.Merge(base, "NetBox", nil)
+	merged.Merge(overlay, "File", nil)
+
+	// Set attributes of the overlay take precedence
+	mccr := merged.GetDevice("ccr01.dus01")
+	assert.Equal(t, "planned", mccr.Status)
+	assert.Equal(t, "ccr", mccr.Role)
+	assert.Equal(t, merged.Sites["DUS01"], mccr.Site)
+	assert.Equal(t, merged.Colos[1], mccr.Colo)
+	assert.Equal(t, []string{"netbox", "lab"}, mccr.MetaData.Tags)
+	assert.Equal(t, "209242", mccr.MetaData.SemanticTags["NET:ASN"])
+	assert.Equal(t, 1, len(mccr.GetInterface("et-0/0/1").Units[NewVLANTag(0, 0)].IPv4Addresses))
+
+	// The sources of the attributes are recorded
+	assert.Equal(t, Source{Connector: "NetBox", ObjectID: "dcim.device:1"}, mccr.Provenance["name"])
+	assert.Equal(t, Source{Connector: "NetBox", ObjectID: "dcim.device:1"}, mccr.Provenance["role"])
+	assert.Equal(t, Source{Connector: "File", ObjectID: "lab.yaml"}, mccr.Provenance["status"])
+	assert.Equal(t, Source{Connector: "NetBox", ObjectID: "dcim.device:1"}, mccr.Provenance["meta_data.tags.netbox"])
+	assert.Equal(t, Source{Connector: "File", ObjectID: "lab.yaml"}, mccr.Provenance["meta_data.semantic_tags.NET:ASN"])
+	assert.Equal(t, "File", mccr.GetInterface("et-0/0/1").Units[NewVLANTag(0, 0)].Provenance["ip_addresses.198.51.100.0/31"].Connector)
+
+	pd := mccr.ToProtoWithProvenance()
+	assert.Equal(t, "dcim.device:1", pd.Provenance["role"].ObjectId)
+	assert.Nil(t, mccr.ToProto().Provenance)
+
+	// ID maps point to the merged objects
+	assert.Same(t, mccr.GetInterface("et-0/0/1"), merged.Interfaces[42])
+	assert.Same(t, mccr, merged.DevicesByInterfaceID[42])
+	assert.Equal(t, 2, len(merged.Prefixes))
+
+	// The cable of the overlay replaces the one to edge01
+	assert.Equal(t, len(base.Cables), len(merged.Cables))
+	for _, c := range merged.Cables {
+		assert.NotEqual(t, "edge01.dus01", c.BEnd.DeviceName)
+	}
+
+	// Differing values are recorded as conflicts, tags are combined without conflict
+	assert.Contains(t, merged.MergeConflicts, &MergeConflict{
+		Entity:        "device",
+		Key:           "ccr01.dus01",
+		Field:         "status",
+		Strategy:      MergeLastWriterWins,
+		Resolution:    ResolutionReplaced,
+		Existing:      Source{Connector: "NetBox", ObjectID: "dcim.device:1"},
+		ExistingValue: "active",
+		Incoming:      Source{Connector: "File", ObjectID: "lab.yaml"},
+		IncomingValue: "planned",
+	})
+	for _, mc := range merged.MergeConflicts {
+		assert.NotEqual(t, "meta_data.tags", mc.Field)
+	}
+	assert.Empty(t, merged.BlockingConflicts())
+
+	// The merged topology does not share objects with its sources
+	ccrOverlay.Status = "offline"
+	assert.Equal(t, "planned", mccr.Status)
+	assert.NotSame(t, ccr, mccr)
+}
+
+func TestMergePolicies(t *testing.T) {
+	tests := []struct {
+		name               string
+		policies           *MergePolicies
+		expectedRole       string
+		expectedTags       []string
+		expectedResolution MergeResolution
+		expectedStrategy   MergeStrategy
+	}{
+		{
+			name:               "Default",
+			policies:           nil,
+			expectedRole:       "lab",
+			expectedTags:       []string{"netbox", "lab"},
+			expectedResolution: ResolutionReplaced,
+			expectedStrategy:   MergeLastWriterWins,
+		},
+		{
+			name: "First writer wins by field",
+			policies: &MergePolicies{
+				Fields: map[string]MergeStrategy{
+					"device.role":           MergeFirstWriterWins,
+					"device.meta_data.tags": MergeLastWriterWins,
+				},
+			},
+			expectedRole:       "ccr",
+			expectedTags:       []string{"lab"},
+			expectedResolution: ResolutionKept,
+			expectedStrategy:   MergeFirstWriterWins,
+		},
+		{
+			name: "Priority by entity",
+			policies: &MergePolicies{
+				Fields: map[string]MergeStrategy{
+					"device": MergePriority,
+				},
+				Priority: []string{"NetBox", "File"},
+			},
+			expectedRole:       "ccr",
+			expectedTags:       []string{"netbox", "lab"},
+			expectedResolution: ResolutionKept,
+			expectedStrategy:   MergePriority,
+		},
+		{
+			name: "Error on conflict",
+			policies: &MergePolicies{
+				Default: MergeErrorOnConflict,
+			},
+			expectedRole:       "ccr",
+			expectedTags:       []string{"netbox", "lab"},
+			expectedResolution: ResolutionError,
+			expectedStrategy:   MergeErrorOnConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			first := NewTopology()
+			d := first.AddDeviceIfNotExists("ccr01.dus01")
+			d.Role = "ccr"
+			d.Platform = "junos"
+			d.MetaData.Tags = []string{"netbox"}
+
+			second := NewTopology()
+			d = second.AddDeviceIfNotExists("ccr01.dus01")
+			d.Role = "lab"
+			d.Platform = "junos"
+			d.MetaData.Tags = []string{"lab"}
+
+			merged := NewTopology()
+			merged.Merge(first, "NetBox", test.policies)
+			merged.Merge(second, "File", test.policies)
+
+			md := merged.GetDevice("ccr01.dus01")
+			assert.Equal(t, test.expectedRole, md.Role)
+			assert.Equal(t, test.expectedTags, md.MetaData.Tags)
+
+			// Equal values don't conflict
+			roleConflicts := 0
+			for _, mc := range merged.MergeConflicts {
+				assert.NotEqual(t, "platform", mc.Field)
+				if mc.Field == "role" {
+					roleConflicts++
+					assert.Equal(t, test.expectedResolution, mc.Resolution)
+					assert.Equal(t, test.expectedStrategy, mc.Strategy)
+					assert.Equal(t, "ccr", mc.ExistingValue)
+					assert.Equal(t, "lab", mc.IncomingValue)
+				}
+			}
+
+			assert.Equal(t, 1, roleConflicts)
+			assert.Equal(t, test.expectedResolution == ResolutionError, len(merged.BlockingConflicts()) > 0)
+		})
+	}
+}
+
+func TestMergeConflicts(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *Topology, variant int)
+		policies *MergePolicies
+		expected *MergeConflict
+		check    func(t *testing.T, merged *Topology)
+	}{
+		{
+			name: "colo",
+			setup: func(t *Topology, variant int) {
+				c := t.AddColoIfNotExists(1, []string{"DUS", "DUS2"}[variant], "dus01")
+				c.Tier = 1
+				c.IsMCP = variant == 0
+			},
+			expected: &MergeConflict{
+				Entity:        "colo",
+				Key:           "1",
+				Field:         "name",
+				Strategy:      MergeLastWriterWins,
+				Resolution:    ResolutionReplaced,
+				Existing:      Source{Connector: "NetBox"},
+				ExistingValue: "DUS",
+				Incoming:      Source{Connector: "File"},
+				IncomingValue: "DUS2",
+			},
+			check: func(t *testing.T, merged *Topology) {
+				c := merged.Colos[1]
+				assert.Equal(t, "DUS2", c.Name)
+				assert.True(t, c.IsMCP)
+				assert.Equal(t, Source{Connector: "File"}, c.Provenance["name"])
+				assert.Equal(t, Source{Connector: "NetBox"}, c.Provenance["is_mcp"])
+				assert.Equal(t, Source{Connector: "File"}, c.Provenance["tier"])
+				assert.Equal(t, "File", c.ToProtoWithProvenance().Provenance["name"].Connector)
+			},
+		},
+		{
+			name: "colo kept",
+			setup: func(t *Topology, variant int) {
+				t.AddColoIfNotExists(1, "DUS", "dus01").Region = []string{"WEUR", "EEUR"}[variant]
+			},
+			policies: &MergePolicies{Fields: map[string]MergeStrategy{"colo": MergeFirstWriterWins}},
+			expected: &MergeConflict{
+				Entity:        "colo",
+				Key:           "1",
+				Field:         "region",
+				Strategy:      MergeFirstWriterWins,
+				Resolution:    ResolutionKept,
+				Existing:      Source{Connector: "NetBox"},
+				ExistingValue: "WEUR",
+				Incoming:      Source{Connector: "File"},
+				IncomingValue: "EEUR",
+			},
+			check: func(t *testing.T, merged *Topology) {
+				assert.Equal(t, "WEUR", merged.Colos[1].Region)
+			},
+		},
+		{
+			name: "front port",
+			setup: func(t *Topology, variant int) {
+				d := t.AddDeviceIfNotExists("pp01.dus01")
+				d.FrontPorts["1"] = &FrontPort{Name: "1", RearPort: "A", RearPortPosition: uint32(variant + 1)}
+			},
+			expected: &MergeConflict{
+				Entity:        "front_port",
+				Key:           "pp01.dus01:1",
+				Field:         "rear_port_position",
+				Strategy:      MergeLastWriterWins,
+				Resolution:    ResolutionReplaced,
+				Existing:      Source{Connector: "NetBox"},
+				ExistingValue: "1",
+				Incoming:      Source{Connector: "File"},
+				IncomingValue: "2",
+			},
+			check: func(t *testing.T, merged *Topology) {
+				d := merged.GetDevice("pp01.dus01")
+				assert.Equal(t, uint32(2), d.FrontPorts["1"].RearPortPosition)
+				assert.Equal(t, "File", d.Provenance["front_ports.1"].Connector)
+			},
+		},
+		{
+			name: "rear port kept",
+			setup: func(t *Topology, variant int) {
+				d := t.AddDeviceIfNotExists("pp01.dus01")
+				d.RearPorts["A"] = &RearPort{Name: "A", Positions: int16(12 * (variant + 1))}
+			},
+			policies: &MergePolicies{Fields: map[string]MergeStrategy{"rear_port.positions": MergeFirstWriterWins}},
+			expected: &MergeConflict{
+				Entity:        "rear_port",
+				Key:           "pp01.dus01:A",
+				Field:         "positions",
+				Strategy:      MergeFirstWriterWins,
+				Resolution:    ResolutionKept,
+				Existing:      Source{Connector: "NetBox"},
+				ExistingValue: "12",
+				Incoming:      Source{Connector: "File"},
+				IncomingValue: "24",
+			},
+			check: func(t *testing.T, merged *Topology) {
+				d := merged.GetDevice("pp01.dus01")
+				assert.Equal(t, int16(12), d.RearPorts["A"].Positions)
+				assert.Equal(t, "NetBox", d.Provenance["rear_ports.A"].Connector)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			first := NewTopology()
+			test.setup(first, 0)
+			second := NewTopology()
+			test.setup(second, 1)
+
+			merged := NewTopology()
+			merged.Merge(first, "NetBox", test.policies)
+			merged.Merge(second, "File", test.policies)
+
+			assert.Equal(t, []*MergeConflict{test.expected}, merged.MergeConflicts)
+			test.check(t, merged)
+		})
+	}
+}
+
+func TestMergePrefixes(t *testing.T) {
+	netbox := NewTopology()
+	netbox.Prefixes[1] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24))
+	netbox.Prefixes[1].SourceID = "ipam.prefix:1"
+	netbox.Prefixes[2] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(198, 51, 100, 0), 24))
+	netbox.Prefixes[2].SourceID = "ipam.prefix:2"
+
+	// Unrelated prefixes sharing the IDs, and the same address in another VRF
+	other := NewTopology()
+	other.Prefixes[1] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(203, 0, 113, 0), 24))
+	other.Prefixes[2] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(198, 51, 100, 0), 24))
+	other.Prefixes[2].Tags = []string{"vrf-mgmt"}
+	other.Prefixes[3] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(10, 0, 0, 0), 8))
+
+	merged := NewTopology()
+	merged.Merge(netbox, "NetBox", nil)
+	merged.Merge(other, "Other", nil)
+
+	assert.Empty(t, merged.MergeConflicts)
+	assert.Len(t, merged.Prefixes, 5)
+
+	// Prefixes of the first connector keep their IDs, the ones of the other connector get new IDs if theirs are taken
+	assert.Equal(t, "192.0.2.0/24", merged.Prefixes[1].Prefix.String())
+	assert.Equal(t, "198.51.100.0/24", merged.Prefixes[2].Prefix.String())
+	assert.Empty(t, merged.Prefixes[2].Tags)
+	assert.Equal(t, "10.0.0.0/8", merged.Prefixes[3].Prefix.String())
+	assert.Equal(t, "203.0.113.0/24", merged.Prefixes[4].Prefix.String())
+	assert.Equal(t, "198.51.100.0/24", merged.Prefixes[5].Prefix.String())
+	assert.Equal(t, []string{"vrf-mgmt"}, merged.Prefixes[5].Tags)
+	assert.Equal(t, Source{Connector: "Other"}, merged.Prefixes[5].Provenance["prefix"])
+
+	// A prefix is merged with the one the same connector added under the same ID
+	update := NewTopology()
+	update.Prefixes[2] = NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(198, 51, 100, 0), 24))
+	update.Prefixes[2].Tags = []string{"vrf-oob"}
+	merged.Merge(update, "Other", nil)
+
+	assert.Empty(t, merged.MergeConflicts)
+	assert.Len(t, merged.Prefixes, 5)
+	assert.Equal(t, []string{"vrf-mgmt", "vrf-oob"}, merged.Prefixes[5].Tags)
+}
@@ -0,0 +1,142 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// Neighbor is the interface at the far end of a LogicalLink as seen from a local interface
+type Neighbor struct {
+	LocalInterfaceName string
+	LocalLAG           string
+	DeviceName         string
+	InterfaceName      string
+	RemoteLAG          string
+	Direct             bool
+	Cables             []string
+	Circuits           []string
+}
+
+// addAdjacency adds both ends of the given LogicalLink to the adjacency index
+func (t *Topology) addAdjacency(ll *LogicalLink) {
+	cables, circuits := t.cablesAndCircuits(ll)
+	direct := len(ll.Path) == 1
+	t.addNeighbor(ll.AEnd, ll.BEnd, direct, cables, circuits)
+	t.addNeighbor(ll.BEnd, ll.AEnd, direct, reverse(cables), reverse(circuits))
+}
+
+// cablesAndCircuits returns the cables and circuits along the path of the given LogicalLink, from its A to its B end
+func (t *Topology) cablesAndCircuits(ll *LogicalLink) ([]string, []string) {
+	cables := make([]string, 0)
+	circuits := make([]string, 0)
+	for _, s := range ll.Path {
+		switch s.Type {
+		case octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE:
+			c, _, exists := t.GetCableByEnd(s.AEnd)
+			if exists {
+				cables = append(cables, c.String())
+			}
+		case octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CIRCUIT:
+			circuits = append(circuits, s.AEnd.DeviceName)
+		}
+	}
+
+	return cables, circuits
+}
+
+func (t *Topology) addNeighbor(local CableEnd, remote CableEnd, direct bool, cables []string, circuits []string) {
+	if _, exists := t.adjacency[local.DeviceName]; !exists {
+		t.adjacency[local.DeviceName] = make(map[string]*Neighbor)
+	}
+
+	t.adjacency[local.DeviceName][local.EndpointName] = &Neighbor{
+		LocalInterfaceName: local.EndpointName,
+		LocalLAG:           t.lagOf(local),
+		DeviceName:         remote.DeviceName,
+		InterfaceName:      remote.EndpointName,
+		RemoteLAG:          t.lagOf(remote),
+		Direct:             direct,
+		Cables:             cables,
+		Circuits:           circuits,
+	}
+}
+
+func (t *Topology) lagOf(ce CableEnd) string {
+	d := t.GetDevice(ce.DeviceName)
+	if d == nil {
+		return ""
+	}
+
+	ifa := d.GetInterface(ce.EndpointName)
+	if ifa == nil {
+		return ""
+	}
+
+	return ifa.LAGMemberOf
+}
+
+// GetNeighbors returns the neighbors of the given interface.
+// If ifName is empty the neighbors of all interfaces of the device are returned, if it is a LAG the ones of all its members.
+func (t *Topology) GetNeighbors(devName string, ifName string) ([]*Neighbor, error) {
+	if ifName == "" {
+		if t.GetDevice(devName) == nil {
+			return nil, fmt.Errorf("device %s not found", devName)
+		}
+	} else {
+		err := t.DeviceAndInterfaceExists(devName, ifName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	adjacency := t.adjacency[devName]
+	if ifName != "" {
+		if n, exists := adjacency[ifName]; exists {
+			return []*Neighbor{n}, nil
+		}
+	}
+
+	res := make([]*Neighbor, 0)
+	for _, localIfName := range sortedKeys(adjacency) {
+		n := adjacency[localIfName]
+		if ifName == "" || n.LocalLAG == ifName {
+			res = append(res, n)
+		}
+	}
+
+	return res, nil
+}
+
+func (n *Neighbor) ToProto() *octopuspb.Neighbor {
+	if n == nil {
+		return nil
+	}
+
+	return &octopuspb.Neighbor{
+		LocalInterfaceName: n.LocalInterfaceName,
+		LocalLag:           n.LocalLAG,
+		DeviceName:         n.DeviceName,
+		InterfaceName:      n.InterfaceName,
+		RemoteLag:          n.RemoteLAG,
+		Direct:             n.Direct,
+		Cables:             n.Cables,
+		Circuits:           n.Circuits,
+	}
+}
+
+func reverse(s []string) []string {
+	ret := make([]string, len(s))
+	for i := range s {
+		ret[len(s)-1-i] = s[i]
+	}
+
+	return ret
+}
@@ -0,0 +1,119 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNeighbors(t *testing.T) {
+	topology := newTestTopologyWithPaths()
+	ccr := topology.GetDevice("ccr01.dus01")
+	ccr.AddInterfaceItNotExists("ae0")
+	ccr.GetInterface("et-0/0/0").LAGMemberOf = "ae0"
+	ccr.GetInterface("et-0/0/1").LAGMemberOf = "ae0"
+	topology.ComputeLogicalLinks()
+
+	toAMS := &Neighbor{
+		LocalInterfaceName: "et-0/0/0",
+		LocalLAG:           "ae0",
+		DeviceName:         "ccr01.ams01",
+		InterfaceName:      "et-0/0/0",
+		Cables: []string{
+			Cable{AEnd: interfaceEnd("ccr01.dus01", "et-0/0/0"), BEnd: frontPortEnd("pp01.dus01", "1")}.String(),
+			Cable{AEnd: rearPortEnd("pp01.dus01", "R1"), BEnd: rearPortEnd("pp02.dus01", "R1")}.String(),
+			Cable{AEnd: frontPortEnd("pp02.dus01", "1"), BEnd: circuitEnd("CID-1", "A")}.String(),
+			Cable{AEnd: interfaceEnd("ccr01.ams01", "et-0/0/0"), BEnd: circuitEnd("CID-1", "Z")}.String(),
+		},
+		Circuits: []string{"CID-1"},
+	}
+
+	toEdge := &Neighbor{
+		LocalInterfaceName: "et-0/0/1",
+		LocalLAG:           "ae0",
+		DeviceName:         "edge01.dus01",
+		InterfaceName:      "et-0/0/1",
+		Direct:             true,
+		Cables: []string{
+			Cable{AEnd: interfaceEnd("ccr01.dus01", "et-0/0/1"), BEnd: interfaceEnd("edge01.dus01", "et-0/0/1")}.String(),
+		},
+		Circuits: []string{},
+	}
+
+	tests := []struct {
+		name     string
+		devName  string
+		ifName   string
+		wantFail bool
+		expected []*Neighbor
+	}{
+		{
+			name:     "unknown device",
+			devName:  "ccr01.fra01",
+			wantFail: true,
+		},
+		{
+			name:     "unknown interface",
+			devName:  "ccr01.dus01",
+			ifName:   "et-0/0/42",
+			wantFail: true,
+		},
+		{
+			name:     "unconnected interface",
+			devName:  "ccr01.dus01",
+			ifName:   "et-0/0/2",
+			expected: []*Neighbor{},
+		},
+		{
+			name:     "single interface",
+			devName:  "ccr01.dus01",
+			ifName:   "et-0/0/1",
+			expected: []*Neighbor{toEdge},
+		},
+		{
+			name:     "LAG",
+			devName:  "ccr01.dus01",
+			ifName:   "ae0",
+			expected: []*Neighbor{toAMS, toEdge},
+		},
+		{
+			name:     "device",
+			devName:  "ccr01.dus01",
+			expected: []*Neighbor{toAMS, toEdge},
+		},
+		{
+			name:    "remote end",
+			devName: "edge01.dus01",
+			ifName:  "et-0/0/1",
+			expected: []*Neighbor{
+				{
+					LocalInterfaceName: "et-0/0/1",
+					DeviceName:         "ccr01.dus01",
+					InterfaceName:      "et-0/0/1",
+					RemoteLAG:          "ae0",
+					Direct:             true,
+					Cables:             toEdge.Cables,
+					Circuits:           []string{},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		neighbors, err := topology.GetNeighbors(test.devName, test.ifName)
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			continue
+		}
+
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, neighbors, test.name)
+	}
+}
@@ -8,6 +8,10 @@
 package model
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	bnet "github.com/bio-routing/bio-rd/net"
 	octopuspb "github.com/cloudflare/octopus/proto/octopus"
 )
@@ -16,6 +20,17 @@ type Prefix struct {
 	Prefix   bnet.Prefix
 	Tags     []string
 	MetaData *MetaData
+
+	// ID of the object in the data source of the connector which added it to its partial topology
+	SourceID string
+	// Sources of the attributes, recorded when merging the partial topologies
+	Provenance Provenance
+}
+
+// prefixSource identifies a prefix by the connector which added it and its ID in the partial topology of the connector
+type prefixSource struct {
+	connector string
+	id        int64
 }
 
 func NewPrefix(pfx bnet.Prefix) *Prefix {
@@ -31,3 +46,48 @@ func (p *Prefix) ToProto() *octopuspb.Prefix {
 		MetaData: p.MetaData.ToProto(),
 	}
 }
+
+// maxPrefixID returns the highest ID of the prefixes, 0 if there are none
+func (t *Topology) maxPrefixID() int64 {
+	maxID := int64(0)
+	for id := range t.Prefixes {
+		maxID = max(maxID, id)
+	}
+
+	return maxID
+}
+
+// prefixKeys returns a key for every prefix of the topology which identifies it across builds, as the IDs of prefixes
+// are not stable (e.g. when restored from a snapshot). Prefixes are keyed by their address and the connector and source
+// object which added them, so the same prefix added several times (e.g. in different VRFs) is told apart.
+func (t *Topology) prefixKeys() map[*Prefix]string {
+	// Sorted, so keys made unique by their ID don't depend on the map order
+	ids := make([]int64, 0, len(t.Prefixes))
+	for id := range t.Prefixes {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	keys := make(map[*Prefix]string, len(t.Prefixes))
+	taken := make(map[string]struct{}, len(t.Prefixes))
+	for _, id := range ids {
+		p := t.Prefixes[id]
+		key := p.Prefix.String()
+		source := strings.TrimSpace(p.Provenance["prefix"].Connector + " " + p.Provenance["prefix"].ObjectID)
+		if source != "" {
+			key = fmt.Sprintf("%s (%s)", key, source)
+		}
+
+		if _, exists := taken[key]; exists {
+			key = fmt.Sprintf("%s #%d", key, id)
+		}
+
+		taken[key] = struct{}{}
+		keys[p] = key
+	}
+
+	return keys
+}
@@ -0,0 +1,180 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// ConnectorPolicy defines how the topology is built while a connector is unhealthy
+type ConnectorPolicy uint8
+
+const (
+	// The topology is not rebuilt while the connector is unhealthy
+	ConnectorRequired ConnectorPolicy = iota
+	// The connector is left out while unhealthy
+	ConnectorOptionalSkip
+	// The connector contributes the last data it loaded successfully while unhealthy (if supported, left out otherwise)
+	ConnectorOptionalLastGood
+)
+
+var connectorPolicyNames = map[ConnectorPolicy]string{
+	ConnectorRequired:         "required",
+	ConnectorOptionalSkip:     "optional-skip",
+	ConnectorOptionalLastGood: "optional-last-good",
+}
+
+func (p ConnectorPolicy) String() string {
+	return connectorPolicyNames[p]
+}
+
+// ConnectorPolicyFromString parses the policy names returned by String
+func ConnectorPolicyFromString(s string) (ConnectorPolicy, error) {
+	for p, name := range connectorPolicyNames {
+		if name == s {
+			return p, nil
+		}
+	}
+
+	return ConnectorRequired, fmt.Errorf("unknown connector policy %q", s)
+}
+
+// ConnectorContribution tells which data of a connector made it into the topology
+type ConnectorContribution uint8
+
+const (
+	ContributionCurrent ConnectorContribution = iota
+	ContributionLastGood
+	ContributionSkipped
+)
+
+var connectorContributionNames = map[ConnectorContribution]string{
+	ContributionCurrent:  "current",
+	ContributionLastGood: "last-good",
+	ContributionSkipped:  "skipped",
+}
+
+func (c ConnectorContribution) String() string {
+	return connectorContributionNames[c]
+}
+
+// ConnectorProvenance describes the data of a connector the topology has been built from
+type ConnectorProvenance struct {
+	Connector    string
+	Policy       ConnectorPolicy
+	Contribution ConnectorContribution
+	LoadTime     time.Time
+}
+
+// DataAge returns how old the connector data was when the topology was built at ts
+func (cp *ConnectorProvenance) DataAge(ts time.Time) time.Duration {
+	if cp.LoadTime.IsZero() || cp.LoadTime.After(ts) {
+		return 0
+	}
+
+	return ts.Sub(cp.LoadTime)
+}
+
+func (cp *ConnectorProvenance) ToProto(ts time.Time) *octopuspb.ConnectorProvenance {
+	ret := &octopuspb.ConnectorProvenance{
+		Connector:    cp.Connector,
+		Policy:       octopuspb.ConnectorPolicy(cp.Policy),
+		Contribution: octopuspb.ConnectorContribution(cp.Contribution),
+		DataAge:      uint64(cp.DataAge(ts).Seconds()),
+	}
+
+	if !cp.LoadTime.IsZero() {
+		ret.LoadTime = uint64(cp.LoadTime.Unix())
+	}
+
+	return ret
+}
+
+func connectorProvenanceFromProto(pcp *octopuspb.ConnectorProvenance) *ConnectorProvenance {
+	cp := &ConnectorProvenance{
+		Connector:    pcp.Connector,
+		Policy:       ConnectorPolicy(pcp.Policy),
+		Contribution: ConnectorContribution(pcp.Contribution),
+	}
+
+	if pcp.LoadTime != 0 {
+		cp.LoadTime = time.Unix(int64(pcp.LoadTime), 0)
+	}
+
+	return cp
+}
+
+// Source tells where the value of an attribute comes from
+type Source struct {
+	Connector string
+	// ID of the object in the data source of the connector, e.g. "dcim.device:42" for NetBox
+	ObjectID string
+}
+
+// Provenance holds the sources of the attributes of an object, keyed by attribute name (e.g. "role", "meta_data.tags.<tag>").
+// The key of the object ("name", "cid", "prefix") refers to the connector which added it.
+type Provenance map[string]Source
+
+func (p Provenance) ToProto() map[string]*octopuspb.Source {
+	if len(p) == 0 {
+		return nil
+	}
+
+	ret := make(map[string]*octopuspb.Source, len(p))
+	for attr, s := range p {
+		ret[attr] = &octopuspb.Source{
+			Connector: s.Connector,
+			ObjectId:  s.ObjectID,
+		}
+	}
+
+	return ret
+}
+
+func provenanceFromProto(m map[string]*octopuspb.Source) Provenance {
+	if len(m) == 0 {
+		return nil
+	}
+
+	p := make(Provenance, len(m))
+	for attr, s := range m {
+		p[attr] = Source{
+			Connector: s.Connector,
+			ObjectID:  s.ObjectId,
+		}
+	}
+
+	return p
+}
+
+// provenanceRecorder records the source of the attributes set on an object while merging
+type provenanceRecorder struct {
+	provenance Provenance
+	source     Source
+}
+
+func recordTo(p *Provenance, connector string, objectID string) provenanceRecorder {
+	if *p == nil {
+		*p = make(Provenance)
+	}
+
+	return provenanceRecorder{
+		provenance: *p,
+		source: Source{
+			Connector: connector,
+			ObjectID:  objectID,
+		},
+	}
+}
+
+func (r provenanceRecorder) set(attr string) {
+	r.provenance[attr] = r.source
+}
@@ -0,0 +1,238 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// NameMatcher matches names either exactly, against a glob pattern, or against a regular expression
+type NameMatcher struct {
+	pattern   string
+	matchType octopuspb.NameMatchType
+	re        *regexp.Regexp
+}
+
+func NewNameMatcher(pattern string, matchType octopuspb.NameMatchType) (*NameMatcher, error) {
+	nm := &NameMatcher{
+		pattern:   pattern,
+		matchType: matchType,
+	}
+
+	switch matchType {
+	case octopuspb.NameMatchType_NAME_MATCH_TYPE_EXACT:
+	case octopuspb.NameMatchType_NAME_MATCH_TYPE_GLOB:
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob pattern %q: %v", pattern, err)
+		}
+	case octopuspb.NameMatchType_NAME_MATCH_TYPE_REGEX:
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %v", pattern, err)
+		}
+
+		nm.re = re
+	default:
+		return nil, fmt.Errorf("unknown match type %d", matchType)
+	}
+
+	return nm, nil
+}
+
+func (nm *NameMatcher) Match(name string) bool {
+	switch nm.matchType {
+	case octopuspb.NameMatchType_NAME_MATCH_TYPE_GLOB:
+		// The pattern has been validated already, so we can ignore the error here
+		m, _ := path.Match(nm.pattern, name)
+		return m
+	case octopuspb.NameMatchType_NAME_MATCH_TYPE_REGEX:
+		return nm.re.MatchString(name)
+	}
+
+	return nm.pattern == name
+}
+
+// MetaDataFilter matches MetaData by the given Tags and SemanticTags, each of them being a criterion of its own.
+// An empty value of a SemanticTag matches any value as long as the key exists.
+type MetaDataFilter struct {
+	Tags         []string
+	SemanticTags map[string]string
+}
+
+// matches returns whether the MetaData matches each of the criteria, Tags first
+func (f *MetaDataFilter) matches(md *MetaData) []bool {
+	if f == nil {
+		return nil
+	}
+
+	results := make([]bool, 0, len(f.Tags)+len(f.SemanticTags))
+	for _, tag := range f.Tags {
+		results = append(results, md != nil && slices.Contains(md.Tags, tag))
+	}
+
+	for k, v := range f.SemanticTags {
+		matched := false
+		if md != nil {
+			mdValue, exists := md.SemanticTags[k]
+			matched = exists && (v == "" || v == mdValue)
+		}
+
+		results = append(results, matched)
+	}
+
+	return results
+}
+
+// DeviceFilter matches devices by their attributes.
+// Within each criterion any of the given values has to match. All criteria set are combined using the Operator,
+// every tag and semantic tag of the MetaData being a criterion of its own.
+type DeviceFilter struct {
+	Operator    octopuspb.FilterOperator
+	Names       []*NameMatcher
+	Sites       []string
+	Colos       []string
+	Pops        []string
+	Roles       []string
+	Platforms   []string
+	Statuses    []string
+	DeviceTypes []string
+	MetaData    *MetaDataFilter
+}
+
+func (f *DeviceFilter) Match(d *Device) bool {
+	if f == nil {
+		return true
+	}
+
+	results := make([]bool, 0)
+	if len(f.Names) > 0 {
+		results = append(results, matchAny(f.Names, d.Name))
+	}
+
+	if len(f.Sites) > 0 {
+		results = append(results, d.Site != nil && slices.Contains(f.Sites, d.Site.Name))
+	}
+
+	if len(f.Colos) > 0 {
+		results = append(results, d.Colo != nil && slices.Contains(f.Colos, d.Colo.Name))
+	}
+
+	if len(f.Pops) > 0 {
+		results = append(results, d.Colo != nil && d.Colo.Pop != nil && slices.Contains(f.Pops, d.Colo.Pop.Name))
+	}
+
+	if len(f.Roles) > 0 {
+		results = append(results, slices.Contains(f.Roles, d.Role))
+	}
+
+	if len(f.Platforms) > 0 {
+		results = append(results, slices.Contains(f.Platforms, d.Platform))
+	}
+
+	if len(f.Statuses) > 0 {
+		results = append(results, slices.Contains(f.Statuses, d.Status))
+	}
+
+	if len(f.DeviceTypes) > 0 {
+		results = append(results, slices.Contains(f.DeviceTypes, d.DeviceType))
+	}
+
+	results = append(results, f.MetaData.matches(d.MetaData)...)
+
+	if len(results) == 0 {
+		return true
+	}
+
+	if f.Operator == octopuspb.FilterOperator_FILTER_OPERATOR_OR {
+		for _, r := range results {
+			if r {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, r := range results {
+		if !r {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FindDevices returns all devices matching the given filter, sorted by name
+func (t *Topology) FindDevices(f *DeviceFilter) []*Device {
+	res := make([]*Device, 0)
+	for _, d := range t.Nodes {
+		if f.Match(d) {
+			res = append(res, d)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res
+}
+
+// DeviceFilterFromProto converts the given protobuf filter into a DeviceFilter
+func DeviceFilterFromProto(pf *octopuspb.DeviceFilter) (*DeviceFilter, error) {
+	if pf == nil {
+		return nil, nil
+	}
+
+	f := &DeviceFilter{
+		Operator:    pf.Operator,
+		Sites:       pf.Sites,
+		Colos:       pf.Colos,
+		Pops:        pf.Pops,
+		Roles:       pf.Roles,
+		Platforms:   pf.Platforms,
+		Statuses:    pf.Statuses,
+		DeviceTypes: pf.DeviceTypes,
+		MetaData: &MetaDataFilter{
+			Tags:         pf.Tags,
+			SemanticTags: make(map[string]string),
+		},
+	}
+
+	for _, n := range pf.Names {
+		nm, err := NewNameMatcher(n.Pattern, n.MatchType)
+		if err != nil {
+			return nil, err
+		}
+
+		f.Names = append(f.Names, nm)
+	}
+
+	for _, st := range pf.SemanticTags {
+		f.MetaData.SemanticTags[st.Key] = st.Value
+	}
+
+	return f, nil
+}
+
+func matchAny(matchers []*NameMatcher, name string) bool {
+	for _, m := range matchers {
+		if m.Match(name) {
+			return true
+		}
+	}
+
+	return false
+}
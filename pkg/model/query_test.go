@@ -0,0 +1,237 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"testing"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNameMatcher(t *testing.T) {
+	tests := []struct {
+		name      string
+		pattern   string
+		matchType octopuspb.NameMatchType
+		input     string
+		wantFail  bool
+		expected  bool
+	}{
+		{
+			name:      "exact match",
+			pattern:   "ccr01.dus01",
+			matchType: octopuspb.NameMatchType_NAME_MATCH_TYPE_EXACT,
+			input:     "ccr01.dus01",
+			expected:  true,
+		},
+		{
+			name:      "exact mismatch",
+			pattern:   "ccr01.dus01",
+			matchType: octopuspb.NameMatchType_NAME_MATCH_TYPE_EXACT,
+			input:     "ccr01.dus011",
+			expected:  false,
+		},
+		{
+			name:      "glob match",
+			pattern:   "ccr0?.dus*",
+			matchType: octopuspb.NameMatchType_NAME_MATCH_TYPE_GLOB,
+			input:     "ccr01.dus01",
+			expected:  true,
+		},
+		{
+			name:      "glob mismatch",
+			pattern:   "edge*",
+			matchType: octopuspb.NameMatchType_NAME_MATCH_TYPE_GLOB,
+			input:     "ccr01.dus01",
+			expected:  false,
+		},
+		{
+			name:      "invalid glob",
+			pattern:   "[",
+			matchType: octopuspb.NameMatchType_NAME_MATCH_TYPE_GLOB,
+			wantFail:  true,
+		},
+		{
+			name:      "regex match",
+			pattern:   `^ccr\d+\.dus\d+$`,
+			matchType: octopuspb.NameMatchType_NAME_MATCH_TYPE_REGEX,
+			input:     "ccr01.dus01",
+			expected:  true,
+		},
+		{
+			name:      "invalid regex",
+			pattern:   "(",
+			matchType: octopuspb.NameMatchType_NAME_MATCH_TYPE_REGEX,
+			wantFail:  true,
+		},
+	}
+
+	for _, test := range tests {
+		nm, err := NewNameMatcher(test.pattern, test.matchType)
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			continue
+		}
+
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, nm.Match(test.input), test.name)
+	}
+}
+
+func TestFindDevices(t *testing.T) {
+	topology := NewTopology()
+	colo := topology.AddColoIfNotExists(1, "dus01", "dus-a")
+
+	ccr := topology.AddDeviceIfNotExists("ccr01.dus01")
+	ccr.Role = "ccr"
+	ccr.Status = "active"
+	ccr.Colo = colo
+	ccr.Site = topology.AddSiteIfNotExists("DUS01")
+	ccr.MetaData.Tags = []string{"core"}
+	ccr.MetaData.SemanticTags["NET:ASN"] = "13335"
+
+	edge := topology.AddDeviceIfNotExists("edge01.dus01")
+	edge.Role = "edge"
+	edge.Status = "planned"
+	edge.Colo = colo
+
+	gcp := topology.AddDeviceIfNotExists("GCP")
+	gcp.Role = "cloud-provider"
+	gcp.MetaData.SemanticTags["NET:ASN"] = "16550"
+
+	tests := []struct {
+		name     string
+		filter   *octopuspb.DeviceFilter
+		expected []string
+	}{
+		{
+			name:     "no filter",
+			filter:   nil,
+			expected: []string{"GCP", "ccr01.dus01", "edge01.dus01"},
+		},
+		{
+			name: "role",
+			filter: &octopuspb.DeviceFilter{
+				Roles: []string{"ccr", "edge"},
+			},
+			expected: []string{"ccr01.dus01", "edge01.dus01"},
+		},
+		{
+			name: "pop and status",
+			filter: &octopuspb.DeviceFilter{
+				Pops:     []string{"dus-a"},
+				Statuses: []string{"planned"},
+			},
+			expected: []string{"edge01.dus01"},
+		},
+		{
+			name: "site or semantic tag key",
+			filter: &octopuspb.DeviceFilter{
+				Operator: octopuspb.FilterOperator_FILTER_OPERATOR_OR,
+				Sites:    []string{"DUS01"},
+				SemanticTags: []*octopuspb.SemanticTagFilter{
+					{
+						Key: "NET:ASN",
+					},
+				},
+			},
+			expected: []string{"GCP", "ccr01.dus01"},
+		},
+		{
+			name: "tag and semantic tag value",
+			filter: &octopuspb.DeviceFilter{
+				Tags: []string{"core"},
+				SemanticTags: []*octopuspb.SemanticTagFilter{
+					{
+						Key:   "NET:ASN",
+						Value: "16550",
+					},
+				},
+			},
+			expected: []string{},
+		},
+		{
+			name: "tag or semantic tag value",
+			filter: &octopuspb.DeviceFilter{
+				Operator: octopuspb.FilterOperator_FILTER_OPERATOR_OR,
+				Tags:     []string{"core"},
+				SemanticTags: []*octopuspb.SemanticTagFilter{
+					{
+						Key:   "NET:ASN",
+						Value: "16550",
+					},
+				},
+			},
+			expected: []string{"GCP", "ccr01.dus01"},
+		},
+		{
+			name: "one of several tags",
+			filter: &octopuspb.DeviceFilter{
+				Operator: octopuspb.FilterOperator_FILTER_OPERATOR_OR,
+				Tags:     []string{"edge", "core"},
+			},
+			expected: []string{"ccr01.dus01"},
+		},
+		{
+			name: "all of several tags",
+			filter: &octopuspb.DeviceFilter{
+				Tags: []string{"edge", "core"},
+			},
+			expected: []string{},
+		},
+		{
+			name: "name glob and colo",
+			filter: &octopuspb.DeviceFilter{
+				Names: []*octopuspb.NameFilter{
+					{
+						Pattern:   "*01.dus01",
+						MatchType: octopuspb.NameMatchType_NAME_MATCH_TYPE_GLOB,
+					},
+				},
+				Colos: []string{"dus01"},
+			},
+			expected: []string{"ccr01.dus01", "edge01.dus01"},
+		},
+	}
+
+	for _, test := range tests {
+		f, err := DeviceFilterFromProto(test.filter)
+		assert.NoError(t, err, test.name)
+
+		names := make([]string, 0)
+		for _, d := range topology.FindDevices(f) {
+			names = append(names, d.Name)
+		}
+
+		assert.Equal(t, test.expected, names, test.name)
+	}
+}
+
+func TestFindInterfaceUnitByMetaDataAndRole(t *testing.T) {
+	topology := NewTopology()
+
+	ccr := topology.AddDeviceIfNotExists("ccr01.dus01")
+	ccr.Role = "ccr"
+	u := ccr.AddInterfaceItNotExists("et-0/0/0").AddUnitIfNotExists(NewVLANTag(0, 100))
+	u.MetaData.SemanticTags["peer"] = "GCP"
+	ccr.AddInterfaceItNotExists("et-0/0/1").AddUnitIfNotExists(NewVLANTag(0, 200))
+
+	edge := topology.AddDeviceIfNotExists("edge01.dus01")
+	edge.Role = "edge"
+	edge.AddInterfaceItNotExists("et-0/0/0").AddUnitIfNotExists(NewVLANTag(0, 100)).MetaData.SemanticTags["peer"] = "GCP"
+
+	assert.Equal(t, []*InterfaceUnit{u}, topology.FindInterfaceUnitByMetaDataAndRole("peer", "GCP", "ccr"))
+	assert.Equal(t, []*InterfaceUnit{}, topology.FindInterfaceUnitByMetaDataAndRole("peer", "AWS", "ccr"))
+
+	// An empty value only matches units whose tag is empty
+	assert.Equal(t, []*InterfaceUnit{}, topology.FindInterfaceUnitByMetaDataAndRole("peer", "", "ccr"))
+	empty := ccr.AddInterfaceItNotExists("et-0/0/2").AddUnitIfNotExists(NewVLANTag(0, 300))
+	empty.MetaData.SemanticTags["peer"] = ""
+	assert.Equal(t, []*InterfaceUnit{empty}, topology.FindInterfaceUnitByMetaDataAndRole("peer", "", "ccr"))
+}
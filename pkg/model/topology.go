@@ -16,7 +16,14 @@ import (
 )
 
 type Topology struct {
-	Timestamp time.Time
+	Timestamp  time.Time
+	Generation uint64
+	// Restored from disk and not rebuilt from the connectors yet
+	Stale bool
+	// The connectors the topology has been built from, in the order they enriched it
+	Connectors []*ConnectorProvenance
+	// Attributes the connectors set to different values, sorted by entity, key and field
+	MergeConflicts []*MergeConflict
 
 	Sites                map[string]*Site
 	Pops                 map[string]*Pop
@@ -27,6 +34,19 @@ type Topology struct {
 	Cables               map[string]*Cable
 	Prefixes             map[int64]*Prefix
 	Circuits             map[string]*Circuit
+	LogicalLinks         map[string]*LogicalLink
+
+	// IDs of the merged prefixes by the connector and ID they have been added with
+	prefixIDs map[prefixSource]int64
+
+	// Cables by both of their ends for GetCableByEnd, built by IndexCables
+	cablesByEnd map[CableEnd]*Cable
+
+	// Neighbors by device and local interface name, built from the LogicalLinks
+	adjacency map[string]map[string]*Neighbor
+
+	// Prefixes and interface addresses for LookupIP and LookupPrefix, built by IndexIPs
+	ipIndex *ipIndex
 }
 
 func NewTopology() *Topology {
@@ -40,6 +60,9 @@ func NewTopology() *Topology {
 		Cables:               make(map[string]*Cable),
 		Prefixes:             make(map[int64]*Prefix),
 		Circuits:             make(map[string]*Circuit),
+		LogicalLinks:         make(map[string]*LogicalLink),
+		prefixIDs:            make(map[prefixSource]int64),
+		adjacency:            make(map[string]map[string]*Neighbor),
 	}
 }
 
@@ -97,17 +120,38 @@ func (t *Topology) GetColo(id uint16) *Colo {
 }
 
 func (t *Topology) ToProto() *octopuspb.Topology {
+	return t.toProto(false)
+}
+
+// ToProtoWithProvenance includes the provenance of the colos, devices, interfaces, units, cables, circuits and prefixes
+func (t *Topology) ToProtoWithProvenance() *octopuspb.Topology {
+	return t.toProto(true)
+}
+
+func (t *Topology) toProto(withProvenance bool) *octopuspb.Topology {
 	if t == nil {
 		return nil
 	}
 
 	protoTopology := &octopuspb.Topology{
-		Timestamp: uint64(t.Timestamp.Unix()),
-		Devices:   make([]*octopuspb.Device, 0),
+		Timestamp:  uint64(t.Timestamp.Unix()),
+		Generation: t.Generation,
+		Stale:      t.Stale,
+		Devices:    make([]*octopuspb.Device, 0),
+	}
+
+	for _, cp := range t.Connectors {
+		protoTopology.Connectors = append(protoTopology.Connectors, cp.ToProto(t.Timestamp))
+	}
+
+	if withProvenance {
+		for _, mc := range t.MergeConflicts {
+			protoTopology.MergeConflicts = append(protoTopology.MergeConflicts, mc.ToProto())
+		}
 	}
 
 	for _, dev := range t.Nodes {
-		protoTopology.Devices = append(protoTopology.Devices, dev.ToProto())
+		protoTopology.Devices = append(protoTopology.Devices, dev.toProto(withProvenance))
 	}
 
 	if len(t.Sites) > 0 {
@@ -127,27 +171,49 @@ func (t *Topology) ToProto() *octopuspb.Topology {
 	if len(t.Colos) > 0 {
 		protoTopology.Colos = make([]*octopuspb.Colo, 0)
 		for _, colo := range t.Colos {
-			protoTopology.Colos = append(protoTopology.Colos, colo.ToProto())
+			protoTopology.Colos = append(protoTopology.Colos, colo.toProto(withProvenance))
 		}
 	}
 
 	if len(t.Cables) > 0 {
 		protoTopology.Cables = make([]*octopuspb.Cable, 0)
 		for _, cable := range t.Cables {
-			protoTopology.Cables = append(protoTopology.Cables, cable.ToProto())
+			pc := cable.ToProto()
+			if withProvenance {
+				pc.Provenance = cable.Provenance.ToProto()
+			}
+
+			protoTopology.Cables = append(protoTopology.Cables, pc)
 		}
 	}
 
 	if len(t.Circuits) > 0 {
 		for _, ckt := range t.Circuits {
-			protoTopology.Circuits = append(protoTopology.Circuits, ckt.ToProto())
+			pc := ckt.ToProto()
+			if withProvenance {
+				pc.Provenance = ckt.Provenance.ToProto()
+			}
+
+			protoTopology.Circuits = append(protoTopology.Circuits, pc)
 		}
 	}
 
 	if len(t.Prefixes) > 0 {
 		protoTopology.Prefixes = make([]*octopuspb.Prefix, 0)
 		for _, prefix := range t.Prefixes {
-			protoTopology.Prefixes = append(protoTopology.Prefixes, prefix.ToProto())
+			pp := prefix.ToProto()
+			if withProvenance {
+				pp.Provenance = prefix.Provenance.ToProto()
+			}
+
+			protoTopology.Prefixes = append(protoTopology.Prefixes, pp)
+		}
+	}
+
+	if len(t.LogicalLinks) > 0 {
+		protoTopology.LogicalLinks = make([]*octopuspb.LogicalLink, 0)
+		for _, ll := range t.LogicalLinks {
+			protoTopology.LogicalLinks = append(protoTopology.LogicalLinks, ll.ToProto())
 		}
 	}
 
@@ -175,13 +241,7 @@ func sortTopology(topology *octopuspb.Topology) {
 			})
 		}
 
-		sort.Slice(d.FrontPorts, func(i, j int) bool {
-			return d.FrontPorts[i].Name < d.FrontPorts[j].Name
-		})
-
-		sort.Slice(d.RearPorts, func(i, j int) bool {
-			return d.RearPorts[i].Name < d.RearPorts[j].Name
-		})
+		sortDevicePorts(d)
 	}
 
 	sort.Slice(topology.Sites, func(i, j int) bool {
@@ -203,6 +263,18 @@ func sortTopology(topology *octopuspb.Topology) {
 	sort.Slice(topology.Cables, func(i, j int) bool {
 		return cableToString(topology.Cables[i]) < cableToString(topology.Cables[j])
 	})
+
+	sortLogicalLinks(topology.LogicalLinks)
+}
+
+func sortDevicePorts(d *octopuspb.Device) {
+	sort.Slice(d.FrontPorts, func(i, j int) bool {
+		return d.FrontPorts[i].Name < d.FrontPorts[j].Name
+	})
+
+	sort.Slice(d.RearPorts, func(i, j int) bool {
+		return d.RearPorts[i].Name < d.RearPorts[j].Name
+	})
 }
 
 func cableToString(c *octopuspb.Cable) string {
@@ -222,6 +294,8 @@ func (t *Topology) DeviceAndInterfaceExists(devName string, ifName string) error
 	return nil
 }
 
+// FindInterfaceUnitByMetaDataAndRole returns the units of the devices with the given role carrying the semantic tag key
+// with exactly the given value. Unlike MetaDataFilter, an empty value only matches units whose tag is empty.
 func (t *Topology) FindInterfaceUnitByMetaDataAndRole(key string, value string, role string) []*InterfaceUnit {
 	res := make([]*InterfaceUnit, 0)
 	for _, d := range t.Nodes {
@@ -231,12 +305,12 @@ func (t *Topology) FindInterfaceUnitByMetaDataAndRole(key string, value string,
 
 		for _, ifa := range d.Interfaces {
 			for _, u := range ifa.Units {
-				v, exists := u.MetaData.SemanticTags[key]
-				if !exists {
+				if u.MetaData == nil {
 					continue
 				}
 
-				if v == value {
+				v, exists := u.MetaData.SemanticTags[key]
+				if exists && v == value {
 					res = append(res, u)
 				}
 			}
@@ -0,0 +1,317 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"fmt"
+	"sort"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// Upper bound of segments of a path, protecting us from loops in the data
+const maxPathSegments = 128
+
+// Path describes the way from an interface through cables, patch panels, and circuits to its far end
+type Path struct {
+	Origin      CableEnd
+	Destination CableEnd
+	Complete    bool
+	Segments    []PathSegment
+}
+
+type PathSegment struct {
+	Type octopuspb.PathSegmentType
+	AEnd CableEnd
+	BEnd CableEnd
+}
+
+// LogicalLink connects two interfaces, either directly or through patch panels and circuits
+type LogicalLink struct {
+	AEnd CableEnd
+	BEnd CableEnd
+	Path []PathSegment
+}
+
+func interfaceEnd(devName string, ifName string) CableEnd {
+	return CableEnd{
+		DeviceName:   devName,
+		EndpointName: ifName,
+		EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE,
+	}
+}
+
+// IndexCables builds the index of cables by their ends used by GetCableByEnd. It is built by ComputeLogicalLinks,
+// callers modifying Cables afterwards have to call it again.
+func (t *Topology) IndexCables() {
+	t.cablesByEnd = make(map[CableEnd]*Cable, len(t.Cables)*2)
+	for _, c := range t.Cables {
+		t.cablesByEnd[c.AEnd] = c
+		t.cablesByEnd[c.BEnd] = c
+	}
+}
+
+// GetCableByEnd returns the cable connected to the given end and the far end of it.
+// Without an index (see IndexCables) all cables are searched.
+func (t *Topology) GetCableByEnd(ce CableEnd) (*Cable, CableEnd, bool) {
+	var c *Cable
+	if t.cablesByEnd != nil {
+		c = t.cablesByEnd[ce]
+	} else {
+		for _, candidate := range t.Cables {
+			if candidate.AEnd == ce || candidate.BEnd == ce {
+				c = candidate
+				break
+			}
+		}
+	}
+
+	if c == nil {
+		return nil, CableEnd{}, false
+	}
+
+	if c.AEnd == ce {
+		return c, c.BEnd, true
+	}
+
+	return c, c.AEnd, true
+}
+
+// TracePath follows the cable connected to the given interface through front/rear ports and circuits up to its far end
+func (t *Topology) TracePath(devName string, ifName string) (*Path, error) {
+	err := t.DeviceAndInterfaceExists(devName, ifName)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Path{
+		Origin:   interfaceEnd(devName, ifName),
+		Segments: make([]PathSegment, 0),
+	}
+
+	// Positions of the rear ports we passed through coming from a front port
+	positions := make([]uint32, 0)
+	visited := make(map[CableEnd]struct{})
+
+	current := p.Origin
+	for len(p.Segments) < maxPathSegments {
+		visited[current] = struct{}{}
+		p.Destination = current
+
+		_, farEnd, exists := t.GetCableByEnd(current)
+		if !exists {
+			return p, nil
+		}
+
+		if _, loop := visited[farEnd]; loop {
+			return nil, fmt.Errorf("loop detected at %s:%s", farEnd.DeviceName, farEnd.EndpointName)
+		}
+
+		p.addSegment(octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE, current, farEnd)
+		p.Destination = farEnd
+		visited[farEnd] = struct{}{}
+
+		var next *CableEnd
+		switch farEnd.EndpointType {
+		case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE:
+			p.Complete = true
+			return p, nil
+
+		case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT:
+			next, positions = t.frontToRearPort(farEnd, positions)
+
+		case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT:
+			next, positions = t.rearToFrontPort(farEnd, positions)
+
+		case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION:
+			next = t.otherCircuitTermination(farEnd)
+		}
+
+		if next == nil {
+			// We don't know how to continue from here, so the path ends at the far end of this cable
+			return p, nil
+		}
+
+		segmentType := octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_PORT_MAPPING
+		if farEnd.EndpointType == octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION {
+			segmentType = octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CIRCUIT
+		}
+
+		p.addSegment(segmentType, farEnd, *next)
+		current = *next
+	}
+
+	return nil, fmt.Errorf("path exceeds %d segments", maxPathSegments)
+}
+
+func (p *Path) addSegment(segmentType octopuspb.PathSegmentType, a CableEnd, b CableEnd) {
+	p.Segments = append(p.Segments, PathSegment{
+		Type: segmentType,
+		AEnd: a,
+		BEnd: b,
+	})
+}
+
+// frontToRearPort maps the given front port to its rear port and remembers the position on the rear port
+func (t *Topology) frontToRearPort(ce CableEnd, positions []uint32) (*CableEnd, []uint32) {
+	d := t.GetDevice(ce.DeviceName)
+	if d == nil {
+		return nil, positions
+	}
+
+	fp := d.FrontPorts[ce.EndpointName]
+	if fp == nil || fp.RearPort == "" {
+		return nil, positions
+	}
+
+	return &CableEnd{
+		DeviceName:   d.Name,
+		EndpointName: fp.RearPort,
+		EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT,
+	}, append(positions, fp.RearPortPosition)
+}
+
+// rearToFrontPort maps the given rear port to the front port of the position we entered the patch panel chain with.
+// Rear ports with a single position map to their only front port.
+func (t *Topology) rearToFrontPort(ce CableEnd, positions []uint32) (*CableEnd, []uint32) {
+	d := t.GetDevice(ce.DeviceName)
+	if d == nil {
+		return nil, positions
+	}
+
+	rp := d.RearPorts[ce.EndpointName]
+	if rp == nil {
+		return nil, positions
+	}
+
+	position := uint32(1)
+	if rp.Positions > 1 {
+		if len(positions) == 0 {
+			// We entered through a multi position rear port, so we can't tell which front port to take
+			return nil, positions
+		}
+
+		position = positions[len(positions)-1]
+		positions = positions[:len(positions)-1]
+	}
+
+	for _, fp := range d.FrontPorts {
+		if fp.RearPort == rp.Name && fp.RearPortPosition == position {
+			return &CableEnd{
+				DeviceName:   d.Name,
+				EndpointName: fp.Name,
+				EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT,
+			}, positions
+		}
+	}
+
+	return nil, positions
+}
+
+// otherCircuitTermination returns the Z termination of a circuit for its A termination and vice versa
+func (t *Topology) otherCircuitTermination(ce CableEnd) *CableEnd {
+	other := ""
+	switch ce.EndpointName {
+	case "A":
+		other = "Z"
+	case "Z":
+		other = "A"
+	default:
+		return nil
+	}
+
+	return &CableEnd{
+		DeviceName:   ce.DeviceName,
+		EndpointName: other,
+		EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION,
+	}
+}
+
+// ComputeLogicalLinks traces the paths of all interfaces and adds the ones ending on another interface as LogicalLinks.
+// Both ends of each LogicalLink are added to the adjacency index used by GetNeighbors, the cables are indexed by IndexCables.
+func (t *Topology) ComputeLogicalLinks() {
+	t.IndexCables()
+
+	for _, devName := range sortedKeys(t.Nodes) {
+		d := t.Nodes[devName]
+		for _, ifName := range sortedKeys(d.Interfaces) {
+			p, err := t.TracePath(devName, ifName)
+			if err != nil || !p.Complete {
+				continue
+			}
+
+			key := logicalLinkKey(p.Origin, p.Destination)
+			if _, exists := t.LogicalLinks[key]; exists {
+				continue
+			}
+
+			ll := &LogicalLink{
+				AEnd: p.Origin,
+				BEnd: p.Destination,
+				Path: p.Segments,
+			}
+
+			t.LogicalLinks[key] = ll
+			t.addAdjacency(ll)
+		}
+	}
+}
+
+func logicalLinkKey(a CableEnd, b CableEnd) string {
+	aStr := a.DeviceName + ":" + a.EndpointName
+	bStr := b.DeviceName + ":" + b.EndpointName
+	if bStr < aStr {
+		aStr, bStr = bStr, aStr
+	}
+
+	return aStr + "<->" + bStr
+}
+
+func (p *Path) ToProto() *octopuspb.TracePathResponse {
+	if p == nil {
+		return nil
+	}
+
+	return &octopuspb.TracePathResponse{
+		Origin:      p.Origin.toProto(),
+		Destination: p.Destination.toProto(),
+		Complete:    p.Complete,
+		Path:        pathSegmentsToProto(p.Segments),
+	}
+}
+
+func (ll *LogicalLink) ToProto() *octopuspb.LogicalLink {
+	if ll == nil {
+		return nil
+	}
+
+	return &octopuspb.LogicalLink{
+		AEnd: ll.AEnd.toProto(),
+		BEnd: ll.BEnd.toProto(),
+		Path: pathSegmentsToProto(ll.Path),
+	}
+}
+
+func pathSegmentsToProto(segments []PathSegment) []*octopuspb.PathSegment {
+	ret := make([]*octopuspb.PathSegment, 0, len(segments))
+	for _, s := range segments {
+		ret = append(ret, &octopuspb.PathSegment{
+			Type: s.Type,
+			AEnd: s.AEnd.toProto(),
+			BEnd: s.BEnd.toProto(),
+		})
+	}
+
+	return ret
+}
+
+func sortLogicalLinks(links []*octopuspb.LogicalLink) {
+	sort.Slice(links, func(i, j int) bool {
+		return cableToString(&octopuspb.Cable{AEnd: links[i].AEnd, BEnd: links[i].BEnd}) < cableToString(&octopuspb.Cable{AEnd: links[j].AEnd, BEnd: links[j].BEnd})
+	})
+}
@@ -0,0 +1,250 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package model
+
+import (
+	"testing"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+func frontPortEnd(devName string, name string) CableEnd {
+	return CableEnd{DeviceName: devName, EndpointName: name, EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT}
+}
+
+func rearPortEnd(devName string, name string) CableEnd {
+	return CableEnd{DeviceName: devName, EndpointName: name, EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT}
+}
+
+func circuitEnd(cid string, name string) CableEnd {
+	return CableEnd{DeviceName: cid, EndpointName: name, EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION}
+}
+
+func addCable(t *Topology, a CableEnd, b CableEnd) {
+	c := &Cable{AEnd: a, BEnd: b}
+	t.Cables[c.String()] = c
+}
+
+// newTestTopologyWithPaths creates the following topology:
+//
+//	ccr01.dus01:et-0/0/0 -- pp01.dus01:1 / R1 -- R1 / pp02.dus01:1 -- CID-1 (A / Z) -- ccr01.ams01:et-0/0/0
+//	ccr01.dus01:et-0/0/1 -- edge01.dus01:et-0/0/1
+//	ccr01.dus01:et-0/0/3 -- pp01.dus01:2 / R1 -- R1 / pp02.dus01:2
+func newTestTopologyWithPaths() *Topology {
+	t := NewTopology()
+
+	ccr := t.AddDeviceIfNotExists("ccr01.dus01")
+	for _, ifName := range []string{"et-0/0/0", "et-0/0/1", "et-0/0/2", "et-0/0/3"} {
+		ccr.AddInterfaceItNotExists(ifName)
+	}
+
+	t.AddDeviceIfNotExists("edge01.dus01").AddInterfaceItNotExists("et-0/0/1")
+	t.AddDeviceIfNotExists("ccr01.ams01").AddInterfaceItNotExists("et-0/0/0")
+
+	for _, ppName := range []string{"pp01.dus01", "pp02.dus01"} {
+		pp := t.AddDeviceIfNotExists(ppName)
+		pp.RearPorts["R1"] = &RearPort{Name: "R1", Positions: 2}
+		pp.FrontPorts["1"] = &FrontPort{Name: "1", RearPort: "R1", RearPortPosition: 1}
+		pp.FrontPorts["2"] = &FrontPort{Name: "2", RearPort: "R1", RearPortPosition: 2}
+	}
+
+	t.Circuits["CID-1"] = NewCircuit("CID-1", "acme", "dark-fiber", "active")
+
+	addCable(t, interfaceEnd("ccr01.dus01", "et-0/0/0"), frontPortEnd("pp01.dus01", "1"))
+	addCable(t, rearPortEnd("pp01.dus01", "R1"), rearPortEnd("pp02.dus01", "R1"))
+	addCable(t, frontPortEnd("pp02.dus01", "1"), circuitEnd("CID-1", "A"))
+	addCable(t, interfaceEnd("ccr01.ams01", "et-0/0/0"), circuitEnd("CID-1", "Z"))
+	addCable(t, interfaceEnd("ccr01.dus01", "et-0/0/1"), interfaceEnd("edge01.dus01", "et-0/0/1"))
+	addCable(t, interfaceEnd("ccr01.dus01", "et-0/0/3"), frontPortEnd("pp01.dus01", "2"))
+
+	return t
+}
+
+func TestTracePath(t *testing.T) {
+	topology := newTestTopologyWithPaths()
+
+	tests := []struct {
+		name     string
+		devName  string
+		ifName   string
+		wantFail bool
+		expected *Path
+	}{
+		{
+			name:     "unknown interface",
+			devName:  "ccr01.dus01",
+			ifName:   "et-0/0/42",
+			wantFail: true,
+		},
+		{
+			name:    "unconnected interface",
+			devName: "ccr01.dus01",
+			ifName:  "et-0/0/2",
+			expected: &Path{
+				Origin:      interfaceEnd("ccr01.dus01", "et-0/0/2"),
+				Destination: interfaceEnd("ccr01.dus01", "et-0/0/2"),
+				Segments:    []PathSegment{},
+			},
+		},
+		{
+			name:    "direct cable",
+			devName: "edge01.dus01",
+			ifName:  "et-0/0/1",
+			expected: &Path{
+				Origin:      interfaceEnd("edge01.dus01", "et-0/0/1"),
+				Destination: interfaceEnd("ccr01.dus01", "et-0/0/1"),
+				Complete:    true,
+				Segments: []PathSegment{
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE,
+						AEnd: interfaceEnd("edge01.dus01", "et-0/0/1"),
+						BEnd: interfaceEnd("ccr01.dus01", "et-0/0/1"),
+					},
+				},
+			},
+		},
+		{
+			name:    "through patch panels and circuit",
+			devName: "ccr01.dus01",
+			ifName:  "et-0/0/0",
+			expected: &Path{
+				Origin:      interfaceEnd("ccr01.dus01", "et-0/0/0"),
+				Destination: interfaceEnd("ccr01.ams01", "et-0/0/0"),
+				Complete:    true,
+				Segments: []PathSegment{
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE,
+						AEnd: interfaceEnd("ccr01.dus01", "et-0/0/0"),
+						BEnd: frontPortEnd("pp01.dus01", "1"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_PORT_MAPPING,
+						AEnd: frontPortEnd("pp01.dus01", "1"),
+						BEnd: rearPortEnd("pp01.dus01", "R1"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE,
+						AEnd: rearPortEnd("pp01.dus01", "R1"),
+						BEnd: rearPortEnd("pp02.dus01", "R1"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_PORT_MAPPING,
+						AEnd: rearPortEnd("pp02.dus01", "R1"),
+						BEnd: frontPortEnd("pp02.dus01", "1"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE,
+						AEnd: frontPortEnd("pp02.dus01", "1"),
+						BEnd: circuitEnd("CID-1", "A"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CIRCUIT,
+						AEnd: circuitEnd("CID-1", "A"),
+						BEnd: circuitEnd("CID-1", "Z"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE,
+						AEnd: circuitEnd("CID-1", "Z"),
+						BEnd: interfaceEnd("ccr01.ams01", "et-0/0/0"),
+					},
+				},
+			},
+		},
+		{
+			name:    "position 2 ending on unconnected front port",
+			devName: "ccr01.dus01",
+			ifName:  "et-0/0/3",
+			expected: &Path{
+				Origin:      interfaceEnd("ccr01.dus01", "et-0/0/3"),
+				Destination: frontPortEnd("pp02.dus01", "2"),
+				Segments: []PathSegment{
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE,
+						AEnd: interfaceEnd("ccr01.dus01", "et-0/0/3"),
+						BEnd: frontPortEnd("pp01.dus01", "2"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_PORT_MAPPING,
+						AEnd: frontPortEnd("pp01.dus01", "2"),
+						BEnd: rearPortEnd("pp01.dus01", "R1"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_CABLE,
+						AEnd: rearPortEnd("pp01.dus01", "R1"),
+						BEnd: rearPortEnd("pp02.dus01", "R1"),
+					},
+					{
+						Type: octopuspb.PathSegmentType_PATH_SEGMENT_TYPE_PORT_MAPPING,
+						AEnd: rearPortEnd("pp02.dus01", "R1"),
+						BEnd: frontPortEnd("pp02.dus01", "2"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		p, err := topology.TracePath(test.devName, test.ifName)
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			continue
+		}
+
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, p, test.name)
+	}
+}
+
+func TestComputeLogicalLinks(t *testing.T) {
+	topology := newTestTopologyWithPaths()
+	topology.ComputeLogicalLinks()
+
+	keys := make([]string, 0)
+	for key, ll := range topology.LogicalLinks {
+		keys = append(keys, key)
+		assert.Equal(t, octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE, ll.AEnd.EndpointType)
+		assert.Equal(t, octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE, ll.BEnd.EndpointType)
+	}
+
+	assert.ElementsMatch(t, []string{
+		"ccr01.ams01:et-0/0/0<->ccr01.dus01:et-0/0/0",
+		"ccr01.dus01:et-0/0/1<->edge01.dus01:et-0/0/1",
+	}, keys)
+}
+
+func TestGetCableByEnd(t *testing.T) {
+	topology := newTestTopologyWithPaths()
+	a := interfaceEnd("ccr01.dus01", "et-0/0/1")
+	b := interfaceEnd("edge01.dus01", "et-0/0/1")
+
+	// Without an index all cables are searched
+	c, farEnd, exists := topology.GetCableByEnd(a)
+	assert.True(t, exists)
+	assert.Equal(t, b, farEnd)
+
+	topology.IndexCables()
+	indexed, farEnd, exists := topology.GetCableByEnd(b)
+	assert.True(t, exists)
+	assert.Same(t, c, indexed)
+	assert.Equal(t, a, farEnd)
+
+	// Cables added later are only found once the index is rebuilt
+	added := interfaceEnd("ccr01.dus01", "et-0/0/2")
+	addCable(topology, added, interfaceEnd("edge01.dus01", "et-0/0/2"))
+	_, _, exists = topology.GetCableByEnd(added)
+	assert.False(t, exists)
+
+	topology.IndexCables()
+	_, farEnd, exists = topology.GetCableByEnd(added)
+	assert.True(t, exists)
+	assert.Equal(t, interfaceEnd("edge01.dus01", "et-0/0/2"), farEnd)
+
+	_, _, exists = topology.GetCableByEnd(interfaceEnd("ccr01.dus01", "et-0/0/9"))
+	assert.False(t, exists)
+}
@@ -0,0 +1,58 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/encoding"
+	grpcproto "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/mem"
+)
+
+// cachingCodec sends messages registered along with their marshalled bytes without marshalling them again.
+// All other messages are handled by the proto codec.
+type cachingCodec struct {
+	fallback encoding.CodecV2
+	// Marshalled bytes by message pointer
+	marshalled sync.Map
+}
+
+func newCachingCodec() *cachingCodec {
+	return &cachingCodec{
+		fallback: encoding.GetCodecV2(grpcproto.Name),
+	}
+}
+
+// register makes the codec send the given bytes for msg. The message must not be modified afterwards.
+func (c *cachingCodec) register(msg any, b []byte) {
+	c.marshalled.Store(msg, b)
+}
+
+// unregister makes the codec marshal msg again, messages still being sent are not affected
+func (c *cachingCodec) unregister(msg any) {
+	c.marshalled.Delete(msg)
+}
+
+func (c *cachingCodec) Marshal(v any) (mem.BufferSlice, error) {
+	b, found := c.marshalled.Load(v)
+	if found {
+		// SliceBuffers are never returned to a pool, so the cached bytes are not touched once sent
+		return mem.BufferSlice{mem.SliceBuffer(b.([]byte))}, nil
+	}
+
+	return c.fallback.Marshal(v)
+}
+
+func (c *cachingCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	return c.fallback.Unmarshal(data, v)
+}
+
+func (c *cachingCodec) Name() string {
+	return c.fallback.Name()
+}
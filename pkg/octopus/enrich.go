@@ -0,0 +1,73 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/connector"
+	"github.com/cloudflare/octopus/pkg/model"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// enrichPartials lets all connectors contributing as planned enrich their own partial topology concurrently.
+// The partials are returned in the order of the connectors, nil for the ones skipped.
+func (o *Octopus) enrichPartials(plan []*model.ConnectorProvenance) ([]*model.Topology, error) {
+	partials := make([]*model.Topology, len(o.connectors))
+	errs := make([]error, len(o.connectors))
+
+	wg := sync.WaitGroup{}
+	for i, c := range o.connectors {
+		switch plan[i].Contribution {
+		case model.ContributionSkipped:
+			log.Warnf("Connector %s is not healthy, leaving it out of the topology", c.GetName())
+			o.enrichDurations[i].Store(0)
+			continue
+		case model.ContributionLastGood:
+			log.Warnf("Connector %s is not healthy, enriching topology with its last good data...", c.GetName())
+		default:
+			log.Infof("Enriching topology with data from Connector %s...", c.GetName())
+		}
+
+		wg.Add(1)
+		go func(i int, c connector.Connector) {
+			defer wg.Done()
+
+			startTime := time.Now()
+			partial := model.NewTopology()
+			errs[i] = enrichTopology(c, plan[i], partial)
+			partials[i] = partial
+			o.enrichDurations[i].Store(time.Since(startTime).Milliseconds())
+		}(i, c)
+	}
+
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("Enriching topology with data from Connector %s failed: %v", o.connectors[i].GetName(), err)
+		}
+	}
+
+	return partials, nil
+}
+
+// enrichTopology adds the data of the connector to the topology as planned
+func enrichTopology(c connector.Connector, cp *model.ConnectorProvenance, topology *model.Topology) error {
+	switch cp.Contribution {
+	case model.ContributionCurrent:
+		return c.EnrichTopology(topology)
+	case model.ContributionLastGood:
+		return c.(connector.LastGoodEnricher).EnrichTopologyLastGood(topology)
+	}
+
+	return nil
+}
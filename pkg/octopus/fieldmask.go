@@ -0,0 +1,61 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// fieldMaskTree holds the paths of a field mask, split by their components
+type fieldMaskTree map[string]fieldMaskTree
+
+func newFieldMaskTree(fm *fieldmaskpb.FieldMask) fieldMaskTree {
+	tree := make(fieldMaskTree)
+	for _, p := range fm.GetPaths() {
+		node := tree
+		for _, component := range strings.Split(p, ".") {
+			if _, exists := node[component]; !exists {
+				node[component] = make(fieldMaskTree)
+			}
+
+			node = node[component]
+		}
+	}
+
+	return tree
+}
+
+// applyFieldMask clears all fields of msg which are not part of the field mask.
+// An empty field mask leaves the message untouched.
+func applyFieldMask(msg proto.Message, fm *fieldmaskpb.FieldMask) {
+	if len(fm.GetPaths()) == 0 {
+		return
+	}
+
+	newFieldMaskTree(fm).prune(msg.ProtoReflect())
+}
+
+func (tree fieldMaskTree) prune(msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		subTree, exists := tree[string(fd.Name())]
+		if !exists {
+			msg.Clear(fd)
+			return true
+		}
+
+		if len(subTree) > 0 && fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			subTree.prune(v.Message())
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// mergePolicyFile is the format of the merge policy file, e.g.
+//
+//	default: last-writer-wins
+//	priority: [NetBox, File]
+//	fields:
+//	  device.role: first-writer-wins
+//	  interface: priority
+//	  cable.ends: error-on-conflict
+type mergePolicyFile struct {
+	Default  string            `yaml:"default"`
+	Priority []string          `yaml:"priority"`
+	Fields   map[string]string `yaml:"fields"`
+}
+
+// LoadMergePolicies reads the merge policies from the given YAML file
+func LoadMergePolicies(path string) (*model.MergePolicies, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read merge policy file: %v", err)
+	}
+
+	var f mergePolicyFile
+	err = yaml.Unmarshal(data, &f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse merge policy file %s: %v", path, err)
+	}
+
+	mp := model.DefaultMergePolicies()
+	mp.Priority = f.Priority
+
+	if f.Default != "" {
+		mp.Default, err = model.MergeStrategyFromString(f.Default)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for field, name := range f.Fields {
+		strategy, err := model.MergeStrategyFromString(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid merge policy for %s: %v", field, err)
+		}
+
+		mp.Fields[field] = strategy
+	}
+
+	return mp, nil
+}
+
+// SetMergePolicies sets the policies used to resolve conflicts between connectors. It has to be called before Start.
+func (o *Octopus) SetMergePolicies(mp *model.MergePolicies) {
+	o.mergePolicies = mp
+}
+
+// GetBlockingConflicts returns the conflicts which prevented the last topology build from being published
+func (o *Octopus) GetBlockingConflicts() []*model.MergeConflict {
+	o.topologyMu.RLock()
+	defer o.topologyMu.RUnlock()
+
+	return o.blockingConflicts
+}
@@ -10,23 +10,36 @@ package octopus
 import (
 	"fmt"
 	"net"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/cloudflare/octopus/pkg/connector"
 	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/cloudflare/octopus/pkg/validation"
 	octopuspb "github.com/cloudflare/octopus/proto/octopus"
 	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
 
 	log "github.com/sirupsen/logrus"
 )
 
-const topologyRefreshTime = time.Minute
+const (
+	topologyRefreshTime = time.Minute
+
+	// Changes signaled by connectors are collected for this long before rebuilding, so a burst of edits results in a single rebuild
+	topologyRebuildDebounce = time.Second * 5
+
+	// Size of the chunks of StreamTopology, the limit leaves room below the default gRPC message size of 4 MiB
+	defaultChunkBytes  = 1 << 20
+	maxChunkBytesLimit = 3 << 20
+)
 
 type Octopus struct {
 	grpcPort uint16
@@ -35,28 +48,86 @@ type Octopus struct {
 	topology              *model.Topology
 	topologyMu            sync.RWMutex
 	topologyBuildDuration atomic.Int64
+	topologyMergeDuration atomic.Int64
 	topologyBuildTime     atomic.Int64
+	rebuildsPerformed     atomic.Uint64
+	rebuildsSkipped       atomic.Uint64
+	changeLog             []*changeSet
+	epoch                 string
+	snapshots             []*snapshot
+	historyBudget         int64
+	watchers              *watchers
+	snapshotStore         *snapshotStore
+
+	connectorPolicies map[string]model.ConnectorPolicy
+	mergePolicies     *model.MergePolicies
+
+	// Conflicts of fields configured as error-on-conflict which prevented the last build from being published
+	blockingConflicts []*model.MergeConflict
+
+	validator *validation.Validator
+	// Report of the last topology build, which has been blocked if it has fatal violations
+	validationReport *validation.Report
+
+	shrinkageLimits map[string]ShrinkageLimit
+	// Item types of the last topology build which dropped beyond their limits, and the build if it has been blocked for that
+	shrinkage []*Shrinkage
+	blocked   *blockedBuild
+
+	// Serializes topology builds of the refresh routine and force accepts
+	buildMu sync.Mutex
+
+	// Sends cached GetTopology responses without marshalling them again
+	codec *cachingCodec
+
+	// How long each connector took to enrich its partial topology during the last build, by index
+	enrichDurations []atomic.Int64
+
+	// Data generations and contributions of the connectors the current topology has been built from
+	connectorStates []connectorState
 }
 
 // NewOctopus creates a new Octopus
 func NewOctopus(grpcPort uint16) *Octopus {
 	return &Octopus{
-		grpcPort:   grpcPort,
-		connectors: make([]connector.Connector, 0),
+		grpcPort:          grpcPort,
+		connectors:        make([]connector.Connector, 0),
+		changeLog:         make([]*changeSet, 0, changeLogSize),
+		epoch:             newEpoch(),
+		connectorPolicies: make(map[string]model.ConnectorPolicy),
+		mergePolicies:     model.DefaultMergePolicies(),
+		validator:         validation.NewValidator(validation.DefaultRules()...),
+		shrinkageLimits:   make(map[string]ShrinkageLimit),
+		codec:             newCachingCodec(),
+		snapshots:         make([]*snapshot, 0),
+		historyBudget:     defaultHistoryBudget,
+		watchers:          newWatchers(),
 	}
 }
 
-// Init initializes the Ocotopus with the given list of connectors and triggers and initial load of data into the connectors
+// Init initializes the Ocotopus with the given list of connectors and triggers and initial load of data into the connectors.
+// All connectors are kept even if the initial load of some fails, so they can recover in their refresh routines.
+// Only failures of required connectors are returned.
 func (o *Octopus) Init(connectors []connector.Connector) error {
+	o.connectors = connectors
+	o.enrichDurations = make([]atomic.Int64, len(connectors))
+
+	failed := make([]string, 0)
 	for _, c := range connectors {
-		log.Infof("Doing initial load for Connector %s...", c.GetName())
+		log.Infof("Doing initial load for Connector %s (%s)...", c.GetName(), o.connectorPolicy(c))
 		err := c.InitialLoad()
 		if err != nil {
-			return fmt.Errorf("Initial load for Connector %s failed: %v\n", c.GetName(), err)
+			log.Errorf("Initial load for Connector %s failed: %v", c.GetName(), err)
+			if o.connectorPolicy(c) == model.ConnectorRequired {
+				failed = append(failed, c.GetName())
+			}
 		}
 	}
 
-	o.connectors = connectors
+	if len(failed) > 0 {
+		return fmt.Errorf("Initial load failed for Connector(s) %s", strings.Join(failed, ", "))
+	}
+
 	return nil
 }
 
@@ -71,37 +142,159 @@ func (o *Octopus) Start() {
 }
 
 // UpdateTopology triggers and instant update of the topology data from all configured connectors
+// If no connector has new data since the current topology was built, the current topology is kept.
+// Unhealthy optional connectors are left out or contribute their last good data, depending on their policy.
+// A topology shrinking beyond the configured limits compared to the current one is not published.
 func (o *Octopus) UpdateTopology() error {
+	o.buildMu.Lock()
+	defer o.buildMu.Unlock()
+
+	plan, states, err := o.planContributions()
+	if err != nil {
+		return err
+	}
+
+	if o.builtFrom(states) {
+		o.rebuildsSkipped.Add(1)
+		log.Debug("No connector has new data, skipping topology rebuild")
+		return nil
+	}
+
+	// Rebuilding would only block the same topology again, with a new timestamp the operator didn't accept
+	if o.blockedFrom(states) {
+		o.rebuildsSkipped.Add(1)
+		return fmt.Errorf("no connector has new data since the topology has been blocked by its shrinkage, not updating topology!")
+	}
+
 	// Build new Topology
 	topology := model.NewTopology()
+	topology.Connectors = plan
 
 	log.Info("Building new topology...")
 	startTime := time.Now()
 
-	for _, c := range o.connectors {
-		if !c.Healthy() {
-			return fmt.Errorf("Connector %s is not healthy, not updating topology!", c.GetName())
+	partials, err := o.enrichPartials(plan)
+	if err != nil {
+		return err
+	}
+
+	// Partials are merged in connector order, regardless of which one finished first
+	mergeStart := time.Now()
+	for i, partial := range partials {
+		if partial != nil {
+			topology.Merge(partial, o.connectors[i].GetName(), o.mergePolicies)
 		}
+	}
 
-		log.Infof("Enriching topology with data from Connector %s...", c.GetName())
-		err := c.EnrichTopology(topology)
-		if err != nil {
-			return fmt.Errorf("Enriching topology with data from Connector %s failed: %v", c.GetName(), err)
+	o.topologyMergeDuration.Store(time.Since(mergeStart).Milliseconds())
+
+	blocking := topology.BlockingConflicts()
+	o.topologyMu.Lock()
+	o.blockingConflicts = blocking
+	o.topologyMu.Unlock()
+
+	if len(blocking) > 0 {
+		for _, mc := range blocking {
+			log.Errorf("Merge conflict: %s", mc)
 		}
+
+		return fmt.Errorf("%d blocking merge conflict(s), not updating topology!", len(blocking))
 	}
 
+	topology.ComputeLogicalLinks()
+	topology.IndexIPs()
+
 	// We got ourselves a new topology, add the time when we built it and store it
 	topology.Timestamp = time.Now()
 	o.topologyBuildDuration.Store(topology.Timestamp.Sub(startTime).Milliseconds())
 	o.topologyBuildTime.Store(topology.Timestamp.Unix())
 
+	report := o.validator.Validate(topology)
+	fatal := report.Fatal()
+	if len(fatal) > 0 {
+		report.Blocked = true
+		o.topologyMu.Lock()
+		o.validationReport = report
+		o.topologyMu.Unlock()
+
+		for _, v := range fatal {
+			log.Errorf("Validation failed: %s", v)
+		}
+
+		return fmt.Errorf("%d fatal validation violation(s), not updating topology!", len(fatal))
+	}
+
+	if len(report.Violations) > 0 {
+		log.Warnf("Topology has %d validation violation(s)", len(report.Violations))
+	}
+
+	previous := o.GetTopology()
+	shrinkage := o.checkShrinkage(previous, topology)
 	o.topologyMu.Lock()
-	defer o.topologyMu.Unlock()
-	o.topology = topology
+	o.shrinkage = shrinkage
+	o.blocked = nil
+	if len(shrinkage) > 0 {
+		o.blocked = &blockedBuild{
+			topology: topology,
+			states:   states,
+			report:   report,
+		}
+	}
+	o.topologyMu.Unlock()
 
+	if len(shrinkage) > 0 {
+		for _, s := range shrinkage {
+			log.Errorf("Topology shrinkage: %s", s)
+		}
+
+		return fmt.Errorf("topology shrank beyond the limits for %d item type(s), not updating topology!", len(shrinkage))
+	}
+
+	o.publishTopology(previous, topology, states, report)
 	return nil
 }
 
+// publishTopology makes the given topology the current one, retains and persists it, and notifies the watchers.
+// It has to be called with buildMu held.
+func (o *Octopus) publishTopology(previous *model.Topology, topology *model.Topology, states []connectorState, report *validation.Report) {
+	events := model.TopologyEvents(previous, topology)
+	diff := model.Diff(previous, topology)
+
+	// Builds are serialized by buildMu, so the generation can't change until we publish
+	o.topologyMu.RLock()
+	topology.Generation = o._currentGeneration() + 1
+	o.topologyMu.RUnlock()
+
+	// The serialized topology is used to account for the memory of the snapshot, to persist it, and to serve it
+	pt := topology.ToProtoWithProvenance()
+	loadTimes := o.connectorLoadTimes()
+
+	cache := newTopologyCache(o.codec, o.epoch, o.enforceHistoryBudget)
+	err := cache.warm(topology, pt)
+	if err != nil {
+		log.Errorf("Unable to cache topology responses: %v", err)
+	}
+
+	o.topologyMu.Lock()
+	o._recordChanges(topology, events)
+	o._retainSnapshot(topology, proto.Size(pt), loadTimes, cache)
+	o.topology = topology
+	report.Generation = topology.Generation
+	o.validationReport = report
+	o.connectorStates = states
+	o.topologyMu.Unlock()
+	o.rebuildsPerformed.Add(1)
+	o.persistSnapshot(pt, loadTimes)
+
+	if diff.Empty() {
+		log.Infof("Built topology generation %d, no changes", topology.Generation)
+	} else {
+		log.Infof("Built topology generation %d, changes: %s", topology.Generation, diff.Summary())
+	}
+
+	o.watchers.notifyAll()
+}
+
 // GetTopology returns a pointer to the current topology
 func (o *Octopus) GetTopology() *model.Topology {
 	o.topologyMu.RLock()
@@ -110,6 +303,31 @@ func (o *Octopus) GetTopology() *model.Topology {
 	return o.topology
 }
 
+// builtFrom checks if the current topology has been built from the given connector data generations and contributions
+func (o *Octopus) builtFrom(states []connectorState) bool {
+	o.topologyMu.RLock()
+	defer o.topologyMu.RUnlock()
+
+	return o.topology != nil && slices.Equal(o.connectorStates, states)
+}
+
+// blockedFrom checks if the last topology build has been blocked by its shrinkage and built from the given connector data generations and contributions
+func (o *Octopus) blockedFrom(states []connectorState) bool {
+	o.topologyMu.RLock()
+	defer o.topologyMu.RUnlock()
+
+	return o.blocked != nil && slices.Equal(o.blocked.states, states)
+}
+
+// Has to be called with topologyMu held
+func (o *Octopus) _currentGeneration() uint64 {
+	if o.topology == nil {
+		return 0
+	}
+
+	return o.topology.Generation
+}
+
 func (o *Octopus) Healthy() bool {
 	o.topologyMu.RLock()
 	defer o.topologyMu.RUnlock()
@@ -117,9 +335,27 @@ func (o *Octopus) Healthy() bool {
 	return o.topology != nil
 }
 
+// topologyRefreshRoutine rebuilds the topology periodically and shortly after any connector signaled new data
 func (o *Octopus) topologyRefreshRoutine() {
+	changes := o.connectorChanges()
+	ticker := time.NewTicker(topologyRefreshTime)
+
+	var debounce <-chan time.Time
 	for {
-		<-time.After(topologyRefreshTime)
+		select {
+		case <-ticker.C:
+		case <-changes.C():
+			if debounce == nil {
+				debounce = time.After(topologyRebuildDebounce)
+			}
+
+			continue
+		case <-debounce:
+			log.Info("Connector data changed, rebuilding topology")
+		}
+
+		debounce = nil
+		ticker.Reset(topologyRefreshTime)
 
 		err := o.UpdateTopology()
 		if err != nil {
@@ -128,6 +364,21 @@ func (o *Octopus) topologyRefreshRoutine() {
 	}
 }
 
+// connectorChanges merges the change notifications of all connectors
+func (o *Octopus) connectorChanges() *connector.Notifier {
+	changes := connector.NewNotifier()
+	for _, c := range o.connectors {
+		go func(c connector.Connector) {
+			for range c.Changes() {
+				log.Debugf("Connector %s signaled new data", c.GetName())
+				changes.Notify()
+			}
+		}(c)
+	}
+
+	return changes
+}
+
 func (o *Octopus) serveGrpc() {
 	portStr := fmt.Sprintf(":%d", o.grpcPort)
 	log.Infof("Starting gRPC API server at %s", portStr)
@@ -136,6 +387,7 @@ func (o *Octopus) serveGrpc() {
 
 	srvMetrics := grpcprom.NewServerMetrics()
 	s := grpc.NewServer(
+		grpc.ForceServerCodecV2(o.codec),
 		grpc.UnaryInterceptor(srvMetrics.UnaryServerInterceptor()),
 		grpc.StreamInterceptor(srvMetrics.StreamServerInterceptor()),
 	)
@@ -0,0 +1,316 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"google.golang.org/protobuf/proto"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	snapshotFilePrefix = "topology-"
+	snapshotFileSuffix = ".pb"
+)
+
+// snapshotStore persists topology snapshots into a directory, keeping the latest retention ones not older than maxAge
+type snapshotStore struct {
+	dir       string
+	retention int
+	maxAge    time.Duration
+}
+
+// EnablePersistence makes the Octopus write every topology it builds to dir, keeping the latest retention snapshots.
+// With a maxAge > 0 snapshots built longer than maxAge ago are removed as well, except the latest one.
+// It has to be called before Init.
+func (o *Octopus) EnablePersistence(dir string, retention int, maxAge time.Duration) error {
+	if retention < 1 {
+		return fmt.Errorf("retention has to be at least 1")
+	}
+
+	if maxAge < 0 {
+		return fmt.Errorf("max age must not be negative")
+	}
+
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return fmt.Errorf("unable to create %q: %v", dir, err)
+	}
+
+	o.snapshotStore = &snapshotStore{
+		dir:       dir,
+		retention: retention,
+		maxAge:    maxAge,
+	}
+
+	return nil
+}
+
+// WarmStart loads the latest persisted snapshot as the current topology, flagged as stale until the topology is rebuilt from the connectors.
+// All other persisted snapshots are added to the history, to be loaded from disk when requested. Only the latest snapshot
+// is read, the generation and build time of the others are taken from their file names.
+func (o *Octopus) WarmStart() error {
+	if o.snapshotStore == nil {
+		return fmt.Errorf("persistence not enabled")
+	}
+
+	files, err := o.snapshotStore.list()
+	if err != nil {
+		return err
+	}
+
+	history := make([]*snapshot, 0, len(files))
+	for _, path := range files {
+		timestamp, generation, err := parseSnapshotPath(path)
+		if err != nil {
+			log.Warnf("Skipping snapshot: %v", err)
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			log.Warnf("Skipping snapshot: %v", err)
+			continue
+		}
+
+		history = append(history, &snapshot{
+			generation: generation,
+			timestamp:  time.Unix(timestamp, 0),
+			size:       int(info.Size()),
+			path:       path,
+		})
+	}
+
+	// Fall back to older snapshots if the latest one can't be read, the unreadable ones are dropped from the history
+	var topology *model.Topology
+	for len(history) > 0 {
+		current := history[len(history)-1]
+		topology, current.connectorLoadTimes, err = readSnapshotTopology(current.path)
+		if err == nil {
+			break
+		}
+
+		log.Warnf("Skipping snapshot: %v", err)
+		history = history[:len(history)-1]
+	}
+
+	if topology == nil {
+		return fmt.Errorf("no snapshot found in %q", o.snapshotStore.dir)
+	}
+
+	current := history[len(history)-1]
+	topology.Stale = true
+	current.topology = topology
+
+	o.topologyMu.Lock()
+	defer o.topologyMu.Unlock()
+
+	o.snapshots = history
+	o.topology = topology
+
+	log.Infof("Restored topology generation %d built at %s from %q, %d snapshot(s) in history", topology.Generation, topology.Timestamp, current.path, len(history))
+	return nil
+}
+
+// readSnapshotTopology restores the topology persisted at path and returns it along with the connector load times of its build
+func readSnapshotTopology(path string) (*model.Topology, []*octopuspb.ConnectorLoadTime, error) {
+	ps, _, err := readSnapshot(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	topology, err := model.TopologyFromProto(ps.Topology)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to restore topology from %q: %v", path, err)
+	}
+
+	return topology, ps.ConnectorLoadTimes, nil
+}
+
+// connectorLoadTimes returns the time every connector last loaded its data
+func (o *Octopus) connectorLoadTimes() []*octopuspb.ConnectorLoadTime {
+	loadTimes := make([]*octopuspb.ConnectorLoadTime, 0, len(o.connectors))
+	for _, c := range o.connectors {
+		loadTimes = append(loadTimes, &octopuspb.ConnectorLoadTime{
+			Connector: c.GetName(),
+			LoadTime:  uint64(c.GetLoadTime().Unix()),
+		})
+	}
+
+	return loadTimes
+}
+
+// persistSnapshot writes the given topology to disk, if persistence is enabled
+func (o *Octopus) persistSnapshot(pt *octopuspb.Topology, connectorLoadTimes []*octopuspb.ConnectorLoadTime) {
+	if o.snapshotStore == nil {
+		return
+	}
+
+	ps := &octopuspb.PersistedSnapshot{
+		Topology:           pt,
+		ConnectorLoadTimes: connectorLoadTimes,
+	}
+
+	removed, err := o.snapshotStore.save(ps)
+	if err != nil {
+		log.Errorf("Failed to persist topology generation %d: %v", pt.Generation, err)
+		removed = append(removed, o.snapshotStore.path(int64(pt.Timestamp), pt.Generation))
+	}
+
+	o.forgetSnapshotFiles(removed)
+}
+
+// path returns the path the snapshot of the given build time and generation is stored at
+func (s *snapshotStore) path(timestamp int64, generation uint64) string {
+	// The timestamp goes first, so the file names sort chronologically
+	name := fmt.Sprintf("%s%020d-%020d%s", snapshotFilePrefix, timestamp, generation, snapshotFileSuffix)
+	return filepath.Join(s.dir, name)
+}
+
+// parseSnapshotPath returns the build time and generation of the snapshot stored at path
+func parseSnapshotPath(path string) (int64, uint64, error) {
+	name := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), snapshotFilePrefix), snapshotFileSuffix)
+	timestamp, generation, found := strings.Cut(name, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("invalid snapshot file name %q", filepath.Base(path))
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid timestamp in snapshot file name %q: %v", filepath.Base(path), err)
+	}
+
+	gen, err := strconv.ParseUint(generation, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid generation in snapshot file name %q: %v", filepath.Base(path), err)
+	}
+
+	return ts, gen, nil
+}
+
+// save writes the snapshot atomically and removes snapshots beyond the retention or max age. It returns the paths of the removed snapshots.
+func (s *snapshotStore) save(ps *octopuspb.PersistedSnapshot) ([]string, error) {
+	data, err := proto.Marshal(ps)
+	if err != nil {
+		return nil, fmt.Errorf("marshal failed: %v", err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".tmp-"+snapshotFilePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temporary file: %v", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to write %q: %v", tmp.Name(), err)
+	}
+
+	err = os.Rename(tmp.Name(), s.path(int64(ps.Topology.Timestamp), ps.Topology.Generation))
+	if err != nil {
+		return nil, fmt.Errorf("rename failed: %v", err)
+	}
+
+	return s.rotate(time.Now())
+}
+
+// rotate removes the oldest snapshots beyond the retention or built longer than the max age before now and returns their paths.
+// The latest snapshot is always kept.
+func (s *snapshotStore) rotate(now time.Time) ([]string, error) {
+	files, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make([]string, 0)
+	for len(files) > s.retention || (len(files) > 1 && s.expired(files[0], now)) {
+		err = os.Remove(files[0])
+		if err != nil {
+			return removed, fmt.Errorf("unable to remove %q: %v", files[0], err)
+		}
+
+		removed = append(removed, files[0])
+		files = files[1:]
+	}
+
+	return removed, nil
+}
+
+// expired returns whether the snapshot at path was built longer than the max age before now
+func (s *snapshotStore) expired(path string, now time.Time) bool {
+	if s.maxAge == 0 {
+		return false
+	}
+
+	timestamp, _, err := parseSnapshotPath(path)
+	if err != nil {
+		return false
+	}
+
+	return now.Sub(time.Unix(timestamp, 0)) > s.maxAge
+}
+
+// list returns the paths of all persisted snapshots, oldest first
+func (s *snapshotStore) list() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %q: %v", s.dir, err)
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasPrefix(e.Name(), snapshotFilePrefix) || !strings.HasSuffix(e.Name(), snapshotFileSuffix) {
+			continue
+		}
+
+		files = append(files, filepath.Join(s.dir, e.Name()))
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+// readSnapshot reads the persisted snapshot from path and returns it along with its size on disk
+func readSnapshot(path string) (*octopuspb.PersistedSnapshot, int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to read %q: %v", path, err)
+	}
+
+	ps := &octopuspb.PersistedSnapshot{}
+	err = proto.Unmarshal(data, ps)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to unmarshal %q: %v", path, err)
+	}
+
+	if ps.Topology == nil {
+		return nil, 0, fmt.Errorf("%q contains no topology", path)
+	}
+
+	return ps, len(data), nil
+}
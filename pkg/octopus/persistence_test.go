@@ -0,0 +1,186 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPersistedSnapshot creates a snapshot of the test topology with the given generation and build time
+func newPersistedSnapshot(generation uint64, ts int64) *octopuspb.PersistedSnapshot {
+	topology := newTestTopology(time.Unix(ts, 0))
+	topology.Generation = generation
+
+	return &octopuspb.PersistedSnapshot{
+		Topology: topology.ToProto(),
+		ConnectorLoadTimes: []*octopuspb.ConnectorLoadTime{
+			{Connector: "File", LoadTime: uint64(ts)},
+		},
+	}
+}
+
+func TestParseSnapshotPath(t *testing.T) {
+	tests := []struct {
+		name               string
+		path               string
+		wantFail           bool
+		expectedTimestamp  int64
+		expectedGeneration uint64
+	}{
+		{
+			name:               "valid",
+			path:               (&snapshotStore{dir: "/var/lib/octopus"}).path(1700000000, 42),
+			expectedTimestamp:  1700000000,
+			expectedGeneration: 42,
+		},
+		{
+			name:     "missing generation",
+			path:     "/var/lib/octopus/topology-1700000000.pb",
+			wantFail: true,
+		},
+		{
+			name:     "invalid timestamp",
+			path:     "/var/lib/octopus/topology-yesterday-00000000000000000042.pb",
+			wantFail: true,
+		},
+		{
+			name:     "invalid generation",
+			path:     "/var/lib/octopus/topology-00000000001700000000-latest.pb",
+			wantFail: true,
+		},
+	}
+
+	for _, test := range tests {
+		timestamp, generation, err := parseSnapshotPath(test.path)
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			continue
+		}
+
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expectedTimestamp, timestamp, test.name)
+		assert.Equal(t, test.expectedGeneration, generation, test.name)
+	}
+}
+
+func TestSnapshotStoreSaveRotate(t *testing.T) {
+	s := &snapshotStore{dir: t.TempDir(), retention: 2}
+
+	// Neither temporary files nor other files are snapshots
+	assert.NoError(t, os.WriteFile(filepath.Join(s.dir, ".tmp-topology-123"), nil, 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(s.dir, "README"), nil, 0o644))
+	assert.NoError(t, os.Mkdir(filepath.Join(s.dir, "topology-dir.pb"), 0o755))
+
+	for gen := uint64(1); gen <= 2; gen++ {
+		removed, err := s.save(newPersistedSnapshot(gen, 1700000000+int64(gen)))
+		assert.NoError(t, err)
+		assert.Empty(t, removed)
+	}
+
+	removed, err := s.save(newPersistedSnapshot(3, 1700000003))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{s.path(1700000001, 1)}, removed)
+
+	files, err := s.list()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{s.path(1700000002, 2), s.path(1700000003, 3)}, files)
+
+	ps, size, err := readSnapshot(files[1])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), ps.Topology.Generation)
+	assert.Len(t, ps.ConnectorLoadTimes, 1)
+	assert.Positive(t, size)
+}
+
+func TestSnapshotStoreRotateMaxAge(t *testing.T) {
+	s := &snapshotStore{dir: t.TempDir(), retention: 10, maxAge: time.Hour}
+	now := time.Unix(1700010000, 0)
+
+	for gen, age := range []time.Duration{3 * time.Hour, 2 * time.Hour, 30 * time.Minute} {
+		path := s.path(now.Add(-age).Unix(), uint64(gen))
+		assert.NoError(t, os.WriteFile(path, nil, 0o644))
+	}
+
+	removed, err := s.rotate(now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{s.path(now.Add(-3*time.Hour).Unix(), 0), s.path(now.Add(-2*time.Hour).Unix(), 1)}, removed)
+
+	// The latest snapshot is kept even if it expired
+	removed, err = s.rotate(now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Empty(t, removed)
+
+	files, err := s.list()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{s.path(now.Add(-30*time.Minute).Unix(), 2)}, files)
+
+	// Without a max age only the retention applies
+	s.maxAge = 0
+	assert.NoError(t, os.WriteFile(s.path(now.Add(-48*time.Hour).Unix(), 3), nil, 0o644))
+	removed, err = s.rotate(now)
+	assert.NoError(t, err)
+	assert.Empty(t, removed)
+}
+
+func TestWarmStart(t *testing.T) {
+	dir := t.TempDir()
+
+	o := NewOctopus(0)
+	assert.Error(t, o.WarmStart(), "persistence not enabled")
+
+	assert.Error(t, o.EnablePersistence(dir, 0, 0), "no retention")
+	assert.Error(t, o.EnablePersistence(dir, 10, -time.Hour), "negative max age")
+	assert.NoError(t, o.EnablePersistence(dir, 10, 0))
+	assert.Error(t, o.WarmStart(), "no snapshots")
+
+	for gen := uint64(1); gen <= 3; gen++ {
+		_, err := o.snapshotStore.save(newPersistedSnapshot(gen, 1700000000+int64(gen)))
+		assert.NoError(t, err)
+	}
+
+	// Older snapshots are not read at warm start, so their contents don't matter until requested
+	assert.NoError(t, os.WriteFile(o.snapshotStore.path(1700000000, 0), []byte("corrupt"), 0o644))
+
+	// The latest snapshot can't be read, so the one before is restored
+	assert.NoError(t, os.WriteFile(o.snapshotStore.path(1700000004, 4), []byte("corrupt"), 0o644))
+
+	assert.NoError(t, o.WarmStart())
+
+	topology := o.GetTopology()
+	assert.NotNil(t, topology)
+	assert.Equal(t, uint64(3), topology.Generation)
+	assert.True(t, topology.Stale)
+
+	snapshots := o.ListSnapshots()
+	assert.Len(t, snapshots, 4)
+	for i, s := range snapshots {
+		assert.Equal(t, uint64(i), s.Generation)
+		assert.Equal(t, uint64(1700000000+i), s.Timestamp)
+		assert.True(t, s.Persisted)
+		assert.Positive(t, s.SizeBytes)
+	}
+
+	assert.False(t, snapshots[2].InMemory)
+	assert.Empty(t, snapshots[2].ConnectorLoadTimes)
+	assert.True(t, snapshots[3].InMemory)
+	assert.Len(t, snapshots[3].ConnectorLoadTimes, 1)
+
+	// Loading an older snapshot from disk fills in its connector load times
+	snapshot := o.GetSnapshot(2)
+	assert.NotNil(t, snapshot)
+	assert.Equal(t, time.Unix(1700000002, 0), snapshot.Timestamp)
+	assert.Len(t, o.ListSnapshots()[2].ConnectorLoadTimes, 1)
+
+	assert.Nil(t, o.GetSnapshot(0), "corrupt snapshot")
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/octopus/pkg/connector"
+	"github.com/cloudflare/octopus/pkg/model"
+)
+
+// connectorState is what the contribution of a connector to a topology depends on
+type connectorState struct {
+	generation   uint64
+	contribution model.ConnectorContribution
+}
+
+// SetConnectorPolicy sets the policy for the connector of the given name. Connectors without a policy are required.
+// It has to be called before Init.
+func (o *Octopus) SetConnectorPolicy(name string, policy model.ConnectorPolicy) {
+	o.connectorPolicies[name] = policy
+}
+
+// ParseConnectorPolicies parses a comma separated list of connector=policy pairs, e.g. "File=optional-skip"
+func ParseConnectorPolicies(s string) (map[string]model.ConnectorPolicy, error) {
+	ret := make(map[string]model.ConnectorPolicy)
+	if s == "" {
+		return ret, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		name, policyName, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid connector policy %q, expected connector=policy", pair)
+		}
+
+		policy, err := model.ConnectorPolicyFromString(policyName)
+		if err != nil {
+			return nil, err
+		}
+
+		ret[name] = policy
+	}
+
+	return ret, nil
+}
+
+func (o *Octopus) connectorPolicy(c connector.Connector) model.ConnectorPolicy {
+	return o.connectorPolicies[c.GetName()]
+}
+
+// planContributions decides how every connector contributes to the next topology, depending on its health and policy.
+// It fails if a required connector is unhealthy or no connector would contribute at all.
+func (o *Octopus) planContributions() ([]*model.ConnectorProvenance, []connectorState, error) {
+	plan := make([]*model.ConnectorProvenance, 0, len(o.connectors))
+	states := make([]connectorState, 0, len(o.connectors))
+	contributing := 0
+
+	for _, c := range o.connectors {
+		cp := &model.ConnectorProvenance{
+			Connector:    c.GetName(),
+			Policy:       o.connectorPolicy(c),
+			Contribution: model.ContributionCurrent,
+		}
+
+		if !c.Healthy() {
+			switch cp.Policy {
+			case model.ConnectorRequired:
+				return nil, nil, fmt.Errorf("Connector %s is not healthy, not updating topology!", c.GetName())
+			case model.ConnectorOptionalLastGood:
+				cp.Contribution = model.ContributionSkipped
+				if _, ok := c.(connector.LastGoodEnricher); ok {
+					cp.Contribution = model.ContributionLastGood
+				}
+			default:
+				cp.Contribution = model.ContributionSkipped
+			}
+		}
+
+		if cp.Contribution != model.ContributionSkipped {
+			cp.LoadTime = c.GetLoadTime()
+			contributing++
+		}
+
+		plan = append(plan, cp)
+		states = append(states, connectorState{
+			generation:   c.GetDataGeneration(),
+			contribution: cp.Contribution,
+		})
+	}
+
+	if contributing == 0 {
+		return nil, nil, fmt.Errorf("No connector is healthy, not updating topology!")
+	}
+
+	return plan, states, nil
+}
@@ -8,16 +8,27 @@
 package octopus
 
 import (
+	"slices"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 var (
 	topologyBuildDuration    = prometheus.NewDesc("octopus_topology_update_duration", "Time it took to build the topology (milliseconds)", nil, nil)
+	topologyMergeDuration    = prometheus.NewDesc("octopus_topology_merge_duration", "Time it took to merge the partial topologies of the connectors (milliseconds)", nil, nil)
 	topologyBuildTime        = prometheus.NewDesc("octopus_topology_build_time", "Timestamp (epoch) when the current topology was build", nil, nil)
+	topologyRebuildCountVec  = prometheus.NewDesc("octopus_topology_rebuild_count", "The number of topology rebuilds performed and skipped as no connector had new data", []string{"result"}, nil)
+	topologyStale            = prometheus.NewDesc("octopus_topology_stale", "Indicator if the current topology has been restored from disk and not been rebuilt yet (0/1)", nil, nil)
 	topologyItemCount        = prometheus.NewDesc("octopus_topology_item_count", "The number of instances per item", []string{"item_type"}, nil)
+	topologyShrinkageBlocked = prometheus.NewDesc("octopus_topology_shrinkage_blocked", "Indicator if the last topology build has been blocked as it shrank beyond the limits (0/1)", nil, nil)
+	topologyShrinkageDrop    = prometheus.NewDesc("octopus_topology_shrinkage_drop", "The number of items which disappeared beyond the limits in the last topology build", []string{"item_type"}, nil)
+	mergeConflictCount       = prometheus.NewDesc("octopus_merge_conflict_count", "The number of attributes connectors set to different values, in the current topology and blocking the last build", []string{"entity", "field", "resolution"}, nil)
+	validationViolationCount = prometheus.NewDesc("octopus_validation_violation_count", "The number of validation violations found in the last topology build", []string{"rule", "severity"}, nil)
+	validationBlocked        = prometheus.NewDesc("octopus_validation_blocked", "Indicator if the last topology build has been blocked by fatal validation violations (0/1)", nil, nil)
 	connectorHealthyVec      = prometheus.NewDesc("octopus_connector_health", "Connector health indicatior (0/1)", []string{"connector"}, nil)
 	connectorLoadDurationVec = prometheus.NewDesc("octopus_connector_load_duraton", "Timestamp (epoch) when the current connector data was fetched", []string{"connector"}, nil)
 	connectorLoadTimeVec     = prometheus.NewDesc("octopus_connector_load_time", "Time it took to fetch data (milliseconds)", []string{"connector"}, nil)
+	connectorEnrichDuration  = prometheus.NewDesc("octopus_connector_enrich_duration", "Time it took the connector to enrich its partial topology during the last build (milliseconds)", []string{"connector"}, nil)
 	connectorUpdateErrorVec  = prometheus.NewDesc("octopus_connector_update_error_count", "The number of time the refresh of connector data has failed", []string{"connector"}, nil)
 )
 
@@ -33,33 +44,77 @@ func NewPromAdapter(octopus *Octopus) *PromAdapter {
 
 func (p *PromAdapter) Describe(ch chan<- *prometheus.Desc) {
 	ch <- topologyBuildDuration
+	ch <- topologyMergeDuration
 	ch <- topologyBuildTime
+	ch <- topologyRebuildCountVec
+	ch <- topologyStale
 	ch <- topologyItemCount
+	ch <- topologyShrinkageBlocked
+	ch <- topologyShrinkageDrop
+	ch <- mergeConflictCount
+	ch <- validationViolationCount
+	ch <- validationBlocked
 	ch <- connectorHealthyVec
 	ch <- connectorLoadDurationVec
 	ch <- connectorLoadTimeVec
+	ch <- connectorEnrichDuration
 	ch <- connectorUpdateErrorVec
+
+	// Connectors may export their own metrics
+	for _, c := range p.octopus.connectors {
+		if collector, ok := c.(prometheus.Collector); ok {
+			collector.Describe(ch)
+		}
+	}
 }
 
 func (p *PromAdapter) Collect(ch chan<- prometheus.Metric) {
 	ch <- prometheus.MustNewConstMetric(topologyBuildDuration, prometheus.GaugeValue, float64(p.octopus.topologyBuildDuration.Load()))
+	ch <- prometheus.MustNewConstMetric(topologyMergeDuration, prometheus.GaugeValue, float64(p.octopus.topologyMergeDuration.Load()))
 	ch <- prometheus.MustNewConstMetric(topologyBuildTime, prometheus.GaugeValue, float64(p.octopus.topologyBuildTime.Load()))
+	ch <- prometheus.MustNewConstMetric(topologyRebuildCountVec, prometheus.CounterValue, float64(p.octopus.rebuildsPerformed.Load()), "performed")
+	ch <- prometheus.MustNewConstMetric(topologyRebuildCountVec, prometheus.CounterValue, float64(p.octopus.rebuildsSkipped.Load()), "skipped")
 
 	t := p.octopus.GetTopology()
-	ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(len(t.Sites)), "sites")
-	ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(len(t.Pops)), "pops")
-	ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(len(t.Colos)), "colos")
-	ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(len(t.Nodes)), "devices")
-	ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(len(t.Interfaces)), "interfaces")
-	ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(len(t.Cables)), "cables")
-	ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(len(t.Circuits)), "circuits")
-	ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(len(t.Prefixes)), "prefixes")
+	ch <- prometheus.MustNewConstMetric(topologyStale, prometheus.GaugeValue, healthyToFloat64(t.Stale))
+	counts := itemCounts(t)
+	for _, itemType := range itemTypes {
+		ch <- prometheus.MustNewConstMetric(topologyItemCount, prometheus.GaugeValue, float64(counts[itemType]), itemType)
+	}
 
-	for _, c := range p.octopus.connectors {
+	shrinkage, blocked := p.octopus.GetShrinkage()
+	ch <- prometheus.MustNewConstMetric(topologyShrinkageBlocked, prometheus.GaugeValue, healthyToFloat64(blocked))
+	for _, s := range shrinkage {
+		ch <- prometheus.MustNewConstMetric(topologyShrinkageDrop, prometheus.GaugeValue, float64(s.Previous-s.Current), s.ItemType)
+	}
+
+	conflicts := make(map[[3]string]int)
+	for _, mc := range append(slices.Clone(t.MergeConflicts), p.octopus.GetBlockingConflicts()...) {
+		conflicts[[3]string{mc.Entity, mc.Field, mc.Resolution.String()}]++
+	}
+
+	for labels, count := range conflicts {
+		ch <- prometheus.MustNewConstMetric(mergeConflictCount, prometheus.GaugeValue, float64(count), labels[0], labels[1], labels[2])
+	}
+
+	report := p.octopus.GetValidationReport()
+	if report != nil {
+		ch <- prometheus.MustNewConstMetric(validationBlocked, prometheus.GaugeValue, healthyToFloat64(report.Blocked))
+		for rule, count := range report.Count() {
+			ch <- prometheus.MustNewConstMetric(validationViolationCount, prometheus.GaugeValue, float64(count), rule, report.Rules[rule].String())
+		}
+	}
+
+	for i, c := range p.octopus.connectors {
+		ch <- prometheus.MustNewConstMetric(connectorEnrichDuration, prometheus.GaugeValue, float64(p.octopus.enrichDurations[i].Load()), c.GetName())
 		ch <- prometheus.MustNewConstMetric(connectorHealthyVec, prometheus.GaugeValue, healthyToFloat64(c.Healthy()), c.GetName())
 		ch <- prometheus.MustNewConstMetric(connectorLoadDurationVec, prometheus.GaugeValue, float64(c.GetLoadDuration().Milliseconds()), c.GetName())
 		ch <- prometheus.MustNewConstMetric(connectorLoadTimeVec, prometheus.GaugeValue, float64(c.GetLoadTime().Unix()), c.GetName())
 		ch <- prometheus.MustNewConstMetric(connectorUpdateErrorVec, prometheus.CounterValue, float64(c.GetUpdateErrorCount()), c.GetName())
+
+		if collector, ok := c.(prometheus.Collector); ok {
+			collector.Collect(ch)
+		}
 	}
 }
 
@@ -0,0 +1,634 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cloudflare/octopus/pkg/validation"
+	api "github.com/cloudflare/octopus/proto/octopus"
+	log "github.com/sirupsen/logrus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	// RESTAPIPrefix is the path all endpoints of the REST API live under
+	RESTAPIPrefix = "/api/v1/"
+
+	// Header carrying the generation of the topology a response is based on
+	generationHeader = "X-Octopus-Generation"
+
+	jsonContentType = "application/json"
+
+	// Smaller responses aren't worth compressing
+	minGzipBytes = 1024
+)
+
+var jsonMarshalOptions = protojson.MarshalOptions{
+	UseProtoNames: true,
+}
+
+var httpStatusCodes = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// restServer serves the query RPCs of the gRPC API as JSON over HTTP.
+// Requests are translated into their gRPC counterparts, so both APIs behave the same.
+type restServer struct {
+	octopus *Octopus
+	server  *ocotopusServer
+}
+
+// RESTHandler returns the handler of the REST API, which has to be mounted at RESTAPIPrefix
+func (o *Octopus) RESTHandler() http.Handler {
+	rs := &restServer{
+		octopus: o,
+		server:  newOctopusServer(o),
+	}
+
+	m := http.NewServeMux()
+	m.HandleFunc("GET /api/v1/topology", rs.getTopology)
+	m.HandleFunc("GET /api/v1/devices", rs.queryDevices)
+	m.HandleFunc("GET /api/v1/devices/{name}", rs.getDevice)
+	m.HandleFunc("GET /api/v1/devices/{name}/neighbors", rs.getNeighbors)
+	m.HandleFunc("GET /api/v1/devices/{name}/path", rs.tracePath)
+	m.HandleFunc("GET /api/v1/lookup/ip", rs.lookupIP)
+	m.HandleFunc("GET /api/v1/lookup/prefix", rs.lookupPrefix)
+	m.HandleFunc("GET /api/v1/diff", rs.diffTopology)
+	m.HandleFunc("GET /api/v1/snapshots", rs.listSnapshots)
+	m.HandleFunc("GET /api/v1/merge-conflicts", rs.getMergeConflicts)
+	m.HandleFunc("GET /api/v1/validation", rs.getValidationReport)
+	m.HandleFunc("GET /api/v1/shrinkage", rs.getShrinkage)
+	m.HandleFunc("GET /api/v1/export", rs.exportTopology)
+
+	return m
+}
+
+func (rs *restServer) getTopology(rw http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	sel, err := snapshotSelector(q, "")
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	includeProvenance, err := boolParam(q, "include_provenance")
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	topology, err := rs.server.selectTopology(sel)
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	if rs.notModified(rw, req, topology.Generation) {
+		return
+	}
+
+	compressed := acceptsGzip(req)
+	b, err := rs.octopus.topologyJSON(topology, includeProvenance, compressed)
+	if err != nil {
+		writeError(rw, req, status.New(codes.Internal, err.Error()).Err())
+		return
+	}
+
+	writeBody(rw, http.StatusOK, jsonContentType, b, compressed)
+}
+
+func (rs *restServer) getDevice(rw http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	sel, err := snapshotSelector(q, "")
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	includeProvenance, err := boolParam(q, "include_provenance")
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	// The snapshot is resolved once, as retained snapshots might have to be loaded from disk
+	topology, err := rs.server.selectTopology(sel)
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	if rs.notModified(rw, req, topology.Generation) {
+		return
+	}
+
+	resp := deviceResponse(topology, &api.DeviceRequest{
+		DeviceName:        req.PathValue("name"),
+		IncludeProvenance: includeProvenance,
+	})
+	if resp.Device == nil {
+		writeError(rw, req, status.New(codes.NotFound, "Device not found.").Err())
+		return
+	}
+
+	writeJSON(rw, req, http.StatusOK, resp)
+}
+
+func (rs *restServer) queryDevices(rw http.ResponseWriter, req *http.Request) {
+	queryRequest, err := queryDevicesRequest(req.URL.Query())
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	if rs.notModified(rw, req, rs.currentGeneration()) {
+		return
+	}
+
+	resp, err := rs.server.QueryDevices(req.Context(), queryRequest)
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) getNeighbors(rw http.ResponseWriter, req *http.Request) {
+	if rs.notModified(rw, req, rs.currentGeneration()) {
+		return
+	}
+
+	resp, err := rs.server.GetNeighbors(req.Context(), &api.GetNeighborsRequest{
+		DeviceName:    req.PathValue("name"),
+		InterfaceName: req.URL.Query().Get("interface"),
+	})
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) tracePath(rw http.ResponseWriter, req *http.Request) {
+	interfaceName := req.URL.Query().Get("interface")
+	if interfaceName == "" {
+		writeError(rw, req, status.New(codes.InvalidArgument, "No interface provided.").Err())
+		return
+	}
+
+	if rs.notModified(rw, req, rs.currentGeneration()) {
+		return
+	}
+
+	resp, err := rs.server.TracePath(req.Context(), &api.TracePathRequest{
+		DeviceName:    req.PathValue("name"),
+		InterfaceName: interfaceName,
+	})
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) lookupIP(rw http.ResponseWriter, req *http.Request) {
+	if rs.notModified(rw, req, rs.currentGeneration()) {
+		return
+	}
+
+	resp, err := rs.server.LookupIP(req.Context(), &api.LookupIPRequest{
+		Address: req.URL.Query().Get("address"),
+	})
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) lookupPrefix(rw http.ResponseWriter, req *http.Request) {
+	if rs.notModified(rw, req, rs.currentGeneration()) {
+		return
+	}
+
+	resp, err := rs.server.LookupPrefix(req.Context(), &api.LookupPrefixRequest{
+		Prefix: req.URL.Query().Get("prefix"),
+	})
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) diffTopology(rw http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	from, err := snapshotSelector(q, "from_")
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	to, err := snapshotSelector(q, "to_")
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	resp, err := rs.server.DiffTopology(req.Context(), &api.DiffTopologyRequest{
+		From: from,
+		To:   to,
+	})
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	etag := fmt.Sprintf(`W/"%s-%d-%d"`, rs.octopus.epoch, resp.FromGeneration, resp.ToGeneration)
+	if matchesETag(rw, req, etag) {
+		return
+	}
+
+	writeJSON(rw, req, http.StatusOK, resp)
+}
+
+func (rs *restServer) listSnapshots(rw http.ResponseWriter, req *http.Request) {
+	resp, err := rs.server.ListSnapshots(req.Context(), &api.ListSnapshotsRequest{})
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) getMergeConflicts(rw http.ResponseWriter, req *http.Request) {
+	sel, err := snapshotSelector(req.URL.Query(), "")
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	// Blocking conflicts aren't part of the snapshot, so there is no point in conditional requests
+	resp, err := rs.server.GetMergeConflicts(req.Context(), &api.GetMergeConflictsRequest{
+		AsOf: sel,
+	})
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) getValidationReport(rw http.ResponseWriter, req *http.Request) {
+	reportRequest := &api.GetValidationReportRequest{}
+	minSeverity := req.URL.Query().Get("min_severity")
+	if minSeverity != "" {
+		severity, err := validation.SeverityFromString(minSeverity)
+		if err != nil {
+			writeError(rw, req, status.New(codes.InvalidArgument, err.Error()).Err())
+			return
+		}
+
+		reportRequest.MinSeverity = api.ValidationSeverity(severity)
+	}
+
+	resp, err := rs.server.GetValidationReport(req.Context(), reportRequest)
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) getShrinkage(rw http.ResponseWriter, req *http.Request) {
+	resp, err := rs.server.GetShrinkage(req.Context(), &api.GetShrinkageRequest{})
+	writeResponse(rw, req, resp, err)
+}
+
+func (rs *restServer) exportTopology(rw http.ResponseWriter, req *http.Request) {
+	exportRequest, err := exportTopologyRequest(req.URL.Query())
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	format, opts, err := exportOptions(exportRequest)
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	topology, err := rs.server.selectTopology(exportRequest.AsOf)
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	if rs.notModified(rw, req, topology.Generation) {
+		return
+	}
+
+	buf := &bytes.Buffer{}
+	err = topology.Export(buf, format, opts)
+	if err != nil {
+		writeError(rw, req, status.New(codes.Internal, err.Error()).Err())
+		return
+	}
+
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exportFileName(topology, format)))
+	writeCompressible(rw, req, http.StatusOK, format.ContentType(), buf.Bytes())
+}
+
+// currentGeneration returns the generation of the current topology, 0 if there is none.
+// It is determined before handling a request, so a response might be newer than its ETag but never older.
+func (rs *restServer) currentGeneration() uint64 {
+	topology := rs.octopus.GetTopology()
+	if topology == nil {
+		return 0
+	}
+
+	return topology.Generation
+}
+
+// queryDevicesRequest builds the request from the device filter parameters and the field mask as comma separated list of fields
+func queryDevicesRequest(q url.Values) (*api.QueryDevicesRequest, error) {
+	filter, err := deviceFilter(q)
+	if err != nil {
+		return nil, err
+	}
+
+	queryRequest := &api.QueryDevicesRequest{
+		Filter: filter,
+	}
+
+	fields := q.Get("fields")
+	if fields != "" {
+		queryRequest.FieldMask = &fieldmaskpb.FieldMask{
+			Paths: strings.Split(fields, ","),
+		}
+	}
+
+	return queryRequest, nil
+}
+
+// exportTopologyRequest builds the request from the format, snapshot selector, include_* and edges parameters, and the device filter parameters
+func exportTopologyRequest(q url.Values) (*api.ExportTopologyRequest, error) {
+	sel, err := snapshotSelector(q, "")
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := deviceFilter(q)
+	if err != nil {
+		return nil, err
+	}
+
+	exportRequest := &api.ExportTopologyRequest{
+		AsOf:   sel,
+		Filter: filter,
+	}
+
+	format := q.Get("format")
+	if format != "" {
+		value, exists := api.ExportFormat_value["EXPORT_FORMAT_"+strings.ToUpper(format)]
+		if !exists {
+			return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Unknown format %q.", format)).Err()
+		}
+
+		exportRequest.Format = api.ExportFormat(value)
+	}
+
+	edges := q.Get("edges")
+	if edges != "" {
+		value, exists := api.ExportEdges_value["EXPORT_EDGES_"+strings.ToUpper(edges)]
+		if !exists {
+			return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Unknown edges %q.", edges)).Err()
+		}
+
+		exportRequest.Edges = api.ExportEdges(value)
+	}
+
+	for name, include := range map[string]*bool{
+		"include_interfaces": &exportRequest.IncludeInterfaces,
+		"include_ports":      &exportRequest.IncludePorts,
+		"include_circuits":   &exportRequest.IncludeCircuits,
+	} {
+		*include, err = boolParam(q, name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return exportRequest, nil
+}
+
+// deviceFilter builds the filter from repeatable parameters (e.g. role=ccr&role=edge)
+// and semantic tags given as key or key=value
+func deviceFilter(q url.Values) (*api.DeviceFilter, error) {
+	filter := &api.DeviceFilter{
+		Sites:       q["site"],
+		Colos:       q["colo"],
+		Pops:        q["pop"],
+		Roles:       q["role"],
+		Platforms:   q["platform"],
+		Statuses:    q["status"],
+		DeviceTypes: q["device_type"],
+		Tags:        q["tag"],
+	}
+
+	operator := q.Get("operator")
+	if operator != "" {
+		value, exists := api.FilterOperator_value["FILTER_OPERATOR_"+strings.ToUpper(operator)]
+		if !exists {
+			return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Unknown operator %q.", operator)).Err()
+		}
+
+		filter.Operator = api.FilterOperator(value)
+	}
+
+	matchType := api.NameMatchType_NAME_MATCH_TYPE_EXACT
+	match := q.Get("match")
+	if match != "" {
+		value, exists := api.NameMatchType_value["NAME_MATCH_TYPE_"+strings.ToUpper(match)]
+		if !exists {
+			return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Unknown match type %q.", match)).Err()
+		}
+
+		matchType = api.NameMatchType(value)
+	}
+
+	for _, name := range q["name"] {
+		filter.Names = append(filter.Names, &api.NameFilter{
+			Pattern:   name,
+			MatchType: matchType,
+		})
+	}
+
+	for _, tag := range q["semantic_tag"] {
+		key, value, _ := strings.Cut(tag, "=")
+		filter.SemanticTags = append(filter.SemanticTags, &api.SemanticTagFilter{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return filter, nil
+}
+
+// snapshotSelector returns the selector given by the generation or timestamp (unix seconds) parameter, nil if neither is set
+func snapshotSelector(q url.Values, prefix string) (*api.SnapshotSelector, error) {
+	generation := q.Get(prefix + "generation")
+	timestamp := q.Get(prefix + "timestamp")
+
+	switch {
+	case generation != "" && timestamp != "":
+		return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Only one of %sgeneration and %stimestamp may be given.", prefix, prefix)).Err()
+	case generation != "":
+		g, err := strconv.ParseUint(generation, 10, 64)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid %sgeneration %q.", prefix, generation)).Err()
+		}
+
+		return &api.SnapshotSelector{
+			Selector: &api.SnapshotSelector_Generation{Generation: g},
+		}, nil
+	case timestamp != "":
+		ts, err := strconv.ParseUint(timestamp, 10, 64)
+		if err != nil {
+			return nil, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid %stimestamp %q.", prefix, timestamp)).Err()
+		}
+
+		return &api.SnapshotSelector{
+			Selector: &api.SnapshotSelector_Timestamp{Timestamp: ts},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func boolParam(q url.Values, name string) (bool, error) {
+	value := q.Get(name)
+	if value == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid %s %q.", name, value)).Err()
+	}
+
+	return b, nil
+}
+
+// notModified sets the ETag of the response to the epoch and generation of the topology it is based on.
+// It returns true and answers the request if the client already has that generation. The epoch keeps clients
+// from matching generations of another process, as they restart at 1 without persisted snapshots.
+// A generation of 0 (no topology) disables conditional requests.
+func (rs *restServer) notModified(rw http.ResponseWriter, req *http.Request, generation uint64) bool {
+	if generation == 0 {
+		return false
+	}
+
+	rw.Header().Set(generationHeader, strconv.FormatUint(generation, 10))
+	return matchesETag(rw, req, fmt.Sprintf(`W/"%s-%d"`, rs.octopus.epoch, generation))
+}
+
+// matchesETag sets the ETag of the response and answers the request with 304 Not Modified if If-None-Match matches it.
+// ETags are weak, as the response might be gzip compressed or not.
+func matchesETag(rw http.ResponseWriter, req *http.Request, etag string) bool {
+	rw.Header().Set("ETag", etag)
+	rw.Header().Set("Vary", "Accept-Encoding")
+
+	for _, tag := range strings.Split(req.Header.Get("If-None-Match"), ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			rw.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+
+	return false
+}
+
+func acceptsGzip(req *http.Request) bool {
+	for _, enc := range strings.Split(req.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(enc, ";")
+		name = strings.TrimSpace(name)
+		if name != "gzip" && name != "*" {
+			continue
+		}
+
+		q, found := strings.CutPrefix(strings.TrimSpace(params), "q=")
+		if !found {
+			return true
+		}
+
+		weight, err := strconv.ParseFloat(q, 64)
+		if err == nil && weight > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeResponse(rw http.ResponseWriter, req *http.Request, resp proto.Message, err error) {
+	if err != nil {
+		writeError(rw, req, err)
+		return
+	}
+
+	writeJSON(rw, req, http.StatusOK, resp)
+}
+
+// writeError answers with the JSON encoded gRPC status and the matching HTTP status code
+func writeError(rw http.ResponseWriter, req *http.Request, err error) {
+	st := status.Convert(err)
+
+	code, exists := httpStatusCodes[st.Code()]
+	if !exists {
+		code = http.StatusInternalServerError
+	}
+
+	writeJSON(rw, req, code, st.Proto())
+}
+
+func writeJSON(rw http.ResponseWriter, req *http.Request, code int, msg proto.Message) {
+	b, err := jsonMarshalOptions.Marshal(msg)
+	if err != nil {
+		log.Errorf("Unable to marshal %s response: %v", req.URL.Path, err)
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	writeCompressible(rw, req, code, jsonContentType, b)
+}
+
+// writeCompressible gzip compresses the body if the client accepts it and it is large enough to be worth it
+func writeCompressible(rw http.ResponseWriter, req *http.Request, code int, contentType string, b []byte) {
+	if len(b) < minGzipBytes || !acceptsGzip(req) {
+		writeBody(rw, code, contentType, b, false)
+		return
+	}
+
+	compressed, err := compress(b, api.TopologyEncoding_TOPOLOGY_ENCODING_GZIP)
+	if err != nil {
+		log.Errorf("Unable to compress %s response: %v", req.URL.Path, err)
+		writeBody(rw, code, contentType, b, false)
+		return
+	}
+
+	writeBody(rw, code, contentType, compressed, true)
+}
+
+func writeBody(rw http.ResponseWriter, code int, contentType string, b []byte, compressed bool) {
+	rw.Header().Set("Content-Type", contentType)
+	rw.Header().Set("Vary", "Accept-Encoding")
+	if compressed {
+		rw.Header().Set("Content-Encoding", "gzip")
+	}
+
+	rw.Header().Set("Content-Length", strconv.Itoa(len(b)))
+	rw.WriteHeader(code)
+	_, _ = rw.Write(b)
+}
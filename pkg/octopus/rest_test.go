@@ -0,0 +1,423 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/cloudflare/octopus/pkg/validation"
+	api "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestTopology creates ccr01.dus01 (role ccr) and edge01.dus01 (role edge) connected by a cable between their et-0/0/0
+func newTestTopology(ts time.Time) *model.Topology {
+	t := model.NewTopology()
+	t.Timestamp = ts
+
+	for name, role := range map[string]string{"ccr01.dus01": "ccr", "edge01.dus01": "edge"} {
+		d := t.AddDeviceIfNotExists(name)
+		d.Role = role
+		d.AddInterfaceItNotExists("et-0/0/0")
+	}
+
+	c := &model.Cable{
+		AEnd: model.CableEnd{DeviceName: "ccr01.dus01", EndpointName: "et-0/0/0", EndpointType: api.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE},
+		BEnd: model.CableEnd{DeviceName: "edge01.dus01", EndpointName: "et-0/0/0", EndpointType: api.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE},
+	}
+	t.Cables[c.String()] = c
+
+	t.ComputeLogicalLinks()
+	t.IndexIPs()
+	return t
+}
+
+// publishTestTopology publishes the topology as a build would, returning its generation
+func publishTestTopology(o *Octopus, topology *model.Topology) uint64 {
+	o.buildMu.Lock()
+	defer o.buildMu.Unlock()
+
+	o.publishTopology(o.GetTopology(), topology, nil, &validation.Report{})
+	return topology.Generation
+}
+
+func serveREST(o *Octopus, target string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	rec := httptest.NewRecorder()
+	o.RESTHandler().ServeHTTP(rec, req)
+	return rec
+}
+
+// compactJSON removes the whitespace protojson randomly adds to its output, other bodies are returned as is
+func compactJSON(body string) string {
+	buf := &bytes.Buffer{}
+	err := json.Compact(buf, []byte(body))
+	if err != nil {
+		return body
+	}
+
+	return buf.String()
+}
+
+func TestRESTNotReady(t *testing.T) {
+	o := NewOctopus(0)
+	for _, target := range []string{"/api/v1/topology", "/api/v1/devices/ccr01.dus01", "/api/v1/devices", "/api/v1/export"} {
+		rec := serveREST(o, target, nil)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code, target)
+		assert.JSONEq(t, `{"code": 14, "message": "Octopus not ready."}`, rec.Body.String(), target)
+	}
+}
+
+func TestRESTHandler(t *testing.T) {
+	o := NewOctopus(0)
+	publishTestTopology(o, newTestTopology(time.Unix(1700000000, 0)))
+	publishTestTopology(o, newTestTopology(time.Unix(1700000060, 0)))
+	etag := func(generations string) string {
+		return fmt.Sprintf(`W/"%s-%s"`, o.epoch, generations)
+	}
+
+	tests := []struct {
+		name            string
+		target          string
+		headers         map[string]string
+		expectedCode    int
+		expectedHeaders map[string]string
+		expectedBody    string
+	}{
+		{
+			name:            "device",
+			target:          "/api/v1/devices/ccr01.dus01",
+			expectedCode:    http.StatusOK,
+			expectedHeaders: map[string]string{"ETag": etag("2"), generationHeader: "2", "Content-Type": "application/json"},
+			expectedBody:    `"name":"ccr01.dus01"`,
+		},
+		{
+			name:            "device of snapshot",
+			target:          "/api/v1/devices/ccr01.dus01?generation=1",
+			expectedCode:    http.StatusOK,
+			expectedHeaders: map[string]string{"ETag": etag("1")},
+		},
+		{
+			name:            "device at time",
+			target:          "/api/v1/devices/ccr01.dus01?timestamp=1700000030",
+			expectedCode:    http.StatusOK,
+			expectedHeaders: map[string]string{"ETag": etag("1")},
+		},
+		{
+			name:         "unknown device",
+			target:       "/api/v1/devices/ccr42.dus01",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `"message":"Device not found."`,
+		},
+		{
+			name:         "unknown snapshot",
+			target:       "/api/v1/devices/ccr01.dus01?generation=42",
+			expectedCode: http.StatusNotFound,
+			expectedBody: `"message":"Snapshot not found."`,
+		},
+		{
+			name:         "generation and timestamp",
+			target:       "/api/v1/topology?generation=1&timestamp=1700000030",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `"code":3`,
+		},
+		{
+			name:         "invalid generation",
+			target:       "/api/v1/topology?generation=latest",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `Invalid generation \"latest\".`,
+		},
+		{
+			name:         "invalid bool",
+			target:       "/api/v1/topology?include_provenance=maybe",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `Invalid include_provenance \"maybe\".`,
+		},
+		{
+			name:         "matching etag",
+			target:       "/api/v1/devices/ccr01.dus01",
+			headers:      map[string]string{"If-None-Match": etag("2")},
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name:         "matching strong etag",
+			target:       "/api/v1/topology",
+			headers:      map[string]string{"If-None-Match": fmt.Sprintf(`"%s-1", "%s-2"`, o.epoch, o.epoch)},
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name:         "any etag",
+			target:       "/api/v1/devices",
+			headers:      map[string]string{"If-None-Match": `*`},
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name:            "outdated etag",
+			target:          "/api/v1/devices/ccr01.dus01",
+			headers:         map[string]string{"If-None-Match": etag("1")},
+			expectedCode:    http.StatusOK,
+			expectedHeaders: map[string]string{"ETag": etag("2")},
+		},
+		{
+			name:            "etag of another epoch",
+			target:          "/api/v1/devices/ccr01.dus01",
+			headers:         map[string]string{"If-None-Match": fmt.Sprintf(`W/"%s-2"`, newEpoch())},
+			expectedCode:    http.StatusOK,
+			expectedHeaders: map[string]string{"ETag": etag("2")},
+		},
+		{
+			name:         "etag of snapshot",
+			target:       "/api/v1/devices/ccr01.dus01?generation=1",
+			headers:      map[string]string{"If-None-Match": etag("1")},
+			expectedCode: http.StatusNotModified,
+		},
+		{
+			name:         "query devices",
+			target:       "/api/v1/devices?role=edge&fields=name",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"devices":[{"name":"edge01.dus01"}]}`,
+		},
+		{
+			name:         "query devices by name",
+			target:       "/api/v1/devices?name=ccr*&match=glob&fields=name,role",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"devices":[{"name":"ccr01.dus01","role":"ccr"}]}`,
+		},
+		{
+			name:         "unknown operator",
+			target:       "/api/v1/devices?role=edge&operator=xor",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `Unknown operator \"xor\".`,
+		},
+		{
+			name:         "unknown match type",
+			target:       "/api/v1/devices?name=ccr&match=fuzzy",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `Unknown match type \"fuzzy\".`,
+		},
+		{
+			name:         "invalid field mask",
+			target:       "/api/v1/devices?fields=colour",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `"message":"Invalid field mask."`,
+		},
+		{
+			name:         "trace path without interface",
+			target:       "/api/v1/devices/ccr01.dus01/path",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "diff",
+			target:       "/api/v1/diff?from_generation=1",
+			expectedCode: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"ETag": etag("1-2"),
+			},
+		},
+		{
+			name:         "diff without from",
+			target:       "/api/v1/diff",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "export",
+			target:       "/api/v1/export?format=dot&role=ccr",
+			expectedCode: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Content-Type":        "text/vnd.graphviz",
+				"Content-Disposition": `attachment; filename="topology-2.dot"`,
+				"ETag":                etag("2"),
+			},
+			expectedBody: `"ccr01.dus01" [label="ccr01.dus01"`,
+		},
+		{
+			name:         "export of snapshot",
+			target:       "/api/v1/export?generation=1&format=jgf",
+			expectedCode: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Content-Disposition": `attachment; filename="topology-1.json"`,
+			},
+		},
+		{
+			name:         "unknown export format",
+			target:       "/api/v1/export?format=svg",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `Unknown format \"svg\".`,
+		},
+		{
+			name:         "unknown export edges",
+			target:       "/api/v1/export?edges=wires",
+			expectedCode: http.StatusBadRequest,
+			expectedBody: `Unknown edges \"wires\".`,
+		},
+		{
+			name:         "invalid export before etag",
+			target:       "/api/v1/export?include_ports=maybe",
+			headers:      map[string]string{"If-None-Match": etag("2")},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		rec := serveREST(o, test.target, test.headers)
+		assert.Equal(t, test.expectedCode, rec.Code, test.name)
+		for k, v := range test.expectedHeaders {
+			assert.Equal(t, v, rec.Header().Get(k), "%s: header %s", test.name, k)
+		}
+
+		if test.expectedBody != "" {
+			assert.Contains(t, compactJSON(rec.Body.String()), test.expectedBody, test.name)
+		}
+	}
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		expected       bool
+	}{
+		{acceptEncoding: "", expected: false},
+		{acceptEncoding: "gzip", expected: true},
+		{acceptEncoding: "deflate, gzip", expected: true},
+		{acceptEncoding: "gzip;q=0", expected: false},
+		{acceptEncoding: "gzip; q=0.5", expected: true},
+		{acceptEncoding: "gzip;q=0.0", expected: false},
+		{acceptEncoding: "gzip;q=invalid", expected: false},
+		{acceptEncoding: "br, gzip;q=0", expected: false},
+		{acceptEncoding: "*", expected: true},
+		{acceptEncoding: "*;q=0", expected: false},
+		{acceptEncoding: "identity", expected: false},
+		{acceptEncoding: "x-gzip", expected: false},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/topology", nil)
+		req.Header.Set("Accept-Encoding", test.acceptEncoding)
+		assert.Equal(t, test.expected, acceptsGzip(req), test.acceptEncoding)
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected int
+	}{
+		{err: status.New(codes.InvalidArgument, "").Err(), expected: http.StatusBadRequest},
+		{err: status.New(codes.NotFound, "").Err(), expected: http.StatusNotFound},
+		{err: status.New(codes.FailedPrecondition, "").Err(), expected: http.StatusBadRequest},
+		{err: status.New(codes.Unavailable, "").Err(), expected: http.StatusServiceUnavailable},
+		{err: status.New(codes.Canceled, "").Err(), expected: 499},
+		{err: status.New(codes.Code(42), "").Err(), expected: http.StatusInternalServerError},
+		{err: io.EOF, expected: http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		rec := httptest.NewRecorder()
+		writeError(rec, httptest.NewRequest(http.MethodGet, "/api/v1/topology", nil), test.err)
+		assert.Equal(t, test.expected, rec.Code, test.err.Error())
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"), test.err.Error())
+	}
+}
+
+func TestWriteCompressible(t *testing.T) {
+	large := bytes.Repeat([]byte("octopus "), minGzipBytes)
+
+	tests := []struct {
+		name           string
+		body           []byte
+		acceptEncoding string
+		compressed     bool
+	}{
+		{name: "large", body: large, acceptEncoding: "gzip", compressed: true},
+		{name: "large without gzip", body: large, acceptEncoding: "", compressed: false},
+		{name: "small", body: []byte("octopus"), acceptEncoding: "gzip", compressed: false},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export", nil)
+		req.Header.Set("Accept-Encoding", test.acceptEncoding)
+		rec := httptest.NewRecorder()
+		writeCompressible(rec, req, http.StatusOK, "text/plain", test.body)
+
+		assert.Equal(t, "text/plain", rec.Header().Get("Content-Type"), test.name)
+		assert.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"), test.name)
+		if !test.compressed {
+			assert.Empty(t, rec.Header().Get("Content-Encoding"), test.name)
+			assert.Equal(t, test.body, rec.Body.Bytes(), test.name)
+			continue
+		}
+
+		assert.Equal(t, "gzip", rec.Header().Get("Content-Encoding"), test.name)
+		assert.Less(t, rec.Body.Len(), len(test.body), test.name)
+
+		r, err := gzip.NewReader(rec.Body)
+		if assert.NoError(t, err, test.name) {
+			b, err := io.ReadAll(r)
+			assert.NoError(t, err, test.name)
+			assert.Equal(t, test.body, b, test.name)
+		}
+	}
+}
+
+func TestQueryDevicesRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/devices?site=DUS01&role=ccr&role=edge&semantic_tag=NET:ASN=13335&semantic_tag=NET:ROUTER&name=ccr.*&match=regex&operator=or&fields=name,role", nil)
+	queryRequest, err := queryDevicesRequest(req.URL.Query())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	f := queryRequest.Filter
+	assert.Equal(t, []string{"DUS01"}, f.Sites)
+	assert.Equal(t, []string{"ccr", "edge"}, f.Roles)
+	assert.Equal(t, api.FilterOperator_FILTER_OPERATOR_OR, f.Operator)
+	if assert.Len(t, f.Names, 1) {
+		assert.Equal(t, "ccr.*", f.Names[0].Pattern)
+		assert.Equal(t, api.NameMatchType_NAME_MATCH_TYPE_REGEX, f.Names[0].MatchType)
+	}
+
+	if assert.Len(t, f.SemanticTags, 2) {
+		assert.Equal(t, "NET:ASN", f.SemanticTags[0].Key)
+		assert.Equal(t, "13335", f.SemanticTags[0].Value)
+		assert.Equal(t, "NET:ROUTER", f.SemanticTags[1].Key)
+		assert.Equal(t, "", f.SemanticTags[1].Value)
+	}
+
+	assert.Equal(t, []string{"name", "role"}, queryRequest.FieldMask.Paths)
+}
+
+func TestExportTopologyRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/export?format=GEXF&edges=all&include_interfaces=true&include_circuits=1&timestamp=1700000000&pop=dus01-a", nil)
+	exportRequest, err := exportTopologyRequest(req.URL.Query())
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, api.ExportFormat_EXPORT_FORMAT_GEXF, exportRequest.Format)
+	assert.Equal(t, api.ExportEdges_EXPORT_EDGES_ALL, exportRequest.Edges)
+	assert.True(t, exportRequest.IncludeInterfaces)
+	assert.False(t, exportRequest.IncludePorts)
+	assert.True(t, exportRequest.IncludeCircuits)
+	assert.Equal(t, uint64(1700000000), exportRequest.AsOf.GetTimestamp())
+	assert.Equal(t, []string{"dus01-a"}, exportRequest.Filter.Pops)
+}
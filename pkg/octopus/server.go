@@ -8,8 +8,15 @@
 package octopus
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"time"
 
+	bnet "github.com/bio-routing/bio-rd/net"
+	"github.com/cloudflare/octopus/pkg/chunk"
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/cloudflare/octopus/pkg/validation"
 	api "github.com/cloudflare/octopus/proto/octopus"
 
 	"google.golang.org/grpc/codes"
@@ -26,28 +33,497 @@ func newOctopusServer(octopus *Octopus) *ocotopusServer {
 	}
 }
 
-func (os *ocotopusServer) GetTopology(context.Context, *api.TopologyRequest) (*api.TopologyResponse, error) {
-	topology := os.octopus.GetTopology()
+func (os *ocotopusServer) GetTopology(ctx context.Context, topologyRequest *api.TopologyRequest) (*api.TopologyResponse, error) {
+	if os.octopus.GetTopology() == nil {
+		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	if _, known := api.TopologyEncoding_name[int32(topologyRequest.Encoding)]; !known {
+		return nil, status.New(codes.InvalidArgument, "Unknown encoding.").Err()
+	}
+
+	resp, err := os.octopus.getTopologyResponse(topologyRequest.AsOf, topologyRequest.KnownGeneration, topologyRequest.KnownEpoch, topologyRequest.IncludeProvenance, topologyRequest.Encoding)
+	if err != nil {
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+
+	if resp == nil {
+		return nil, status.New(codes.NotFound, "Snapshot not found.").Err()
+	}
+
+	return resp, nil
+}
+
+func (os *ocotopusServer) GetDevice(context context.Context, deviceRequest *api.DeviceRequest) (*api.DeviceResponse, error) {
+	if deviceRequest == nil {
+		return nil, status.New(codes.InvalidArgument, "No device_name provided.").Err()
+	}
+
+	topology, err := os.selectTopology(deviceRequest.AsOf)
+	if err != nil {
+		return nil, err
+	}
+
+	return deviceResponse(topology, deviceRequest), nil
+}
+
+// selectTopology returns the snapshot matching the selector, the current topology if it is nil,
+// or the error to answer with if there is none
+func (os *ocotopusServer) selectTopology(sel *api.SnapshotSelector) (*model.Topology, error) {
+	if os.octopus.GetTopology() == nil {
+		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	topology := os.octopus.selectSnapshot(sel)
 	if topology == nil {
+		return nil, status.New(codes.NotFound, "Snapshot not found.").Err()
+	}
+
+	return topology, nil
+}
+
+func deviceResponse(topology *model.Topology, deviceRequest *api.DeviceRequest) *api.DeviceResponse {
+	if deviceRequest.IncludeProvenance {
+		return &api.DeviceResponse{
+			Device: topology.GetDevice(deviceRequest.DeviceName).ToProtoWithProvenance(),
+		}
+	}
+
+	return &api.DeviceResponse{
+		Device: topology.GetDevice(deviceRequest.DeviceName).ToProto(),
+	}
+}
+
+func (os *ocotopusServer) WatchTopology(watchRequest *api.WatchTopologyRequest, stream api.OctopusService_WatchTopologyServer) error {
+	if os.octopus.GetTopology() == nil {
+		return status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	w := os.octopus.watchers.subscribe()
+	defer os.octopus.watchers.unsubscribe(w)
+
+	generation, err := os.sendTopologyUpdates(stream, watchRequest.Generation, watchRequest.Epoch)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-w.notify:
+		}
+
+		generation, err = os.sendTopologyUpdates(stream, generation, os.octopus.epoch)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// sendTopologyUpdates sends all changes after the given generation of the given epoch to the client, or a full snapshot if those are unknown.
+// It returns the generation the client is at afterwards.
+func (os *ocotopusServer) sendTopologyUpdates(stream api.OctopusService_WatchTopologyServer, generation uint64, epoch string) (uint64, error) {
+	topology, changes, ok := os.octopus.changesSince(generation, epoch)
+	if !ok {
+		protoTopology, err := os.octopus.topologyProto(topology, false)
+		if err != nil {
+			return generation, status.New(codes.Internal, err.Error()).Err()
+		}
+
+		err = stream.Send(&api.WatchTopologyResponse{
+			Generation: protoTopology.Generation,
+			Timestamp:  protoTopology.Timestamp,
+			Epoch:      os.octopus.epoch,
+			Update: &api.WatchTopologyResponse_Snapshot{
+				Snapshot: protoTopology,
+			},
+		})
+		if err != nil {
+			return generation, err
+		}
+
+		return protoTopology.Generation, nil
+	}
+
+	for _, cs := range changes {
+		err := stream.Send(&api.WatchTopologyResponse{
+			Generation: cs.generation,
+			Timestamp:  cs.timestamp,
+			Epoch:      os.octopus.epoch,
+			Update: &api.WatchTopologyResponse_Changes{
+				Changes: &api.TopologyChanges{
+					Events: cs.events,
+				},
+			},
+		})
+		if err != nil {
+			return generation, err
+		}
+
+		generation = cs.generation
+	}
+
+	return generation, nil
+}
+
+func (os *ocotopusServer) DiffTopology(ctx context.Context, diffRequest *api.DiffTopologyRequest) (*api.DiffTopologyResponse, error) {
+	if os.octopus.GetTopology() == nil {
 		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
 	}
 
-	return &api.TopologyResponse{
-		Topology: topology.ToProto(),
+	if diffRequest.From == nil {
+		return nil, status.New(codes.InvalidArgument, "No from snapshot provided.").Err()
+	}
+
+	from := os.octopus.selectSnapshot(diffRequest.From)
+	if from == nil {
+		return nil, status.New(codes.NotFound, "From snapshot not found.").Err()
+	}
+
+	to := os.octopus.selectSnapshot(diffRequest.To)
+	if to == nil {
+		return nil, status.New(codes.NotFound, "To snapshot not found.").Err()
+	}
+
+	return &api.DiffTopologyResponse{
+		FromGeneration: from.Generation,
+		ToGeneration:   to.Generation,
+		Diff:           model.Diff(from, to).ToProto(),
 	}, nil
 }
 
-func (os *ocotopusServer) GetDevice(context context.Context, deviceRequest *api.DeviceRequest) (*api.DeviceResponse, error) {
+func (os *ocotopusServer) QueryDevices(ctx context.Context, queryRequest *api.QueryDevicesRequest) (*api.QueryDevicesResponse, error) {
 	topology := os.octopus.GetTopology()
 	if topology == nil {
 		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
 	}
 
-	if deviceRequest == nil {
-		return nil, status.New(codes.InvalidArgument, "No device_name provided.").Err()
+	if queryRequest.FieldMask != nil && !queryRequest.FieldMask.IsValid(&api.Device{}) {
+		return nil, status.New(codes.InvalidArgument, "Invalid field mask.").Err()
 	}
 
-	return &api.DeviceResponse{
-		Device: topology.GetDevice(deviceRequest.DeviceName).ToProto(),
+	filter, err := model.DeviceFilterFromProto(queryRequest.Filter)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	resp := &api.QueryDevicesResponse{
+		Devices: make([]*api.Device, 0),
+	}
+
+	for _, d := range topology.FindDevices(filter) {
+		protoDev := d.ToProto()
+		applyFieldMask(protoDev, queryRequest.FieldMask)
+		resp.Devices = append(resp.Devices, protoDev)
+	}
+
+	return resp, nil
+}
+
+func (os *ocotopusServer) TracePath(ctx context.Context, traceRequest *api.TracePathRequest) (*api.TracePathResponse, error) {
+	topology := os.octopus.GetTopology()
+	if topology == nil {
+		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	err := topology.DeviceAndInterfaceExists(traceRequest.DeviceName, traceRequest.InterfaceName)
+	if err != nil {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	}
+
+	p, err := topology.TracePath(traceRequest.DeviceName, traceRequest.InterfaceName)
+	if err != nil {
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+
+	return p.ToProto(), nil
+}
+
+func (os *ocotopusServer) GetNeighbors(ctx context.Context, neighborsRequest *api.GetNeighborsRequest) (*api.GetNeighborsResponse, error) {
+	topology := os.octopus.GetTopology()
+	if topology == nil {
+		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	neighbors, err := topology.GetNeighbors(neighborsRequest.DeviceName, neighborsRequest.InterfaceName)
+	if err != nil {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	}
+
+	resp := &api.GetNeighborsResponse{
+		Neighbors: make([]*api.Neighbor, 0, len(neighbors)),
+	}
+
+	for _, n := range neighbors {
+		resp.Neighbors = append(resp.Neighbors, n.ToProto())
+	}
+
+	return resp, nil
+}
+
+func (os *ocotopusServer) LookupIP(ctx context.Context, lookupRequest *api.LookupIPRequest) (*api.LookupIPResponse, error) {
+	topology := os.octopus.GetTopology()
+	if topology == nil {
+		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	addr, err := bnet.IPFromString(lookupRequest.Address)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	res, err := topology.LookupIP(addr)
+	if err != nil {
+		return nil, status.New(codes.Internal, err.Error()).Err()
+	}
+
+	return res.ToLookupIPResponse(), nil
+}
+
+func (os *ocotopusServer) LookupPrefix(ctx context.Context, lookupRequest *api.LookupPrefixRequest) (*api.LookupPrefixResponse, error) {
+	topology := os.octopus.GetTopology()
+	if topology == nil {
+		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	pfx, err := bnet.PrefixFromString(lookupRequest.Prefix)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	res, err := topology.LookupPrefix(*pfx)
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	return res.ToLookupPrefixResponse(), nil
+}
+
+func (os *ocotopusServer) ListSnapshots(ctx context.Context, listRequest *api.ListSnapshotsRequest) (*api.ListSnapshotsResponse, error) {
+	return &api.ListSnapshotsResponse{
+		Snapshots: os.octopus.ListSnapshots(),
+	}, nil
+}
+
+func (os *ocotopusServer) GetMergeConflicts(ctx context.Context, conflictsRequest *api.GetMergeConflictsRequest) (*api.GetMergeConflictsResponse, error) {
+	resp := &api.GetMergeConflictsResponse{
+		Conflicts: make([]*api.MergeConflict, 0),
+		Blocking:  make([]*api.MergeConflict, 0),
+	}
+
+	// Conflicts blocking the build are reported even if no topology has been published yet
+	for _, mc := range os.octopus.GetBlockingConflicts() {
+		resp.Blocking = append(resp.Blocking, mc.ToProto())
+	}
+
+	if os.octopus.GetTopology() == nil {
+		if len(resp.Blocking) > 0 {
+			return resp, nil
+		}
+
+		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	topology := os.octopus.selectSnapshot(conflictsRequest.AsOf)
+	if topology == nil {
+		return nil, status.New(codes.NotFound, "Snapshot not found.").Err()
+	}
+
+	resp.Generation = topology.Generation
+	for _, mc := range topology.MergeConflicts {
+		resp.Conflicts = append(resp.Conflicts, mc.ToProto())
+	}
+
+	return resp, nil
+}
+
+func (os *ocotopusServer) GetValidationReport(ctx context.Context, reportRequest *api.GetValidationReportRequest) (*api.GetValidationReportResponse, error) {
+	report := os.octopus.GetValidationReport()
+	if report == nil {
+		return nil, status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	return &api.GetValidationReportResponse{
+		Report: report.ToProto(validation.Severity(reportRequest.MinSeverity)),
 	}, nil
 }
+
+func (os *ocotopusServer) GetShrinkage(ctx context.Context, shrinkageRequest *api.GetShrinkageRequest) (*api.GetShrinkageResponse, error) {
+	shrinkage, blocked := os.octopus.GetShrinkage()
+	resp := &api.GetShrinkageResponse{
+		Shrinkage: make([]*api.TopologyShrinkage, 0, len(shrinkage)),
+		Blocked:   blocked,
+	}
+
+	topology := os.octopus.GetBlockedTopology()
+	if topology != nil {
+		resp.Timestamp = uint64(topology.Timestamp.Unix())
+	}
+
+	for _, s := range shrinkage {
+		resp.Shrinkage = append(resp.Shrinkage, s.ToProto())
+	}
+
+	return resp, nil
+}
+
+func (os *ocotopusServer) ForceAcceptTopology(ctx context.Context, acceptRequest *api.ForceAcceptTopologyRequest) (*api.ForceAcceptTopologyResponse, error) {
+	if acceptRequest.Reason == "" {
+		return nil, status.New(codes.InvalidArgument, "No reason provided.").Err()
+	}
+
+	if acceptRequest.Timestamp == 0 {
+		return nil, status.New(codes.InvalidArgument, "No timestamp of the blocked topology provided.").Err()
+	}
+
+	_, blocked := os.octopus.GetShrinkage()
+	if !blocked {
+		return nil, status.New(codes.FailedPrecondition, "No topology blocked by its shrinkage.").Err()
+	}
+
+	topology, accepted, err := os.octopus.ForceAcceptTopology(acceptRequest.Reason, time.Unix(int64(acceptRequest.Timestamp), 0))
+	if err != nil {
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	}
+
+	resp := &api.ForceAcceptTopologyResponse{
+		Generation: topology.Generation,
+		Accepted:   make([]*api.TopologyShrinkage, 0, len(accepted)),
+	}
+
+	for _, s := range accepted {
+		resp.Accepted = append(resp.Accepted, s.ToProto())
+	}
+
+	return resp, nil
+}
+
+func (os *ocotopusServer) StreamTopology(streamRequest *api.StreamTopologyRequest, stream api.OctopusService_StreamTopologyServer) error {
+	if os.octopus.GetTopology() == nil {
+		return status.New(codes.Unavailable, "Octopus not ready.").Err()
+	}
+
+	// All chunks are taken from this snapshot, regardless of rebuilds while streaming
+	topology := os.octopus.selectSnapshot(streamRequest.AsOf)
+	if topology == nil {
+		return status.New(codes.NotFound, "Snapshot not found.").Err()
+	}
+
+	pt, err := os.octopus.topologyProto(topology, streamRequest.IncludeProvenance)
+	if err != nil {
+		return status.New(codes.Internal, err.Error()).Err()
+	}
+
+	for _, c := range chunk.Split(pt, chunkBytes(streamRequest.MaxChunkBytes)) {
+		err := stream.Send(c)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (os *ocotopusServer) ExportTopology(exportRequest *api.ExportTopologyRequest, stream api.OctopusService_ExportTopologyServer) error {
+	format, opts, err := exportOptions(exportRequest)
+	if err != nil {
+		return err
+	}
+
+	topology, err := os.selectTopology(exportRequest.AsOf)
+	if err != nil {
+		return err
+	}
+
+	w := &exportWriter{
+		send:          stream.Send,
+		maxChunkBytes: chunkBytes(exportRequest.MaxChunkBytes),
+		first: &api.ExportTopologyResponse{
+			Generation:  topology.Generation,
+			ContentType: format.ContentType(),
+			FileName:    exportFileName(topology, format),
+		},
+	}
+
+	err = topology.Export(w, format, opts)
+	if err != nil {
+		return status.New(codes.Internal, err.Error()).Err()
+	}
+
+	return w.flush()
+}
+
+// exportOptions returns the format and the options of the export request
+func exportOptions(exportRequest *api.ExportTopologyRequest) (model.ExportFormat, *model.ExportOptions, error) {
+	format, err := model.ExportFormatFromProto(exportRequest.Format)
+	if err != nil {
+		return format, nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	opts, err := model.ExportOptionsFromProto(exportRequest)
+	if err != nil {
+		return format, nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	return format, opts, nil
+}
+
+// chunkBytes returns the size of chunks requested by the client, bounded by maxChunkBytesLimit
+func chunkBytes(requested uint32) int {
+	if requested == 0 {
+		return defaultChunkBytes
+	}
+
+	return min(int(requested), maxChunkBytesLimit)
+}
+
+// exportWriter sends the written export in chunks of maxChunkBytes, so it never has to fit into a single message
+type exportWriter struct {
+	send          func(*api.ExportTopologyResponse) error
+	maxChunkBytes int
+	// Carries the metadata of the export, sent along with the first data
+	first *api.ExportTopologyResponse
+	buf   []byte
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= w.maxChunkBytes {
+		err := w.sendChunk(w.buf[:w.maxChunkBytes])
+		if err != nil {
+			return 0, err
+		}
+
+		w.buf = w.buf[w.maxChunkBytes:]
+	}
+
+	return len(p), nil
+}
+
+// flush sends the remaining data. The first chunk is always sent, even if the export is empty.
+func (w *exportWriter) flush() error {
+	if len(w.buf) == 0 && w.first == nil {
+		return nil
+	}
+
+	err := w.sendChunk(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *exportWriter) sendChunk(data []byte) error {
+	resp := w.first
+	w.first = nil
+	if resp == nil {
+		resp = &api.ExportTopologyResponse{}
+	}
+
+	// The buffer is appended to afterwards, so the chunk gets its own copy
+	resp.Data = bytes.Clone(data)
+	return w.send(resp)
+}
+
+func exportFileName(topology *model.Topology, format model.ExportFormat) string {
+	return fmt.Sprintf("topology-%d.%s", topology.Generation, format.Extension())
+}
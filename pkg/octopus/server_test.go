@@ -0,0 +1,101 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	api "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportWriter(t *testing.T) {
+	tests := []struct {
+		name           string
+		writes         []string
+		maxChunkBytes  int
+		expectedChunks []string
+	}{
+		{
+			name:           "empty",
+			maxChunkBytes:  4,
+			expectedChunks: []string{""},
+		},
+		{
+			name:           "single chunk",
+			writes:         []string{"ab", "c"},
+			maxChunkBytes:  4,
+			expectedChunks: []string{"abc"},
+		},
+		{
+			name:           "exact chunks",
+			writes:         []string{"abcd", "efgh"},
+			maxChunkBytes:  4,
+			expectedChunks: []string{"abcd", "efgh"},
+		},
+		{
+			name:           "write spanning chunks",
+			writes:         []string{"a", "bcdefghij", "k"},
+			maxChunkBytes:  4,
+			expectedChunks: []string{"abcd", "efgh", "ijk"},
+		},
+	}
+
+	for _, test := range tests {
+		chunks := []*api.ExportTopologyResponse{}
+		w := &exportWriter{
+			send: func(resp *api.ExportTopologyResponse) error {
+				chunks = append(chunks, resp)
+				return nil
+			},
+			maxChunkBytes: test.maxChunkBytes,
+			first:         &api.ExportTopologyResponse{Generation: 42, FileName: "topology-42.dot"},
+		}
+
+		for _, s := range test.writes {
+			n, err := w.Write([]byte(s))
+			assert.NoError(t, err, test.name)
+			assert.Equal(t, len(s), n, test.name)
+		}
+		assert.NoError(t, w.flush(), test.name)
+
+		data := []string{}
+		for i, c := range chunks {
+			data = append(data, string(c.Data))
+			if i == 0 {
+				assert.Equal(t, uint64(42), c.Generation, test.name)
+				assert.Equal(t, "topology-42.dot", c.FileName, test.name)
+			} else {
+				assert.Zero(t, c.Generation, test.name)
+				assert.Empty(t, c.FileName, test.name)
+			}
+		}
+		assert.Equal(t, test.expectedChunks, data, test.name)
+	}
+}
+
+func TestExportWriterSendError(t *testing.T) {
+	w := &exportWriter{
+		send: func(resp *api.ExportTopologyResponse) error {
+			return errors.New("stream closed")
+		},
+		maxChunkBytes: 4,
+		first:         &api.ExportTopologyResponse{},
+	}
+
+	_, err := w.Write(bytes.Repeat([]byte("a"), 8))
+	assert.Error(t, err)
+}
+
+func TestChunkBytes(t *testing.T) {
+	assert.Equal(t, defaultChunkBytes, chunkBytes(0))
+	assert.Equal(t, 1024, chunkBytes(1024))
+	assert.Equal(t, maxChunkBytesLimit, chunkBytes(1<<30))
+}
@@ -0,0 +1,223 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/cloudflare/octopus/pkg/validation"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// itemTypes are the types of items counted in a topology, in the order they are reported
+var itemTypes = []string{"sites", "pops", "colos", "devices", "interfaces", "cables", "circuits", "prefixes"}
+
+func itemCounts(t *model.Topology) map[string]int {
+	// t.Interfaces only indexes NetBox interfaces by their ID and is not restored at warm start, so count those of the devices
+	interfaces := 0
+	for _, d := range t.Nodes {
+		interfaces += len(d.Interfaces)
+	}
+
+	return map[string]int{
+		"sites":      len(t.Sites),
+		"pops":       len(t.Pops),
+		"colos":      len(t.Colos),
+		"devices":    len(t.Nodes),
+		"interfaces": interfaces,
+		"cables":     len(t.Cables),
+		"circuits":   len(t.Circuits),
+		"prefixes":   len(t.Prefixes),
+	}
+}
+
+// ShrinkageLimit is how many items of a type may disappear between two topologies. Zero values are not checked.
+type ShrinkageLimit struct {
+	MaxDropCount   int
+	MaxDropPercent float64
+}
+
+// Shrinkage is an item type which dropped beyond its limit
+type Shrinkage struct {
+	ItemType string
+	Previous int
+	Current  int
+	// The limit exceeded, e.g. "10%" or "100"
+	Limit string
+}
+
+func (s *Shrinkage) String() string {
+	return fmt.Sprintf("%s dropped from %d to %d (limit %s)", s.ItemType, s.Previous, s.Current, s.Limit)
+}
+
+func (s *Shrinkage) ToProto() *octopuspb.TopologyShrinkage {
+	return &octopuspb.TopologyShrinkage{
+		ItemType:      s.ItemType,
+		PreviousCount: uint64(s.Previous),
+		CurrentCount:  uint64(s.Current),
+		Limit:         s.Limit,
+	}
+}
+
+// SetShrinkageLimits sets the limits by item type, "*" applying to all types without a limit of their own.
+// A topology exceeding any limit compared to the current one is not published unless force accepted.
+// It has to be called before Start.
+func (o *Octopus) SetShrinkageLimits(limits map[string]ShrinkageLimit) {
+	o.shrinkageLimits = limits
+}
+
+// ParseShrinkageLimits parses a comma separated list of item=limit pairs, limit being a number of items or a percentage,
+// e.g. "devices=10%,devices=50,*=50%". Both a count and a percentage may be given for the same item type.
+func ParseShrinkageLimits(s string) (map[string]ShrinkageLimit, error) {
+	ret := make(map[string]ShrinkageLimit)
+	if s == "" {
+		return ret, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		itemType, value, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || (itemType != "*" && !slices.Contains(itemTypes, itemType)) {
+			return nil, fmt.Errorf("invalid shrinkage limit %q, expected item=limit with item being * or one of %s", pair, strings.Join(itemTypes, ", "))
+		}
+
+		limit := ret[itemType]
+		if percent, isPercent := strings.CutSuffix(value, "%"); isPercent {
+			p, err := strconv.ParseFloat(percent, 64)
+			if err != nil || p <= 0 || p > 100 {
+				return nil, fmt.Errorf("invalid shrinkage limit %q, expected a percentage greater than 0 and up to 100", pair)
+			}
+
+			limit.MaxDropPercent = p
+		} else {
+			c, err := strconv.Atoi(value)
+			if err != nil || c <= 0 {
+				return nil, fmt.Errorf("invalid shrinkage limit %q, expected a positive number of items", pair)
+			}
+
+			limit.MaxDropCount = c
+		}
+
+		ret[itemType] = limit
+	}
+
+	return ret, nil
+}
+
+func (o *Octopus) shrinkageLimit(itemType string) ShrinkageLimit {
+	limit, exists := o.shrinkageLimits[itemType]
+	if !exists {
+		return o.shrinkageLimits["*"]
+	}
+
+	return limit
+}
+
+// checkShrinkage returns the item types of the topology which dropped beyond their limits compared to the previous topology
+func (o *Octopus) checkShrinkage(previous *model.Topology, topology *model.Topology) []*Shrinkage {
+	ret := make([]*Shrinkage, 0)
+	if previous == nil {
+		return ret
+	}
+
+	previousCounts := itemCounts(previous)
+	counts := itemCounts(topology)
+
+	for _, itemType := range itemTypes {
+		drop := previousCounts[itemType] - counts[itemType]
+		if drop <= 0 {
+			continue
+		}
+
+		limit := o.shrinkageLimit(itemType)
+		exceeded := ""
+		if limit.MaxDropCount > 0 && drop > limit.MaxDropCount {
+			exceeded = strconv.Itoa(limit.MaxDropCount)
+		} else if limit.MaxDropPercent > 0 && float64(drop)*100 > limit.MaxDropPercent*float64(previousCounts[itemType]) {
+			exceeded = strconv.FormatFloat(limit.MaxDropPercent, 'f', -1, 64) + "%"
+		}
+
+		if exceeded == "" {
+			continue
+		}
+
+		ret = append(ret, &Shrinkage{
+			ItemType: itemType,
+			Previous: previousCounts[itemType],
+			Current:  counts[itemType],
+			Limit:    exceeded,
+		})
+	}
+
+	return ret
+}
+
+// blockedBuild is a topology which has not been published because of its shrinkage.
+// It is kept until the next build, so exactly the topology the shrinkage has been reported for can be force accepted.
+type blockedBuild struct {
+	topology *model.Topology
+	states   []connectorState
+	report   *validation.Report
+}
+
+// GetShrinkage returns the item types which dropped beyond their limits in the last topology build and whether it has been blocked
+func (o *Octopus) GetShrinkage() ([]*Shrinkage, bool) {
+	o.topologyMu.RLock()
+	defer o.topologyMu.RUnlock()
+
+	return o.shrinkage, o.blocked != nil
+}
+
+// GetBlockedTopology returns the topology blocked by its shrinkage, nil if the last build has not been blocked
+func (o *Octopus) GetBlockedTopology() *model.Topology {
+	o.topologyMu.RLock()
+	defer o.topologyMu.RUnlock()
+
+	if o.blocked == nil {
+		return nil
+	}
+
+	return o.blocked.topology
+}
+
+// ForceAcceptTopology publishes the topology blocked by its shrinkage, which has been built at the given time.
+// It fails if there is no blocked topology or another build has been blocked since, as that may have shrunk even further.
+func (o *Octopus) ForceAcceptTopology(reason string, timestamp time.Time) (*model.Topology, []*Shrinkage, error) {
+	o.buildMu.Lock()
+	defer o.buildMu.Unlock()
+
+	o.topologyMu.Lock()
+	blocked := o.blocked
+	accepted := o.shrinkage
+	if blocked == nil {
+		o.topologyMu.Unlock()
+		return nil, nil, fmt.Errorf("no topology has been blocked by its shrinkage")
+	}
+
+	if blocked.topology.Timestamp.Unix() != timestamp.Unix() {
+		o.topologyMu.Unlock()
+		return nil, nil, fmt.Errorf("the topology blocked has been built at %s, not at %s", blocked.topology.Timestamp.Format(time.RFC3339), timestamp.Format(time.RFC3339))
+	}
+
+	o.blocked = nil
+	previous := o.topology
+	o.topologyMu.Unlock()
+
+	o.publishTopology(previous, blocked.topology, blocked.states, blocked.report)
+	for _, s := range accepted {
+		log.Warnf("Force accepted topology generation %d: %s (reason: %s)", blocked.topology.Generation, s, reason)
+	}
+
+	return blocked.topology, accepted, nil
+}
@@ -0,0 +1,318 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/connector"
+	"github.com/cloudflare/octopus/pkg/model"
+	"github.com/cloudflare/octopus/pkg/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTopologyWithDevices creates a topology with the given number of devices and no other items
+func newTopologyWithDevices(n int, ts time.Time) *model.Topology {
+	t := model.NewTopology()
+	t.Timestamp = ts
+	for i := 0; i < n; i++ {
+		t.AddDeviceIfNotExists(fmt.Sprintf("ccr%02d.dus01", i))
+	}
+
+	return t
+}
+
+func TestParseShrinkageLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantFail bool
+		expected map[string]ShrinkageLimit
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: map[string]ShrinkageLimit{},
+		},
+		{
+			name:  "count",
+			input: "devices=10",
+			expected: map[string]ShrinkageLimit{
+				"devices": {MaxDropCount: 10},
+			},
+		},
+		{
+			name:  "percentage",
+			input: "interfaces=12.5%",
+			expected: map[string]ShrinkageLimit{
+				"interfaces": {MaxDropPercent: 12.5},
+			},
+		},
+		{
+			name:  "wildcard",
+			input: "*=50%, cables=100",
+			expected: map[string]ShrinkageLimit{
+				"*":      {MaxDropPercent: 50},
+				"cables": {MaxDropCount: 100},
+			},
+		},
+		{
+			name:  "count and percentage",
+			input: "devices=10%,devices=50",
+			expected: map[string]ShrinkageLimit{
+				"devices": {MaxDropCount: 50, MaxDropPercent: 10},
+			},
+		},
+		{
+			name:  "duplicate",
+			input: "devices=10,devices=20",
+			expected: map[string]ShrinkageLimit{
+				"devices": {MaxDropCount: 20},
+			},
+		},
+		{
+			name:     "unknown item type",
+			input:    "routers=10",
+			wantFail: true,
+		},
+		{
+			name:     "missing limit",
+			input:    "devices",
+			wantFail: true,
+		},
+		{
+			name:     "invalid count",
+			input:    "devices=ten",
+			wantFail: true,
+		},
+		{
+			name:     "zero count",
+			input:    "devices=0",
+			wantFail: true,
+		},
+		{
+			name:     "negative count",
+			input:    "devices=-1",
+			wantFail: true,
+		},
+		{
+			name:     "percentage above 100",
+			input:    "devices=101%",
+			wantFail: true,
+		},
+		{
+			name:     "zero percentage",
+			input:    "devices=0%",
+			wantFail: true,
+		},
+	}
+
+	for _, test := range tests {
+		limits, err := ParseShrinkageLimits(test.input)
+		if test.wantFail {
+			assert.Error(t, err, test.name)
+			continue
+		}
+
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.expected, limits, test.name)
+	}
+}
+
+func TestCheckShrinkage(t *testing.T) {
+	ts := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name     string
+		limits   map[string]ShrinkageLimit
+		previous *model.Topology
+		current  *model.Topology
+		expected []*Shrinkage
+	}{
+		{
+			name:     "no previous topology",
+			limits:   map[string]ShrinkageLimit{"*": {MaxDropCount: 1}},
+			current:  newTopologyWithDevices(0, ts),
+			expected: []*Shrinkage{},
+		},
+		{
+			name:     "zero previous count",
+			limits:   map[string]ShrinkageLimit{"*": {MaxDropPercent: 10}},
+			previous: newTopologyWithDevices(0, ts),
+			current:  newTopologyWithDevices(0, ts),
+			expected: []*Shrinkage{},
+		},
+		{
+			name:     "grown",
+			limits:   map[string]ShrinkageLimit{"*": {MaxDropCount: 1}},
+			previous: newTopologyWithDevices(2, ts),
+			current:  newTopologyWithDevices(10, ts),
+			expected: []*Shrinkage{},
+		},
+		{
+			name:     "count at the limit",
+			limits:   map[string]ShrinkageLimit{"devices": {MaxDropCount: 2}},
+			previous: newTopologyWithDevices(10, ts),
+			current:  newTopologyWithDevices(8, ts),
+			expected: []*Shrinkage{},
+		},
+		{
+			name:     "count over the limit",
+			limits:   map[string]ShrinkageLimit{"devices": {MaxDropCount: 2}},
+			previous: newTopologyWithDevices(10, ts),
+			current:  newTopologyWithDevices(7, ts),
+			expected: []*Shrinkage{
+				{ItemType: "devices", Previous: 10, Current: 7, Limit: "2"},
+			},
+		},
+		{
+			name:     "percentage at the limit",
+			limits:   map[string]ShrinkageLimit{"*": {MaxDropPercent: 50}},
+			previous: newTopologyWithDevices(10, ts),
+			current:  newTopologyWithDevices(5, ts),
+			expected: []*Shrinkage{},
+		},
+		{
+			name:     "percentage over the limit",
+			limits:   map[string]ShrinkageLimit{"*": {MaxDropPercent: 50}},
+			previous: newTopologyWithDevices(10, ts),
+			current:  newTopologyWithDevices(4, ts),
+			expected: []*Shrinkage{
+				{ItemType: "devices", Previous: 10, Current: 4, Limit: "50%"},
+			},
+		},
+		{
+			name:     "dropped to zero",
+			limits:   map[string]ShrinkageLimit{"*": {MaxDropPercent: 50}},
+			previous: newTopologyWithDevices(1, ts),
+			current:  newTopologyWithDevices(0, ts),
+			expected: []*Shrinkage{
+				{ItemType: "devices", Previous: 1, Current: 0, Limit: "50%"},
+			},
+		},
+		{
+			name:     "own limit overrides wildcard",
+			limits:   map[string]ShrinkageLimit{"*": {MaxDropCount: 1}, "devices": {MaxDropCount: 5}},
+			previous: newTopologyWithDevices(10, ts),
+			current:  newTopologyWithDevices(6, ts),
+			expected: []*Shrinkage{},
+		},
+		{
+			name:     "no limits",
+			limits:   map[string]ShrinkageLimit{},
+			previous: newTopologyWithDevices(10, ts),
+			current:  newTopologyWithDevices(0, ts),
+			expected: []*Shrinkage{},
+		},
+	}
+
+	for _, test := range tests {
+		o := NewOctopus(0)
+		o.SetShrinkageLimits(test.limits)
+		assert.Equal(t, test.expected, o.checkShrinkage(test.previous, test.current), test.name)
+	}
+}
+
+func TestForceAcceptTopology(t *testing.T) {
+	previous := newTopologyWithDevices(10, time.Unix(1700000000, 0))
+	previous.Generation = 1
+	blocked := newTopologyWithDevices(2, time.Unix(1700000060, 0))
+
+	o := NewOctopus(0)
+	o.SetShrinkageLimits(map[string]ShrinkageLimit{"*": {MaxDropPercent: 50}})
+
+	_, _, err := o.ForceAcceptTopology("decommissioned", blocked.Timestamp)
+	assert.Error(t, err, "nothing blocked")
+
+	o.topology = previous
+	o.shrinkage = o.checkShrinkage(previous, blocked)
+	o.blocked = &blockedBuild{
+		topology: blocked,
+		report:   &validation.Report{},
+	}
+
+	_, _, err = o.ForceAcceptTopology("decommissioned", time.Unix(1700000120, 0))
+	assert.Error(t, err, "other topology blocked")
+	assert.Same(t, previous, o.GetTopology())
+
+	topology, accepted, err := o.ForceAcceptTopology("decommissioned", blocked.Timestamp)
+	assert.NoError(t, err)
+	assert.Same(t, blocked, topology)
+	assert.Same(t, blocked, o.GetTopology())
+	assert.Equal(t, uint64(2), topology.Generation)
+	assert.Equal(t, []*Shrinkage{{ItemType: "devices", Previous: 10, Current: 2, Limit: "50%"}}, accepted)
+
+	_, isBlocked := o.GetShrinkage()
+	assert.False(t, isBlocked)
+	assert.Nil(t, o.GetBlockedTopology())
+}
+
+// testConnector adds its devices to the topology, generation has to be increased whenever they change
+type testConnector struct {
+	devices    int
+	generation uint64
+}
+
+func (c *testConnector) GetName() string                { return "Test" }
+func (c *testConnector) InitialLoad() error             { return nil }
+func (c *testConnector) Healthy() bool                  { return true }
+func (c *testConnector) StartRefreshRoutine()           {}
+func (c *testConnector) GetLoadDuration() time.Duration { return 0 }
+func (c *testConnector) GetLoadTime() time.Time         { return time.Unix(1700000000, 0) }
+func (c *testConnector) GetUpdateErrorCount() uint64    { return 0 }
+func (c *testConnector) Changes() <-chan struct{}       { return nil }
+func (c *testConnector) GetDataGeneration() uint64      { return c.generation }
+func (c *testConnector) EnrichTopology(t *model.Topology) error {
+	for i := 0; i < c.devices; i++ {
+		t.AddDeviceIfNotExists(fmt.Sprintf("ccr%02d.dus01", i))
+	}
+
+	return nil
+}
+
+func TestUpdateTopologyBlocked(t *testing.T) {
+	c := &testConnector{devices: 10, generation: 1}
+	o := NewOctopus(0)
+	o.SetShrinkageLimits(map[string]ShrinkageLimit{"*": {MaxDropPercent: 50}})
+	assert.NoError(t, o.Init([]connector.Connector{c}))
+	assert.NoError(t, o.UpdateTopology())
+
+	c.devices = 2
+	c.generation++
+	assert.Error(t, o.UpdateTopology(), "shrank")
+	blocked := o.GetBlockedTopology()
+	assert.NotNil(t, blocked)
+
+	// The blocked topology is kept until the connector has new data, so it can still be accepted
+	assert.Error(t, o.UpdateTopology(), "still blocked")
+	assert.Same(t, blocked, o.GetBlockedTopology())
+
+	c.generation++
+	assert.Error(t, o.UpdateTopology(), "shrank again")
+	assert.NotSame(t, blocked, o.GetBlockedTopology())
+
+	blocked = o.GetBlockedTopology()
+	_, _, err := o.ForceAcceptTopology("decommissioned", blocked.Timestamp)
+	assert.NoError(t, err)
+	assert.Same(t, blocked, o.GetTopology())
+	assert.NoError(t, o.UpdateTopology(), "built from the accepted data")
+}
+
+func TestItemCountsRestored(t *testing.T) {
+	topology := newTestTopology(time.Unix(1700000000, 0))
+	counts := itemCounts(topology)
+	assert.Equal(t, 2, counts["devices"])
+	assert.Equal(t, 2, counts["interfaces"])
+
+	// Interfaces are counted the same in a topology restored at warm start
+	restored, err := model.TopologyFromProto(topology.ToProtoWithProvenance())
+	assert.NoError(t, err)
+	assert.Equal(t, counts, itemCounts(restored))
+}
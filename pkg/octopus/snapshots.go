@@ -0,0 +1,241 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"slices"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Default size (of the serialized topologies) of the snapshots we keep in memory
+const defaultHistoryBudget = 256 << 20
+
+// snapshot is an entry of the topology history. Once evicted from memory, it can only be loaded from disk if it has been persisted.
+type snapshot struct {
+	generation uint64
+	timestamp  time.Time
+	// nil for snapshots found at warm start until they are loaded from disk
+	connectorLoadTimes []*octopuspb.ConnectorLoadTime
+	size               int
+
+	// nil once evicted from memory
+	topology *model.Topology
+	// GetTopology responses, nil once evicted from memory
+	cache *topologyCache
+	// Set if persisted to disk
+	path string
+}
+
+func (s *snapshot) ToProto() *octopuspb.SnapshotInfo {
+	return &octopuspb.SnapshotInfo{
+		Generation:         s.generation,
+		Timestamp:          uint64(s.timestamp.Unix()),
+		ConnectorLoadTimes: s.connectorLoadTimes,
+		SizeBytes:          uint64(s.size),
+		InMemory:           s.topology != nil,
+		Persisted:          s.path != "",
+	}
+}
+
+// SetHistoryBudget sets the total size (of the serialized topologies) of the snapshots kept in memory.
+// The current topology is always kept. Older snapshots beyond the budget are dropped, or only kept on disk if persistence is enabled.
+func (o *Octopus) SetHistoryBudget(maxBytes int64) {
+	o.topologyMu.Lock()
+	defer o.topologyMu.Unlock()
+
+	o.historyBudget = maxBytes
+	o._enforceHistoryBudget()
+}
+
+// _retainSnapshot adds the given topology to the history along with its cache, which may be nil.
+// Has to be called with topologyMu held.
+func (o *Octopus) _retainSnapshot(topology *model.Topology, size int, connectorLoadTimes []*octopuspb.ConnectorLoadTime, cache *topologyCache) {
+	s := &snapshot{
+		generation:         topology.Generation,
+		timestamp:          topology.Timestamp,
+		connectorLoadTimes: connectorLoadTimes,
+		size:               size,
+		topology:           topology,
+		cache:              cache,
+	}
+
+	// The snapshot is persisted right after, we forget the path again if that fails
+	if o.snapshotStore != nil {
+		s.path = o.snapshotStore.path(topology.Timestamp.Unix(), topology.Generation)
+	}
+
+	o.snapshots = append(o.snapshots, s)
+	o._enforceHistoryBudget()
+}
+
+// memorySize returns the size of the snapshot and its cached responses
+func (s *snapshot) memorySize() int64 {
+	if s.topology == nil {
+		return 0
+	}
+
+	size := int64(s.size)
+	if s.cache != nil {
+		size += int64(s.cache.cachedSize())
+	}
+
+	return size
+}
+
+// enforceHistoryBudget evicts the oldest snapshots from memory until the budget is met, e.g. after a cache has grown
+func (o *Octopus) enforceHistoryBudget() {
+	o.topologyMu.Lock()
+	defer o.topologyMu.Unlock()
+
+	o._enforceHistoryBudget()
+}
+
+// _enforceHistoryBudget evicts the oldest snapshots from memory until the budget is met.
+// Has to be called with topologyMu held.
+func (o *Octopus) _enforceHistoryBudget() {
+	total := int64(0)
+	for _, s := range o.snapshots {
+		total += s.memorySize()
+	}
+
+	// The latest snapshot is the current topology, which is never evicted
+	for i := 0; i < len(o.snapshots)-1 && total > o.historyBudget; i++ {
+		s := o.snapshots[i]
+		if s.topology == nil {
+			continue
+		}
+
+		total -= s.memorySize()
+		s.topology = nil
+		if s.cache != nil {
+			s.cache.release()
+			s.cache = nil
+		}
+	}
+
+	o._dropUnreachableSnapshots()
+}
+
+// _dropUnreachableSnapshots removes snapshots which are neither in memory nor on disk from the history.
+// Has to be called with topologyMu held.
+func (o *Octopus) _dropUnreachableSnapshots() {
+	retained := o.snapshots[:0]
+	for _, s := range o.snapshots {
+		if s.topology != nil || s.path != "" {
+			retained = append(retained, s)
+		}
+	}
+
+	clear(o.snapshots[len(retained):])
+	o.snapshots = retained
+}
+
+// forgetSnapshotFiles marks the given files as no longer available to load snapshots from
+func (o *Octopus) forgetSnapshotFiles(paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+
+	o.topologyMu.Lock()
+	defer o.topologyMu.Unlock()
+
+	for _, s := range o.snapshots {
+		if slices.Contains(paths, s.path) {
+			s.path = ""
+		}
+	}
+
+	o._dropUnreachableSnapshots()
+}
+
+// ListSnapshots returns all snapshots in the history, oldest first
+func (o *Octopus) ListSnapshots() []*octopuspb.SnapshotInfo {
+	o.topologyMu.RLock()
+	defer o.topologyMu.RUnlock()
+
+	ret := make([]*octopuspb.SnapshotInfo, 0, len(o.snapshots))
+	for _, s := range o.snapshots {
+		ret = append(ret, s.ToProto())
+	}
+
+	return ret
+}
+
+// GetSnapshot returns the retained topology snapshot of the given generation, or nil if it is not known (anymore)
+func (o *Octopus) GetSnapshot(generation uint64) *model.Topology {
+	return o.loadSnapshot(func(s *snapshot) bool {
+		return s.generation == generation
+	})
+}
+
+// GetSnapshotAt returns the latest retained topology snapshot which was built at or before the given time, or nil if there is none
+func (o *Octopus) GetSnapshotAt(ts time.Time) *model.Topology {
+	return o.loadSnapshot(func(s *snapshot) bool {
+		return !s.timestamp.After(ts)
+	})
+}
+
+// loadSnapshot returns the latest snapshot matching, loading it from disk if it is not in memory anymore
+func (o *Octopus) loadSnapshot(match func(*snapshot) bool) *model.Topology {
+	o.topologyMu.RLock()
+	var found *snapshot
+	for i := len(o.snapshots) - 1; i >= 0; i-- {
+		if match(o.snapshots[i]) {
+			found = o.snapshots[i]
+			break
+		}
+	}
+
+	if found == nil {
+		o.topologyMu.RUnlock()
+		return nil
+	}
+
+	topology, path := found.topology, found.path
+	o.topologyMu.RUnlock()
+
+	if topology != nil || path == "" {
+		return topology
+	}
+
+	topology, loadTimes, err := readSnapshotTopology(path)
+	if err != nil {
+		log.Errorf("Failed to load snapshot: %v", err)
+		return nil
+	}
+
+	// Snapshots found at warm start only learn their connector load times once read
+	o.topologyMu.Lock()
+	if found.connectorLoadTimes == nil {
+		found.connectorLoadTimes = loadTimes
+	}
+	o.topologyMu.Unlock()
+
+	return topology
+}
+
+// selectSnapshot returns the snapshot matching the given selector. A nil selector refers to the current topology.
+func (o *Octopus) selectSnapshot(sel *octopuspb.SnapshotSelector) *model.Topology {
+	if sel == nil {
+		return o.GetTopology()
+	}
+
+	switch s := sel.Selector.(type) {
+	case *octopuspb.SnapshotSelector_Generation:
+		return o.GetSnapshot(s.Generation)
+	case *octopuspb.SnapshotSelector_Timestamp:
+		return o.GetSnapshotAt(time.Unix(int64(s.Timestamp), 0))
+	}
+
+	return nil
+}
@@ -0,0 +1,379 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"sync"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
+)
+
+type topologyResponseKey struct {
+	includeProvenance bool
+	encoding          octopuspb.TopologyEncoding
+}
+
+type topologyJSONKey struct {
+	includeProvenance bool
+	gzip              bool
+}
+
+// topologyCache holds the GetTopology responses of a snapshot, so the topology is converted, marshalled, and compressed
+// only once instead of for every request. The responses are registered with the codec along with their marshalled bytes.
+type topologyCache struct {
+	codec *cachingCodec
+	// Epoch of the Octopus, set in every response
+	epoch     string
+	mu        sync.Mutex
+	responses map[topologyResponseKey]*octopuspb.TopologyResponse
+	// Responses of the REST API
+	json map[topologyJSONKey][]byte
+	// Size of the marshalled responses
+	size int
+	// Set once the snapshot has been evicted, responses created afterwards are not registered with the codec anymore
+	released bool
+	// Called without mu held whenever responses have been added after warming, so the history budget can be enforced
+	grown func()
+}
+
+func newTopologyCache(codec *cachingCodec, epoch string, grown func()) *topologyCache {
+	return &topologyCache{
+		codec:     codec,
+		epoch:     epoch,
+		grown:     grown,
+		responses: make(map[topologyResponseKey]*octopuspb.TopologyResponse),
+		json:      make(map[topologyJSONKey][]byte),
+	}
+}
+
+// warm adds the uncompressed responses of the topology, pt being the topology converted with provenance
+func (c *topologyCache) warm(topology *model.Topology, pt *octopuspb.Topology) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c._add(topologyResponseKey{includeProvenance: true}, &octopuspb.TopologyResponse{
+		Topology:   pt,
+		Generation: pt.Generation,
+		Epoch:      c.epoch,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c._response(topology, topologyResponseKey{})
+	return err
+}
+
+// response returns the cached response, creating it if needed
+func (c *topologyCache) response(topology *model.Topology, includeProvenance bool, encoding octopuspb.TopologyEncoding) (*octopuspb.TopologyResponse, error) {
+	c.mu.Lock()
+	size := c.size
+	resp, err := c._response(topology, topologyResponseKey{
+		includeProvenance: includeProvenance,
+		encoding:          encoding,
+	})
+	grown := c.size > size
+	c.mu.Unlock()
+
+	if grown {
+		c.notifyGrown()
+	}
+
+	return resp, err
+}
+
+// topologyProto returns the cached proto of the topology
+func (c *topologyCache) topologyProto(topology *model.Topology, includeProvenance bool) (*octopuspb.Topology, error) {
+	resp, err := c.response(topology, includeProvenance, octopuspb.TopologyEncoding_TOPOLOGY_ENCODING_NONE)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Topology, nil
+}
+
+// Has to be called with mu held
+func (c *topologyCache) _response(topology *model.Topology, key topologyResponseKey) (*octopuspb.TopologyResponse, error) {
+	resp, exists := c.responses[key]
+	if exists {
+		return resp, nil
+	}
+
+	if key.encoding == octopuspb.TopologyEncoding_TOPOLOGY_ENCODING_NONE {
+		pt := topology.ToProto()
+		if key.includeProvenance {
+			pt = topology.ToProtoWithProvenance()
+		}
+
+		resp = &octopuspb.TopologyResponse{
+			Topology:   pt,
+			Generation: pt.Generation,
+			Epoch:      c.epoch,
+		}
+
+		return resp, c._add(key, resp)
+	}
+
+	uncompressed, err := c._response(topology, topologyResponseKey{includeProvenance: key.includeProvenance})
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := proto.Marshal(uncompressed.Topology)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal topology: %v", err)
+	}
+
+	encoded, err := compress(b, key.encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &octopuspb.TopologyResponse{
+		Generation:      uncompressed.Generation,
+		Epoch:           c.epoch,
+		Encoding:        key.encoding,
+		EncodedTopology: encoded,
+	}
+
+	return resp, c._add(key, resp)
+}
+
+// jsonResponse returns the cached JSON encoded response, gzip compressed if requested, creating it if needed
+func (c *topologyCache) jsonResponse(topology *model.Topology, includeProvenance bool, compressed bool) ([]byte, error) {
+	c.mu.Lock()
+	size := c.size
+	b, err := c._compressedJSONResponse(topology, includeProvenance, compressed)
+	grown := c.size > size
+	c.mu.Unlock()
+
+	if grown {
+		c.notifyGrown()
+	}
+
+	return b, err
+}
+
+// Has to be called with mu held
+func (c *topologyCache) _compressedJSONResponse(topology *model.Topology, includeProvenance bool, compressed bool) ([]byte, error) {
+	key := topologyJSONKey{
+		includeProvenance: includeProvenance,
+		gzip:              compressed,
+	}
+
+	b, exists := c.json[key]
+	if exists {
+		return b, nil
+	}
+
+	b, err := c._jsonResponse(topology, includeProvenance)
+	if err != nil || !compressed {
+		return b, err
+	}
+
+	b, err = compress(b, octopuspb.TopologyEncoding_TOPOLOGY_ENCODING_GZIP)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.released {
+		c.json[key] = b
+		c.size += len(b)
+	}
+
+	return b, nil
+}
+
+// Has to be called with mu held
+func (c *topologyCache) _jsonResponse(topology *model.Topology, includeProvenance bool) ([]byte, error) {
+	b, exists := c.json[topologyJSONKey{includeProvenance: includeProvenance}]
+	if exists {
+		return b, nil
+	}
+
+	resp, err := c._response(topology, topologyResponseKey{includeProvenance: includeProvenance})
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = jsonMarshalOptions.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal topology response: %v", err)
+	}
+
+	if !c.released {
+		c.json[topologyJSONKey{includeProvenance: includeProvenance}] = b
+		c.size += len(b)
+	}
+
+	return b, nil
+}
+
+// Has to be called with mu held
+func (c *topologyCache) _add(key topologyResponseKey, resp *octopuspb.TopologyResponse) error {
+	b, err := proto.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("unable to marshal topology response: %v", err)
+	}
+
+	if c.released {
+		return nil
+	}
+
+	c.responses[key] = resp
+	c.size += len(b)
+	c.codec.register(resp, b)
+	return nil
+}
+
+// notifyGrown calls grown if set, has to be called without mu held as it takes the locks of the Octopus
+func (c *topologyCache) notifyGrown() {
+	if c.grown != nil {
+		c.grown()
+	}
+}
+
+// cachedSize returns the size of the marshalled responses
+func (c *topologyCache) cachedSize() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.size
+}
+
+// release unregisters the responses from the codec, so their bytes can be garbage collected once sent
+func (c *topologyCache) release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, resp := range c.responses {
+		c.codec.unregister(resp)
+		delete(c.responses, key)
+	}
+
+	clear(c.json)
+
+	c.size = 0
+	c.released = true
+}
+
+func compress(b []byte, encoding octopuspb.TopologyEncoding) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	switch encoding {
+	case octopuspb.TopologyEncoding_TOPOLOGY_ENCODING_GZIP:
+		w := gzip.NewWriter(buf)
+		_, err := w.Write(b)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compress topology: %v", err)
+		}
+
+		err = w.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to compress topology: %v", err)
+		}
+	case octopuspb.TopologyEncoding_TOPOLOGY_ENCODING_ZSTD:
+		w, err := zstd.NewWriter(nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create zstd encoder: %v", err)
+		}
+
+		defer w.Close()
+		return w.EncodeAll(b, nil), nil
+	default:
+		return nil, fmt.Errorf("unknown topology encoding %s", encoding)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// topologyCache returns the cache of the retained snapshot of the given topology.
+// Snapshots loaded from disk aren't retained in memory, so nil is returned for them.
+func (o *Octopus) topologyCache(topology *model.Topology) *topologyCache {
+	o.topologyMu.RLock()
+	for i := len(o.snapshots) - 1; i >= 0; i-- {
+		s := o.snapshots[i]
+		if s.topology == topology && s.cache != nil {
+			o.topologyMu.RUnlock()
+			return s.cache
+		}
+	}
+	o.topologyMu.RUnlock()
+
+	// Snapshots restored at warm start don't have a cache yet
+	o.topologyMu.Lock()
+	defer o.topologyMu.Unlock()
+
+	for _, s := range o.snapshots {
+		if s.topology == topology {
+			if s.cache == nil {
+				s.cache = newTopologyCache(o.codec, o.epoch, o.enforceHistoryBudget)
+			}
+
+			return s.cache
+		}
+	}
+
+	return nil
+}
+
+// getTopologyResponse returns the response for the snapshot matching the given selector, nil if there is none.
+// If the client already knows the snapshot, i.e. got its generation from this epoch, only not_modified is set.
+func (o *Octopus) getTopologyResponse(sel *octopuspb.SnapshotSelector, knownGeneration uint64, knownEpoch string, includeProvenance bool, encoding octopuspb.TopologyEncoding) (*octopuspb.TopologyResponse, error) {
+	topology := o.selectSnapshot(sel)
+	if topology == nil {
+		return nil, nil
+	}
+
+	if knownGeneration != 0 && knownGeneration == topology.Generation && knownEpoch == o.epoch {
+		return &octopuspb.TopologyResponse{
+			Generation:  topology.Generation,
+			Epoch:       o.epoch,
+			NotModified: true,
+		}, nil
+	}
+
+	cache := o.topologyCache(topology)
+	if cache == nil {
+		// One-off cache, the codec must not hold on to its responses
+		cache = newTopologyCache(o.codec, o.epoch, nil)
+		defer cache.release()
+	}
+
+	return cache.response(topology, includeProvenance, encoding)
+}
+
+// topologyJSON returns the JSON encoded response of the topology, from the cache of its snapshot if retained
+func (o *Octopus) topologyJSON(topology *model.Topology, includeProvenance bool, compressed bool) ([]byte, error) {
+	cache := o.topologyCache(topology)
+	if cache == nil {
+		cache = newTopologyCache(o.codec, o.epoch, nil)
+		defer cache.release()
+	}
+
+	return cache.jsonResponse(topology, includeProvenance, compressed)
+}
+
+// topologyProto returns the proto of the topology, from the cache of its snapshot if retained. It must not be modified.
+func (o *Octopus) topologyProto(topology *model.Topology, includeProvenance bool) (*octopuspb.Topology, error) {
+	cache := o.topologyCache(topology)
+	if cache == nil {
+		if includeProvenance {
+			return topology.ToProtoWithProvenance(), nil
+		}
+
+		return topology.ToProto(), nil
+	}
+
+	return cache.topologyProto(topology, includeProvenance)
+}
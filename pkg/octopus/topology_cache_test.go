@@ -0,0 +1,157 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCachingCodec(t *testing.T) {
+	codec := newCachingCodec()
+
+	registered := &api.TopologyResponse{Generation: 1}
+	unregistered := &api.TopologyResponse{Generation: 1}
+	expected, err := proto.Marshal(registered)
+	assert.NoError(t, err)
+
+	// Bytes which don't match the message show that they are sent as is
+	cached := []byte("cached")
+	codec.register(registered, cached)
+
+	b, err := codec.Marshal(registered)
+	assert.NoError(t, err)
+	assert.Equal(t, cached, b.Materialize(), "registered")
+
+	b, err = codec.Marshal(unregistered)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, b.Materialize(), "equal message not registered")
+
+	codec.unregister(registered)
+	b, err = codec.Marshal(registered)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, b.Materialize(), "unregistered")
+
+	decoded := &api.TopologyResponse{}
+	assert.NoError(t, codec.Unmarshal(b, decoded))
+	assert.True(t, proto.Equal(registered, decoded))
+}
+
+func TestTopologyCacheRelease(t *testing.T) {
+	topology := newTestTopology(time.Unix(1700000000, 0))
+	topology.Generation = 1
+
+	codec := newCachingCodec()
+	cache := newTopologyCache(codec, "epoch", nil)
+
+	resp, err := cache.response(topology, false, api.TopologyEncoding_TOPOLOGY_ENCODING_NONE)
+	assert.NoError(t, err)
+	_, registered := codec.marshalled.Load(resp)
+	assert.True(t, registered)
+	assert.Positive(t, cache.cachedSize())
+
+	again, err := cache.response(topology, false, api.TopologyEncoding_TOPOLOGY_ENCODING_NONE)
+	assert.NoError(t, err)
+	assert.Same(t, resp, again)
+
+	cache.release()
+	_, registered = codec.marshalled.Load(resp)
+	assert.False(t, registered, "released")
+	assert.Zero(t, cache.cachedSize())
+
+	// Responses are still served after the release, but neither cached nor registered
+	released, err := cache.response(topology, false, api.TopologyEncoding_TOPOLOGY_ENCODING_NONE)
+	assert.NoError(t, err)
+	assert.NotSame(t, resp, released)
+	assert.True(t, proto.Equal(resp, released))
+	_, registered = codec.marshalled.Load(released)
+	assert.False(t, registered, "created after release")
+	assert.Zero(t, cache.cachedSize())
+
+	b, err := codec.Marshal(released)
+	assert.NoError(t, err)
+	expected, err := proto.Marshal(released)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, b.Materialize())
+}
+
+func TestHistoryBudgetCacheGrowth(t *testing.T) {
+	o := NewOctopus(0)
+	publishTestTopology(o, newTestTopology(time.Unix(1700000000, 0)))
+	current := newTestTopology(time.Unix(1700000060, 0))
+	publishTestTopology(o, current)
+
+	o.topologyMu.RLock()
+	total := int64(0)
+	for _, s := range o.snapshots {
+		total += s.memorySize()
+	}
+	o.topologyMu.RUnlock()
+
+	// Both snapshots fit exactly, until the current one caches another response
+	o.SetHistoryBudget(total)
+	assert.Len(t, o.ListSnapshots(), 2)
+
+	_, err := o.topologyJSON(current, false, true)
+	assert.NoError(t, err)
+
+	snapshots := o.ListSnapshots()
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, current.Generation, snapshots[0].Generation)
+}
+
+func TestGetTopologyResponseKnownGeneration(t *testing.T) {
+	o := NewOctopus(0)
+	publishTestTopology(o, newTestTopology(time.Unix(1700000000, 0)))
+	current := newTestTopology(time.Unix(1700000060, 0))
+	publishTestTopology(o, current)
+
+	tests := []struct {
+		name                string
+		knownGeneration     uint64
+		knownEpoch          string
+		expectedNotModified bool
+	}{
+		{
+			name: "nothing known",
+		},
+		{
+			name:                "current generation",
+			knownGeneration:     current.Generation,
+			knownEpoch:          o.epoch,
+			expectedNotModified: true,
+		},
+		{
+			name:            "current generation without epoch",
+			knownGeneration: current.Generation,
+		},
+		{
+			name:            "current generation of another epoch",
+			knownGeneration: current.Generation,
+			knownEpoch:      newEpoch(),
+		},
+		{
+			name:            "older generation",
+			knownGeneration: current.Generation - 1,
+			knownEpoch:      o.epoch,
+		},
+	}
+
+	for _, test := range tests {
+		resp, err := o.getTopologyResponse(nil, test.knownGeneration, test.knownEpoch, false, api.TopologyEncoding_TOPOLOGY_ENCODING_NONE)
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, current.Generation, resp.Generation, test.name)
+		assert.Equal(t, o.epoch, resp.Epoch, test.name)
+		assert.Equal(t, test.expectedNotModified, resp.NotModified, test.name)
+		assert.Equal(t, test.expectedNotModified, resp.Topology == nil, test.name)
+	}
+}
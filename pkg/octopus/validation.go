@@ -0,0 +1,26 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"github.com/cloudflare/octopus/pkg/validation"
+)
+
+// SetValidator sets the validator every topology is checked with before it is published. It has to be called before Start.
+// By default all built-in rules are checked with their default severities, so no topology is blocked.
+func (o *Octopus) SetValidator(v *validation.Validator) {
+	o.validator = v
+}
+
+// GetValidationReport returns the report of the last topology build, nil if no topology has been built yet
+func (o *Octopus) GetValidationReport() *validation.Report {
+	o.topologyMu.RLock()
+	defer o.topologyMu.RUnlock()
+
+	return o.validationReport
+}
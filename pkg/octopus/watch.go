@@ -0,0 +1,127 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package octopus
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// Number of topology generations we keep the changes for, so reconnecting watchers can resume
+const changeLogSize = 100
+
+// newEpoch returns a random ID of this process. Generations restart when Octopus is restarted without
+// persisted snapshots, so watchers may only resume from a generation they have received in the same epoch.
+func newEpoch() string {
+	b := make([]byte, 8)
+	_, err := rand.Read(b)
+	if err != nil {
+		return time.Now().Format(time.RFC3339Nano)
+	}
+
+	return hex.EncodeToString(b)
+}
+
+// changeSet holds the events which transformed the previous topology into the one of the given generation
+type changeSet struct {
+	generation uint64
+	timestamp  uint64
+	events     []*octopuspb.TopologyEvent
+}
+
+// watcher is notified whenever a new topology generation has been published
+type watcher struct {
+	notify chan struct{}
+}
+
+type watchers struct {
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+}
+
+func newWatchers() *watchers {
+	return &watchers{
+		watchers: make(map[*watcher]struct{}),
+	}
+}
+
+func (w *watchers) subscribe() *watcher {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	wa := &watcher{
+		// Notifications are coalesced, the watcher will pick up all changes since the last generation it has sent
+		notify: make(chan struct{}, 1),
+	}
+	w.watchers[wa] = struct{}{}
+
+	return wa
+}
+
+func (w *watchers) unsubscribe(wa *watcher) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.watchers, wa)
+}
+
+func (w *watchers) notifyAll() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for wa := range w.watchers {
+		select {
+		case wa.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// _recordChanges appends the events which lead to the given topology to the change log.
+// Has to be called with topologyMu held.
+func (o *Octopus) _recordChanges(topology *model.Topology, events []*octopuspb.TopologyEvent) {
+	cs := &changeSet{
+		generation: topology.Generation,
+		timestamp:  uint64(topology.Timestamp.Unix()),
+		events:     events,
+	}
+
+	o.changeLog = append(o.changeLog, cs)
+	if len(o.changeLog) > changeLogSize {
+		o.changeLog = o.changeLog[len(o.changeLog)-changeLogSize:]
+	}
+}
+
+// changesSince returns the current topology and all change sets after the given generation of the given epoch.
+// If the generation is from another epoch or the change log does not reach back far enough,
+// ok will be false and the caller has to fall back to a full snapshot.
+func (o *Octopus) changesSince(generation uint64, epoch string) (topology *model.Topology, changes []*changeSet, ok bool) {
+	o.topologyMu.RLock()
+	defer o.topologyMu.RUnlock()
+
+	if o.topology == nil || epoch != o.epoch || generation == 0 || generation > o.topology.Generation {
+		return o.topology, nil, false
+	}
+
+	if generation == o.topology.Generation {
+		return o.topology, nil, true
+	}
+
+	for i, cs := range o.changeLog {
+		if cs.generation == generation+1 {
+			return o.topology, o.changeLog[i:], true
+		}
+	}
+
+	return o.topology, nil, false
+}
@@ -0,0 +1,329 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package validation
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// DefaultRules returns the built-in rules
+func DefaultRules() []Rule {
+	return []Rule{
+		&DanglingCableRule{},
+		&MissingLAGParentRule{},
+		&DuplicateIPRule{},
+		&UncoveredIPRule{},
+		&InvalidFrontPortRule{},
+		&DeviceWithoutSiteRule{},
+	}
+}
+
+// DanglingCableRule finds cables with an end on a device, port, or circuit not present in the topology
+type DanglingCableRule struct{}
+
+func (r *DanglingCableRule) Name() string {
+	return "dangling-cable"
+}
+
+func (r *DanglingCableRule) Description() string {
+	return "Cables must connect interfaces, ports, or circuit terminations present in the topology"
+}
+
+func (r *DanglingCableRule) DefaultSeverity() Severity {
+	return SeverityError
+}
+
+func (r *DanglingCableRule) Check(t *model.Topology) []Finding {
+	ret := make([]Finding, 0)
+	for _, key := range sortedKeys(t.Cables) {
+		c := t.Cables[key]
+		for _, ce := range []model.CableEnd{c.AEnd, c.BEnd} {
+			err := checkCableEnd(t, ce)
+			if err != nil {
+				ret = append(ret, Finding{
+					Entity:  "cable",
+					Key:     key,
+					Message: err.Error(),
+				})
+			}
+		}
+	}
+
+	return ret
+}
+
+func checkCableEnd(t *model.Topology, ce model.CableEnd) error {
+	if ce.EndpointType == octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION {
+		if t.Circuits[ce.DeviceName] == nil {
+			return fmt.Errorf("circuit %s does not exist", ce.DeviceName)
+		}
+
+		return nil
+	}
+
+	d := t.GetDevice(ce.DeviceName)
+	if d == nil {
+		return fmt.Errorf("device %s does not exist", ce.DeviceName)
+	}
+
+	exists := false
+	switch ce.EndpointType {
+	case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE:
+		exists = d.Interfaces[ce.EndpointName] != nil
+	case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_FRONT_PORT:
+		exists = d.FrontPorts[ce.EndpointName] != nil
+	case octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_REAR_PORT:
+		exists = d.RearPorts[ce.EndpointName] != nil
+	default:
+		return fmt.Errorf("endpoint %s:%s has unknown type %d", ce.DeviceName, ce.EndpointName, ce.EndpointType)
+	}
+
+	if !exists {
+		return fmt.Errorf("endpoint %s:%s does not exist", ce.DeviceName, ce.EndpointName)
+	}
+
+	return nil
+}
+
+// MissingLAGParentRule finds interfaces which are member of a LAG interface not present on their device
+type MissingLAGParentRule struct{}
+
+func (r *MissingLAGParentRule) Name() string {
+	return "missing-lag-parent"
+}
+
+func (r *MissingLAGParentRule) Description() string {
+	return "LAG members must reference a LAG interface of their device"
+}
+
+func (r *MissingLAGParentRule) DefaultSeverity() Severity {
+	return SeverityError
+}
+
+func (r *MissingLAGParentRule) Check(t *model.Topology) []Finding {
+	ret := make([]Finding, 0)
+	for _, devName := range sortedKeys(t.Nodes) {
+		d := t.Nodes[devName]
+		for _, ifName := range sortedKeys(d.Interfaces) {
+			ifa := d.Interfaces[ifName]
+			if ifa.LAGMemberOf == "" || d.Interfaces[ifa.LAGMemberOf] != nil {
+				continue
+			}
+
+			ret = append(ret, Finding{
+				Entity:  "interface",
+				Key:     devName + ":" + ifName,
+				Message: fmt.Sprintf("LAG parent %s does not exist", ifa.LAGMemberOf),
+			})
+		}
+	}
+
+	return ret
+}
+
+// DuplicateIPRule finds addresses configured on more than one device. Link-local addresses are ignored.
+type DuplicateIPRule struct{}
+
+func (r *DuplicateIPRule) Name() string {
+	return "duplicate-ip"
+}
+
+func (r *DuplicateIPRule) Description() string {
+	return "IP addresses must not be configured on more than one device"
+}
+
+func (r *DuplicateIPRule) DefaultSeverity() Severity {
+	return SeverityWarning
+}
+
+func (r *DuplicateIPRule) Check(t *model.Topology) []Finding {
+	devicesByAddr := make(map[string][]string)
+	forEachIP(t, func(d *model.Device, ifa *model.Interface, u *model.InterfaceUnit, ip model.IP) {
+		addr := ip.Address.Addr()
+		if addr.ToNetIP().IsLinkLocalUnicast() {
+			return
+		}
+
+		key := addr.String()
+		if !slices.Contains(devicesByAddr[key], d.Name) {
+			devicesByAddr[key] = append(devicesByAddr[key], d.Name)
+		}
+	})
+
+	ret := make([]Finding, 0)
+	for _, addr := range sortedKeys(devicesByAddr) {
+		devices := devicesByAddr[addr]
+		if len(devices) < 2 {
+			continue
+		}
+
+		ret = append(ret, Finding{
+			Entity:  "ip",
+			Key:     addr,
+			Message: fmt.Sprintf("configured on devices %s", strings.Join(devices, ", ")),
+		})
+	}
+
+	return ret
+}
+
+// UncoveredIPRule finds interface addresses not covered by any prefix. Link-local addresses are ignored.
+type UncoveredIPRule struct{}
+
+func (r *UncoveredIPRule) Name() string {
+	return "ip-without-prefix"
+}
+
+func (r *UncoveredIPRule) Description() string {
+	return "IP addresses of interfaces must be covered by a prefix"
+}
+
+func (r *UncoveredIPRule) DefaultSeverity() Severity {
+	return SeverityInfo
+}
+
+func (r *UncoveredIPRule) Check(t *model.Topology) []Finding {
+	ret := make([]Finding, 0)
+	forEachIP(t, func(d *model.Device, ifa *model.Interface, u *model.InterfaceUnit, ip model.IP) {
+		addr := ip.Address.Addr()
+		if addr.ToNetIP().IsLinkLocalUnicast() {
+			return
+		}
+
+		key := fmt.Sprintf("%s:%s %s", d.Name, ifa.Name, ip.Address.String())
+		res, err := t.LookupIP(addr)
+		if err != nil {
+			ret = append(ret, Finding{
+				Entity:  "ip",
+				Key:     key,
+				Message: err.Error(),
+			})
+			return
+		}
+
+		if len(res.Prefixes) == 0 {
+			ret = append(ret, Finding{
+				Entity:  "ip",
+				Key:     key,
+				Message: "not covered by any prefix",
+			})
+		}
+	})
+
+	return ret
+}
+
+// InvalidFrontPortRule finds front ports mapped to a rear port not present on their device or to a position the rear port doesn't have
+type InvalidFrontPortRule struct{}
+
+func (r *InvalidFrontPortRule) Name() string {
+	return "invalid-front-port"
+}
+
+func (r *InvalidFrontPortRule) Description() string {
+	return "Front ports must map to an existing rear port and position"
+}
+
+func (r *InvalidFrontPortRule) DefaultSeverity() Severity {
+	return SeverityError
+}
+
+func (r *InvalidFrontPortRule) Check(t *model.Topology) []Finding {
+	ret := make([]Finding, 0)
+	for _, devName := range sortedKeys(t.Nodes) {
+		d := t.Nodes[devName]
+		for _, fpName := range sortedKeys(d.FrontPorts) {
+			fp := d.FrontPorts[fpName]
+			message := ""
+
+			rp := d.RearPorts[fp.RearPort]
+			if rp == nil {
+				message = fmt.Sprintf("rear port %q does not exist", fp.RearPort)
+			} else if fp.RearPortPosition < 1 || int(fp.RearPortPosition) > int(rp.Positions) {
+				message = fmt.Sprintf("position %d is out of range of rear port %s (%d positions)", fp.RearPortPosition, rp.Name, rp.Positions)
+			}
+
+			if message == "" {
+				continue
+			}
+
+			ret = append(ret, Finding{
+				Entity:  "front_port",
+				Key:     devName + ":" + fpName,
+				Message: message,
+			})
+		}
+	}
+
+	return ret
+}
+
+// DeviceWithoutSiteRule finds devices not assigned to a site
+type DeviceWithoutSiteRule struct{}
+
+func (r *DeviceWithoutSiteRule) Name() string {
+	return "device-without-site"
+}
+
+func (r *DeviceWithoutSiteRule) Description() string {
+	return "Devices must be assigned to a site"
+}
+
+func (r *DeviceWithoutSiteRule) DefaultSeverity() Severity {
+	return SeverityWarning
+}
+
+func (r *DeviceWithoutSiteRule) Check(t *model.Topology) []Finding {
+	ret := make([]Finding, 0)
+	for _, devName := range sortedKeys(t.Nodes) {
+		if t.Nodes[devName].Site != nil {
+			continue
+		}
+
+		ret = append(ret, Finding{
+			Entity:  "device",
+			Key:     devName,
+			Message: "no site assigned",
+		})
+	}
+
+	return ret
+}
+
+// forEachIP calls f for every address configured on an interface unit, ordered by device and interface
+func forEachIP(t *model.Topology, f func(d *model.Device, ifa *model.Interface, u *model.InterfaceUnit, ip model.IP)) {
+	for _, devName := range sortedKeys(t.Nodes) {
+		d := t.Nodes[devName]
+		for _, ifName := range sortedKeys(d.Interfaces) {
+			ifa := d.Interfaces[ifName]
+			for _, u := range ifa.Units {
+				for _, ips := range [][]model.IP{u.IPv4Addresses, u.IPv6Addresses} {
+					for _, ip := range ips {
+						f(d, ifa, u, ip)
+					}
+				}
+			}
+		}
+	}
+}
+
+func sortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,160 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package validation
+
+import (
+	"testing"
+
+	bnet "github.com/bio-routing/bio-rd/net"
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+func interfaceEnd(devName string, ifName string) model.CableEnd {
+	return model.CableEnd{
+		DeviceName:   devName,
+		EndpointName: ifName,
+		EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_INTERFACE,
+	}
+}
+
+func newTestTopology() *model.Topology {
+	t := model.NewTopology()
+	site := t.AddSiteIfNotExists("DUS01")
+
+	ccr := t.AddDeviceIfNotExists("ccr01.dus01")
+	ccr.Site = site
+	ccr.AddInterfaceItNotExists("ae0")
+	ccr.AddInterfaceItNotExists("et-0/0/0").LAGMemberOf = "ae0"
+	ccr.AddInterfaceItNotExists("et-0/0/1").LAGMemberOf = "ae1"
+	ccr.GetInterface("ae0").AddIPAddressIfNotExists(model.NewVLANTag(0, 0), model.NewIP(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 31)))
+	ccr.GetInterface("ae0").AddIPAddressIfNotExists(model.NewVLANTag(0, 0), model.NewIP(bnet.NewPfx(bnet.IPv6FromBlocks(0xfe80, 0, 0, 0, 0, 0, 0, 1), 64)))
+
+	edge := t.AddDeviceIfNotExists("edge01.dus01")
+	edge.AddInterfaceItNotExists("et-0/0/0")
+	edge.GetInterface("et-0/0/0").AddIPAddressIfNotExists(model.NewVLANTag(0, 0), model.NewIP(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 31)))
+	edge.GetInterface("et-0/0/0").AddIPAddressIfNotExists(model.NewVLANTag(0, 0), model.NewIP(bnet.NewPfx(bnet.IPv4FromOctets(198, 51, 100, 1), 31)))
+	edge.GetInterface("et-0/0/0").AddIPAddressIfNotExists(model.NewVLANTag(0, 0), model.NewIP(bnet.NewPfx(bnet.IPv6FromBlocks(0xfe80, 0, 0, 0, 0, 0, 0, 1), 64)))
+	edge.RearPorts["rp1"] = &model.RearPort{Name: "rp1", Positions: 2}
+	edge.FrontPorts["fp1"] = &model.FrontPort{Name: "fp1", RearPort: "rp1", RearPortPosition: 1}
+	edge.FrontPorts["fp3"] = &model.FrontPort{Name: "fp3", RearPort: "rp1", RearPortPosition: 3}
+	edge.FrontPorts["fp4"] = &model.FrontPort{Name: "fp4", RearPort: "rp2", RearPortPosition: 1}
+
+	for _, c := range []*model.Cable{
+		{AEnd: interfaceEnd("ccr01.dus01", "et-0/0/0"), BEnd: interfaceEnd("edge01.dus01", "et-0/0/0")},
+		{AEnd: interfaceEnd("ccr01.dus01", "et-0/0/1"), BEnd: interfaceEnd("edge02.dus01", "et-0/0/0")},
+		{
+			AEnd: interfaceEnd("ccr01.dus01", "et-0/0/2"),
+			BEnd: model.CableEnd{DeviceName: "CID-1", EndpointName: "A", EndpointType: octopuspb.CableEndpointType_CABLE_ENDPOINT_TYPE_CIRCUIT_TERMINATION},
+		},
+	} {
+		t.Cables[c.String()] = c
+	}
+
+	t.Prefixes[1] = model.NewPrefix(bnet.NewPfx(bnet.IPv4FromOctets(192, 0, 2, 0), 24))
+	t.IndexIPs()
+
+	return t
+}
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     Rule
+		expected []Finding
+	}{
+		{
+			name: "Dangling cables",
+			rule: &DanglingCableRule{},
+			expected: []Finding{
+				{
+					Entity:  "cable",
+					Key:     "ccr01.dus01:et-0/0/1:1<->edge02.dus01:et-0/0/0:1",
+					Message: "device edge02.dus01 does not exist",
+				},
+				{
+					Entity:  "cable",
+					Key:     "ccr01.dus01:et-0/0/2:1<->CID-1:A:4",
+					Message: "endpoint ccr01.dus01:et-0/0/2 does not exist",
+				},
+				{
+					Entity:  "cable",
+					Key:     "ccr01.dus01:et-0/0/2:1<->CID-1:A:4",
+					Message: "circuit CID-1 does not exist",
+				},
+			},
+		},
+		{
+			name: "Missing LAG parent",
+			rule: &MissingLAGParentRule{},
+			expected: []Finding{
+				{
+					Entity:  "interface",
+					Key:     "ccr01.dus01:et-0/0/1",
+					Message: "LAG parent ae1 does not exist",
+				},
+			},
+		},
+		{
+			name: "Duplicate IPs, ignoring link-local ones",
+			rule: &DuplicateIPRule{},
+			expected: []Finding{
+				{
+					Entity:  "ip",
+					Key:     "192.0.2.0",
+					Message: "configured on devices ccr01.dus01, edge01.dus01",
+				},
+			},
+		},
+		{
+			name: "IPs without prefix",
+			rule: &UncoveredIPRule{},
+			expected: []Finding{
+				{
+					Entity:  "ip",
+					Key:     "edge01.dus01:et-0/0/0 198.51.100.1/31",
+					Message: "not covered by any prefix",
+				},
+			},
+		},
+		{
+			name: "Invalid front ports",
+			rule: &InvalidFrontPortRule{},
+			expected: []Finding{
+				{
+					Entity:  "front_port",
+					Key:     "edge01.dus01:fp3",
+					Message: "position 3 is out of range of rear port rp1 (2 positions)",
+				},
+				{
+					Entity:  "front_port",
+					Key:     "edge01.dus01:fp4",
+					Message: "rear port \"rp2\" does not exist",
+				},
+			},
+		},
+		{
+			name: "Devices without site",
+			rule: &DeviceWithoutSiteRule{},
+			expected: []Finding{
+				{
+					Entity:  "device",
+					Key:     "edge01.dus01",
+					Message: "no site assigned",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.rule.Check(newTestTopology()))
+		})
+	}
+}
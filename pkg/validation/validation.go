@@ -0,0 +1,259 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+)
+
+// Severity of the violations of a rule
+type Severity uint8
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+	// Violations block publishing the topology
+	SeverityFatal
+)
+
+var severityNames = map[Severity]string{
+	SeverityInfo:    "info",
+	SeverityWarning: "warning",
+	SeverityError:   "error",
+	SeverityFatal:   "fatal",
+}
+
+func (s Severity) String() string {
+	return severityNames[s]
+}
+
+// SeverityFromString parses the severity names returned by String
+func SeverityFromString(s string) (Severity, error) {
+	for severity, name := range severityNames {
+		if name == s {
+			return severity, nil
+		}
+	}
+
+	return SeverityInfo, fmt.Errorf("unknown severity %q", s)
+}
+
+// Finding is an object violating a rule
+type Finding struct {
+	// device, interface, ip, cable or front_port
+	Entity string
+	// Identifies the object, e.g. "ccr01.dus01:et-0/0/0" for an interface
+	Key     string
+	Message string
+}
+
+// Rule checks a topology for inconsistencies. Rules must not modify the topology.
+type Rule interface {
+	Name() string
+	Description() string
+	DefaultSeverity() Severity
+	Check(t *model.Topology) []Finding
+}
+
+// Violation is a finding of a rule along with the severity the rule is configured with
+type Violation struct {
+	Finding
+	Rule     string
+	Severity Severity
+}
+
+func (v *Violation) String() string {
+	return fmt.Sprintf("%s (%s): %s %s: %s", v.Rule, v.Severity, v.Entity, v.Key, v.Message)
+}
+
+func (v *Violation) ToProto() *octopuspb.ValidationViolation {
+	return &octopuspb.ValidationViolation{
+		Rule:     v.Rule,
+		Severity: octopuspb.ValidationSeverity(v.Severity),
+		Entity:   v.Entity,
+		Key:      v.Key,
+		Message:  v.Message,
+	}
+}
+
+// Report holds the violations found in a topology, sorted by rule and key
+type Report struct {
+	Timestamp time.Time
+	// Generation of the validated topology, 0 if it has been blocked
+	Generation uint64
+	Blocked    bool
+	// Severity of every rule checked
+	Rules      map[string]Severity
+	Violations []*Violation
+
+	descriptions map[string]string
+}
+
+// Fatal returns the violations which block publishing the topology
+func (r *Report) Fatal() []*Violation {
+	return r.Filter(SeverityFatal)
+}
+
+// Filter returns the violations of at least the given severity
+func (r *Report) Filter(minSeverity Severity) []*Violation {
+	ret := make([]*Violation, 0)
+	for _, v := range r.Violations {
+		if v.Severity >= minSeverity {
+			ret = append(ret, v)
+		}
+	}
+
+	return ret
+}
+
+// Count returns the number of violations by rule, including the rules without any
+func (r *Report) Count() map[string]int {
+	ret := make(map[string]int, len(r.Rules))
+	for name := range r.Rules {
+		ret[name] = 0
+	}
+
+	for _, v := range r.Violations {
+		ret[v.Rule]++
+	}
+
+	return ret
+}
+
+// ToProto returns the report with the violations of at least the given severity
+func (r *Report) ToProto(minSeverity Severity) *octopuspb.ValidationReport {
+	pr := &octopuspb.ValidationReport{
+		Timestamp:  uint64(r.Timestamp.Unix()),
+		Generation: r.Generation,
+		Blocked:    r.Blocked,
+		Rules:      make([]*octopuspb.ValidationRule, 0, len(r.Rules)),
+		Violations: make([]*octopuspb.ValidationViolation, 0),
+	}
+
+	for name, severity := range r.Rules {
+		pr.Rules = append(pr.Rules, &octopuspb.ValidationRule{
+			Name:        name,
+			Description: r.descriptions[name],
+			Severity:    octopuspb.ValidationSeverity(severity),
+		})
+	}
+
+	slices.SortFunc(pr.Rules, func(a, b *octopuspb.ValidationRule) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	for _, v := range r.Filter(minSeverity) {
+		pr.Violations = append(pr.Violations, v.ToProto())
+	}
+
+	return pr
+}
+
+// Validator checks topologies with a set of rules
+type Validator struct {
+	rules      []Rule
+	severities map[string]Severity
+}
+
+// NewValidator creates a Validator checking the given rules with their default severities
+func NewValidator(rules ...Rule) *Validator {
+	return &Validator{
+		rules:      rules,
+		severities: make(map[string]Severity),
+	}
+}
+
+// AddRule adds a rule to the validator
+func (v *Validator) AddRule(r Rule) {
+	v.rules = append(v.rules, r)
+}
+
+// SetSeverity overrides the default severity of the rule of the given name
+func (v *Validator) SetSeverity(rule string, severity Severity) error {
+	if !slices.ContainsFunc(v.rules, func(r Rule) bool { return r.Name() == rule }) {
+		return fmt.Errorf("unknown validation rule %q", rule)
+	}
+
+	v.severities[rule] = severity
+	return nil
+}
+
+// ParseSeverities parses a comma separated list of rule=severity pairs, e.g. "dangling-cable=fatal"
+func ParseSeverities(s string) (map[string]Severity, error) {
+	ret := make(map[string]Severity)
+	if s == "" {
+		return ret, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		name, severityName, found := strings.Cut(strings.TrimSpace(pair), "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid rule severity %q, expected rule=severity", pair)
+		}
+
+		severity, err := SeverityFromString(severityName)
+		if err != nil {
+			return nil, err
+		}
+
+		ret[name] = severity
+	}
+
+	return ret, nil
+}
+
+func (v *Validator) severity(r Rule) Severity {
+	severity, exists := v.severities[r.Name()]
+	if !exists {
+		return r.DefaultSeverity()
+	}
+
+	return severity
+}
+
+// Validate checks the topology with all rules. The logical links and IP index of the topology have to be built.
+func (v *Validator) Validate(t *model.Topology) *Report {
+	r := &Report{
+		Timestamp:    t.Timestamp,
+		Generation:   t.Generation,
+		Rules:        make(map[string]Severity, len(v.rules)),
+		Violations:   make([]*Violation, 0),
+		descriptions: make(map[string]string, len(v.rules)),
+	}
+
+	for _, rule := range v.rules {
+		severity := v.severity(rule)
+		r.Rules[rule.Name()] = severity
+		r.descriptions[rule.Name()] = rule.Description()
+
+		for _, f := range rule.Check(t) {
+			r.Violations = append(r.Violations, &Violation{
+				Finding:  f,
+				Rule:     rule.Name(),
+				Severity: severity,
+			})
+		}
+	}
+
+	slices.SortStableFunc(r.Violations, func(a, b *Violation) int {
+		if c := strings.Compare(a.Rule, b.Rule); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return r
+}
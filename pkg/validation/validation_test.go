@@ -0,0 +1,131 @@
+//
+// Copyright (c) 2023 Cloudflare, Inc.
+//
+// Licensed under Apache 2.0 license found in the LICENSE file
+// or at http://www.apache.org/licenses/LICENSE-2.0
+//
+
+package validation
+
+import (
+	"testing"
+
+	"github.com/cloudflare/octopus/pkg/model"
+	octopuspb "github.com/cloudflare/octopus/proto/octopus"
+	"github.com/stretchr/testify/assert"
+)
+
+// customRule shows how rules can be plugged in
+type customRule struct{}
+
+func (r *customRule) Name() string {
+	return "custom"
+}
+
+func (r *customRule) Description() string {
+	return "Devices must have a role"
+}
+
+func (r *customRule) DefaultSeverity() Severity {
+	return SeverityInfo
+}
+
+func (r *customRule) Check(t *model.Topology) []Finding {
+	ret := make([]Finding, 0)
+	for _, d := range t.Nodes {
+		if d.Role == "" {
+			ret = append(ret, Finding{Entity: "device", Key: d.Name, Message: "no role"})
+		}
+	}
+
+	return ret
+}
+
+func TestValidate(t *testing.T) {
+	v := NewValidator(DefaultRules()...)
+	v.AddRule(&customRule{})
+
+	assert.Error(t, v.SetSeverity("unknown", SeverityFatal))
+	assert.NoError(t, v.SetSeverity("missing-lag-parent", SeverityFatal))
+
+	r := v.Validate(newTestTopology())
+	assert.Equal(t, 7, len(r.Rules))
+	assert.Equal(t, SeverityFatal, r.Rules["missing-lag-parent"])
+	assert.Equal(t, SeverityError, r.Rules["dangling-cable"])
+
+	assert.Equal(t, []*Violation{
+		{
+			Finding: Finding{
+				Entity:  "interface",
+				Key:     "ccr01.dus01:et-0/0/1",
+				Message: "LAG parent ae1 does not exist",
+			},
+			Rule:     "missing-lag-parent",
+			Severity: SeverityFatal,
+		},
+	}, r.Fatal())
+
+	count := r.Count()
+	assert.Equal(t, 3, count["dangling-cable"])
+	assert.Equal(t, 2, count["custom"])
+	assert.Equal(t, 1, count["missing-lag-parent"])
+	assert.Equal(t, 11, len(r.Violations))
+
+	// Violations are sorted by rule
+	assert.Equal(t, "custom", r.Violations[0].Rule)
+	assert.Equal(t, "missing-lag-parent", r.Violations[len(r.Violations)-1].Rule)
+
+	pr := r.ToProto(SeverityError)
+	assert.Equal(t, 7, len(pr.Rules))
+	assert.Equal(t, "custom", pr.Rules[0].Name)
+	assert.Equal(t, 6, len(pr.Violations))
+	for _, pv := range pr.Violations {
+		assert.GreaterOrEqual(t, pv.Severity, octopuspb.ValidationSeverity_VALIDATION_SEVERITY_ERROR)
+	}
+}
+
+func TestParseSeverities(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected map[string]Severity
+		wantErr  bool
+	}{
+		{
+			name:     "Empty",
+			input:    "",
+			expected: map[string]Severity{},
+		},
+		{
+			name:  "Multiple rules",
+			input: "dangling-cable=fatal, duplicate-ip=info",
+			expected: map[string]Severity{
+				"dangling-cable": SeverityFatal,
+				"duplicate-ip":   SeverityInfo,
+			},
+		},
+		{
+			name:    "Unknown severity",
+			input:   "dangling-cable=critical",
+			wantErr: true,
+		},
+		{
+			name:    "Missing severity",
+			input:   "dangling-cable",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := ParseSeverities(test.input)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	api "github.com/bio-routing/bio-rd/net/api"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -28,6 +29,212 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ConnectorPolicy int32
+
+const (
+	// The topology is not rebuilt while the connector is unhealthy
+	ConnectorPolicy_CONNECTOR_POLICY_REQUIRED ConnectorPolicy = 0
+	// The connector is left out while unhealthy
+	ConnectorPolicy_CONNECTOR_POLICY_OPTIONAL_SKIP ConnectorPolicy = 1
+	// The connector contributes the last data it loaded successfully while unhealthy (if supported, left out otherwise)
+	ConnectorPolicy_CONNECTOR_POLICY_OPTIONAL_LAST_GOOD ConnectorPolicy = 2
+)
+
+// Enum value maps for ConnectorPolicy.
+var (
+	ConnectorPolicy_name = map[int32]string{
+		0: "CONNECTOR_POLICY_REQUIRED",
+		1: "CONNECTOR_POLICY_OPTIONAL_SKIP",
+		2: "CONNECTOR_POLICY_OPTIONAL_LAST_GOOD",
+	}
+	ConnectorPolicy_value = map[string]int32{
+		"CONNECTOR_POLICY_REQUIRED":           0,
+		"CONNECTOR_POLICY_OPTIONAL_SKIP":      1,
+		"CONNECTOR_POLICY_OPTIONAL_LAST_GOOD": 2,
+	}
+)
+
+func (x ConnectorPolicy) Enum() *ConnectorPolicy {
+	p := new(ConnectorPolicy)
+	*p = x
+	return p
+}
+
+func (x ConnectorPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectorPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[0].Descriptor()
+}
+
+func (ConnectorPolicy) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[0]
+}
+
+func (x ConnectorPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectorPolicy.Descriptor instead.
+func (ConnectorPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{0}
+}
+
+type ConnectorContribution int32
+
+const (
+	ConnectorContribution_CONNECTOR_CONTRIBUTION_CURRENT   ConnectorContribution = 0
+	ConnectorContribution_CONNECTOR_CONTRIBUTION_LAST_GOOD ConnectorContribution = 1
+	ConnectorContribution_CONNECTOR_CONTRIBUTION_SKIPPED   ConnectorContribution = 2
+)
+
+// Enum value maps for ConnectorContribution.
+var (
+	ConnectorContribution_name = map[int32]string{
+		0: "CONNECTOR_CONTRIBUTION_CURRENT",
+		1: "CONNECTOR_CONTRIBUTION_LAST_GOOD",
+		2: "CONNECTOR_CONTRIBUTION_SKIPPED",
+	}
+	ConnectorContribution_value = map[string]int32{
+		"CONNECTOR_CONTRIBUTION_CURRENT":   0,
+		"CONNECTOR_CONTRIBUTION_LAST_GOOD": 1,
+		"CONNECTOR_CONTRIBUTION_SKIPPED":   2,
+	}
+)
+
+func (x ConnectorContribution) Enum() *ConnectorContribution {
+	p := new(ConnectorContribution)
+	*p = x
+	return p
+}
+
+func (x ConnectorContribution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectorContribution) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[1].Descriptor()
+}
+
+func (ConnectorContribution) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[1]
+}
+
+func (x ConnectorContribution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectorContribution.Descriptor instead.
+func (ConnectorContribution) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{1}
+}
+
+type MergeStrategy int32
+
+const (
+	MergeStrategy_MERGE_STRATEGY_LAST_WRITER_WINS  MergeStrategy = 0
+	MergeStrategy_MERGE_STRATEGY_FIRST_WRITER_WINS MergeStrategy = 1
+	MergeStrategy_MERGE_STRATEGY_PRIORITY          MergeStrategy = 2
+	MergeStrategy_MERGE_STRATEGY_UNION             MergeStrategy = 3
+	MergeStrategy_MERGE_STRATEGY_ERROR_ON_CONFLICT MergeStrategy = 4
+)
+
+// Enum value maps for MergeStrategy.
+var (
+	MergeStrategy_name = map[int32]string{
+		0: "MERGE_STRATEGY_LAST_WRITER_WINS",
+		1: "MERGE_STRATEGY_FIRST_WRITER_WINS",
+		2: "MERGE_STRATEGY_PRIORITY",
+		3: "MERGE_STRATEGY_UNION",
+		4: "MERGE_STRATEGY_ERROR_ON_CONFLICT",
+	}
+	MergeStrategy_value = map[string]int32{
+		"MERGE_STRATEGY_LAST_WRITER_WINS":  0,
+		"MERGE_STRATEGY_FIRST_WRITER_WINS": 1,
+		"MERGE_STRATEGY_PRIORITY":          2,
+		"MERGE_STRATEGY_UNION":             3,
+		"MERGE_STRATEGY_ERROR_ON_CONFLICT": 4,
+	}
+)
+
+func (x MergeStrategy) Enum() *MergeStrategy {
+	p := new(MergeStrategy)
+	*p = x
+	return p
+}
+
+func (x MergeStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[2].Descriptor()
+}
+
+func (MergeStrategy) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[2]
+}
+
+func (x MergeStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeStrategy.Descriptor instead.
+func (MergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{2}
+}
+
+type MergeResolution int32
+
+const (
+	MergeResolution_MERGE_RESOLUTION_REPLACED MergeResolution = 0
+	MergeResolution_MERGE_RESOLUTION_KEPT     MergeResolution = 1
+	// The topology has not been published
+	MergeResolution_MERGE_RESOLUTION_ERROR MergeResolution = 2
+)
+
+// Enum value maps for MergeResolution.
+var (
+	MergeResolution_name = map[int32]string{
+		0: "MERGE_RESOLUTION_REPLACED",
+		1: "MERGE_RESOLUTION_KEPT",
+		2: "MERGE_RESOLUTION_ERROR",
+	}
+	MergeResolution_value = map[string]int32{
+		"MERGE_RESOLUTION_REPLACED": 0,
+		"MERGE_RESOLUTION_KEPT":     1,
+		"MERGE_RESOLUTION_ERROR":    2,
+	}
+)
+
+func (x MergeResolution) Enum() *MergeResolution {
+	p := new(MergeResolution)
+	*p = x
+	return p
+}
+
+func (x MergeResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[3].Descriptor()
+}
+
+func (MergeResolution) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[3]
+}
+
+func (x MergeResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeResolution.Descriptor instead.
+func (MergeResolution) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{3}
+}
+
 type CableEndpointType int32
 
 const (
@@ -67,11 +274,11 @@ func (x CableEndpointType) String() string {
 }
 
 func (CableEndpointType) Descriptor() protoreflect.EnumDescriptor {
-	return file_octopus_proto_enumTypes[0].Descriptor()
+	return file_octopus_proto_enumTypes[4].Descriptor()
 }
 
 func (CableEndpointType) Type() protoreflect.EnumType {
-	return &file_octopus_proto_enumTypes[0]
+	return &file_octopus_proto_enumTypes[4]
 }
 
 func (x CableEndpointType) Number() protoreflect.EnumNumber {
@@ -80,242 +287,512 @@ func (x CableEndpointType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CableEndpointType.Descriptor instead.
 func (CableEndpointType) EnumDescriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{0}
+	return file_octopus_proto_rawDescGZIP(), []int{4}
 }
 
-//
-// Messages for data types
-type Topology struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type PathSegmentType int32
 
-	Timestamp uint64     `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Sites     []*Site    `protobuf:"bytes,2,rep,name=sites,proto3" json:"sites,omitempty"`
-	Pops      []*Pop     `protobuf:"bytes,3,rep,name=pops,proto3" json:"pops,omitempty"`
-	Colos     []*Colo    `protobuf:"bytes,4,rep,name=colos,proto3" json:"colos,omitempty"`
-	Devices   []*Device  `protobuf:"bytes,5,rep,name=devices,proto3" json:"devices,omitempty"`
-	Cables    []*Cable   `protobuf:"bytes,6,rep,name=cables,proto3" json:"cables,omitempty"`
-	Prefixes  []*Prefix  `protobuf:"bytes,7,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
-	Circuits  []*Circuit `protobuf:"bytes,8,rep,name=circuits,proto3" json:"circuits,omitempty"`
-}
+const (
+	PathSegmentType_PATH_SEGMENT_TYPE_UNSPECIFIED PathSegmentType = 0
+	// A cable between a_end and b_end
+	PathSegmentType_PATH_SEGMENT_TYPE_CABLE PathSegmentType = 1
+	// The mapping between a front port and its rear port (or vice versa) within a device
+	PathSegmentType_PATH_SEGMENT_TYPE_PORT_MAPPING PathSegmentType = 2
+	// A circuit between its A and Z termination
+	PathSegmentType_PATH_SEGMENT_TYPE_CIRCUIT PathSegmentType = 3
+)
 
-func (x *Topology) Reset() {
-	*x = Topology{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for PathSegmentType.
+var (
+	PathSegmentType_name = map[int32]string{
+		0: "PATH_SEGMENT_TYPE_UNSPECIFIED",
+		1: "PATH_SEGMENT_TYPE_CABLE",
+		2: "PATH_SEGMENT_TYPE_PORT_MAPPING",
+		3: "PATH_SEGMENT_TYPE_CIRCUIT",
 	}
-}
+	PathSegmentType_value = map[string]int32{
+		"PATH_SEGMENT_TYPE_UNSPECIFIED":  0,
+		"PATH_SEGMENT_TYPE_CABLE":        1,
+		"PATH_SEGMENT_TYPE_PORT_MAPPING": 2,
+		"PATH_SEGMENT_TYPE_CIRCUIT":      3,
+	}
+)
 
-func (x *Topology) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PathSegmentType) Enum() *PathSegmentType {
+	p := new(PathSegmentType)
+	*p = x
+	return p
 }
 
-func (*Topology) ProtoMessage() {}
+func (x PathSegmentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *Topology) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (PathSegmentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[5].Descriptor()
 }
 
-// Deprecated: Use Topology.ProtoReflect.Descriptor instead.
-func (*Topology) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{0}
+func (PathSegmentType) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[5]
 }
 
-func (x *Topology) GetTimestamp() uint64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+func (x PathSegmentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Topology) GetSites() []*Site {
-	if x != nil {
-		return x.Sites
-	}
-	return nil
+// Deprecated: Use PathSegmentType.Descriptor instead.
+func (PathSegmentType) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Topology) GetPops() []*Pop {
-	if x != nil {
-		return x.Pops
+type TopologyEventType int32
+
+const (
+	TopologyEventType_TOPOLOGY_EVENT_TYPE_UNSPECIFIED TopologyEventType = 0
+	TopologyEventType_TOPOLOGY_EVENT_TYPE_ADD         TopologyEventType = 1
+	TopologyEventType_TOPOLOGY_EVENT_TYPE_UPDATE      TopologyEventType = 2
+	TopologyEventType_TOPOLOGY_EVENT_TYPE_DELETE      TopologyEventType = 3
+)
+
+// Enum value maps for TopologyEventType.
+var (
+	TopologyEventType_name = map[int32]string{
+		0: "TOPOLOGY_EVENT_TYPE_UNSPECIFIED",
+		1: "TOPOLOGY_EVENT_TYPE_ADD",
+		2: "TOPOLOGY_EVENT_TYPE_UPDATE",
+		3: "TOPOLOGY_EVENT_TYPE_DELETE",
 	}
-	return nil
+	TopologyEventType_value = map[string]int32{
+		"TOPOLOGY_EVENT_TYPE_UNSPECIFIED": 0,
+		"TOPOLOGY_EVENT_TYPE_ADD":         1,
+		"TOPOLOGY_EVENT_TYPE_UPDATE":      2,
+		"TOPOLOGY_EVENT_TYPE_DELETE":      3,
+	}
+)
+
+func (x TopologyEventType) Enum() *TopologyEventType {
+	p := new(TopologyEventType)
+	*p = x
+	return p
 }
 
-func (x *Topology) GetColos() []*Colo {
-	if x != nil {
-		return x.Colos
-	}
-	return nil
+func (x TopologyEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Topology) GetDevices() []*Device {
-	if x != nil {
-		return x.Devices
-	}
-	return nil
+func (TopologyEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[6].Descriptor()
 }
 
-func (x *Topology) GetCables() []*Cable {
-	if x != nil {
-		return x.Cables
-	}
-	return nil
+func (TopologyEventType) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[6]
 }
 
-func (x *Topology) GetPrefixes() []*Prefix {
-	if x != nil {
-		return x.Prefixes
-	}
-	return nil
+func (x TopologyEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Topology) GetCircuits() []*Circuit {
-	if x != nil {
-		return x.Circuits
-	}
-	return nil
+// Deprecated: Use TopologyEventType.Descriptor instead.
+func (TopologyEventType) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{6}
 }
 
-type Site struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type DiffType int32
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
+const (
+	DiffType_DIFF_TYPE_UNSPECIFIED DiffType = 0
+	DiffType_DIFF_TYPE_ADDED       DiffType = 1
+	DiffType_DIFF_TYPE_REMOVED     DiffType = 2
+	DiffType_DIFF_TYPE_MODIFIED    DiffType = 3
+)
 
-func (x *Site) Reset() {
-	*x = Site{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for DiffType.
+var (
+	DiffType_name = map[int32]string{
+		0: "DIFF_TYPE_UNSPECIFIED",
+		1: "DIFF_TYPE_ADDED",
+		2: "DIFF_TYPE_REMOVED",
+		3: "DIFF_TYPE_MODIFIED",
 	}
-}
+	DiffType_value = map[string]int32{
+		"DIFF_TYPE_UNSPECIFIED": 0,
+		"DIFF_TYPE_ADDED":       1,
+		"DIFF_TYPE_REMOVED":     2,
+		"DIFF_TYPE_MODIFIED":    3,
+	}
+)
 
-func (x *Site) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DiffType) Enum() *DiffType {
+	p := new(DiffType)
+	*p = x
+	return p
 }
 
-func (*Site) ProtoMessage() {}
-
-func (x *Site) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x DiffType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use Site.ProtoReflect.Descriptor instead.
-func (*Site) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{1}
+func (DiffType) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[7].Descriptor()
 }
 
-func (x *Site) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (DiffType) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[7]
 }
 
-type Pop struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x DiffType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+// Deprecated: Use DiffType.Descriptor instead.
+func (DiffType) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Pop) Reset() {
-	*x = Pop{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Services and related messages
+type TopologyEncoding int32
+
+const (
+	// The topology is returned in the topology field
+	TopologyEncoding_TOPOLOGY_ENCODING_NONE TopologyEncoding = 0
+	// The marshalled topology is returned compressed in the encoded_topology field
+	TopologyEncoding_TOPOLOGY_ENCODING_GZIP TopologyEncoding = 1
+	TopologyEncoding_TOPOLOGY_ENCODING_ZSTD TopologyEncoding = 2
+)
+
+// Enum value maps for TopologyEncoding.
+var (
+	TopologyEncoding_name = map[int32]string{
+		0: "TOPOLOGY_ENCODING_NONE",
+		1: "TOPOLOGY_ENCODING_GZIP",
+		2: "TOPOLOGY_ENCODING_ZSTD",
 	}
+	TopologyEncoding_value = map[string]int32{
+		"TOPOLOGY_ENCODING_NONE": 0,
+		"TOPOLOGY_ENCODING_GZIP": 1,
+		"TOPOLOGY_ENCODING_ZSTD": 2,
+	}
+)
+
+func (x TopologyEncoding) Enum() *TopologyEncoding {
+	p := new(TopologyEncoding)
+	*p = x
+	return p
 }
 
-func (x *Pop) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TopologyEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Pop) ProtoMessage() {}
+func (TopologyEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[8].Descriptor()
+}
 
-func (x *Pop) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (TopologyEncoding) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[8]
+}
+
+func (x TopologyEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopologyEncoding.Descriptor instead.
+func (TopologyEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{8}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_GRAPHML ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_DOT     ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_GEXF    ExportFormat = 2
+	// JSON Graph Format
+	ExportFormat_EXPORT_FORMAT_JGF ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_GRAPHML",
+		1: "EXPORT_FORMAT_DOT",
+		2: "EXPORT_FORMAT_GEXF",
+		3: "EXPORT_FORMAT_JGF",
 	}
-	return mi.MessageOf(x)
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_GRAPHML": 0,
+		"EXPORT_FORMAT_DOT":     1,
+		"EXPORT_FORMAT_GEXF":    2,
+		"EXPORT_FORMAT_JGF":     3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Pop.ProtoReflect.Descriptor instead.
-func (*Pop) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{2}
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Pop) GetName() string {
-	if x != nil {
-		return x.Name
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[9].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[9]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{9}
+}
+
+type ExportEdges int32
+
+const (
+	ExportEdges_EXPORT_EDGES_CABLES ExportEdges = 0
+	// Paths between interfaces through patch panels and circuits (logical links)
+	ExportEdges_EXPORT_EDGES_PATHS ExportEdges = 1
+	ExportEdges_EXPORT_EDGES_ALL   ExportEdges = 2
+)
+
+// Enum value maps for ExportEdges.
+var (
+	ExportEdges_name = map[int32]string{
+		0: "EXPORT_EDGES_CABLES",
+		1: "EXPORT_EDGES_PATHS",
+		2: "EXPORT_EDGES_ALL",
 	}
-	return ""
+	ExportEdges_value = map[string]int32{
+		"EXPORT_EDGES_CABLES": 0,
+		"EXPORT_EDGES_PATHS":  1,
+		"EXPORT_EDGES_ALL":    2,
+	}
+)
+
+func (x ExportEdges) Enum() *ExportEdges {
+	p := new(ExportEdges)
+	*p = x
+	return p
 }
 
-type Colo struct {
+func (x ExportEdges) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportEdges) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[10].Descriptor()
+}
+
+func (ExportEdges) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[10]
+}
+
+func (x ExportEdges) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportEdges.Descriptor instead.
+func (ExportEdges) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{10}
+}
+
+type FilterOperator int32
+
+const (
+	FilterOperator_FILTER_OPERATOR_AND FilterOperator = 0
+	FilterOperator_FILTER_OPERATOR_OR  FilterOperator = 1
+)
+
+// Enum value maps for FilterOperator.
+var (
+	FilterOperator_name = map[int32]string{
+		0: "FILTER_OPERATOR_AND",
+		1: "FILTER_OPERATOR_OR",
+	}
+	FilterOperator_value = map[string]int32{
+		"FILTER_OPERATOR_AND": 0,
+		"FILTER_OPERATOR_OR":  1,
+	}
+)
+
+func (x FilterOperator) Enum() *FilterOperator {
+	p := new(FilterOperator)
+	*p = x
+	return p
+}
+
+func (x FilterOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[11].Descriptor()
+}
+
+func (FilterOperator) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[11]
+}
+
+func (x FilterOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterOperator.Descriptor instead.
+func (FilterOperator) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{11}
+}
+
+type NameMatchType int32
+
+const (
+	NameMatchType_NAME_MATCH_TYPE_EXACT NameMatchType = 0
+	NameMatchType_NAME_MATCH_TYPE_GLOB  NameMatchType = 1
+	NameMatchType_NAME_MATCH_TYPE_REGEX NameMatchType = 2
+)
+
+// Enum value maps for NameMatchType.
+var (
+	NameMatchType_name = map[int32]string{
+		0: "NAME_MATCH_TYPE_EXACT",
+		1: "NAME_MATCH_TYPE_GLOB",
+		2: "NAME_MATCH_TYPE_REGEX",
+	}
+	NameMatchType_value = map[string]int32{
+		"NAME_MATCH_TYPE_EXACT": 0,
+		"NAME_MATCH_TYPE_GLOB":  1,
+		"NAME_MATCH_TYPE_REGEX": 2,
+	}
+)
+
+func (x NameMatchType) Enum() *NameMatchType {
+	p := new(NameMatchType)
+	*p = x
+	return p
+}
+
+func (x NameMatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NameMatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[12].Descriptor()
+}
+
+func (NameMatchType) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[12]
+}
+
+func (x NameMatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NameMatchType.Descriptor instead.
+func (NameMatchType) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{12}
+}
+
+type ValidationSeverity int32
+
+const (
+	ValidationSeverity_VALIDATION_SEVERITY_INFO    ValidationSeverity = 0
+	ValidationSeverity_VALIDATION_SEVERITY_WARNING ValidationSeverity = 1
+	ValidationSeverity_VALIDATION_SEVERITY_ERROR   ValidationSeverity = 2
+	// Violations block publishing the topology
+	ValidationSeverity_VALIDATION_SEVERITY_FATAL ValidationSeverity = 3
+)
+
+// Enum value maps for ValidationSeverity.
+var (
+	ValidationSeverity_name = map[int32]string{
+		0: "VALIDATION_SEVERITY_INFO",
+		1: "VALIDATION_SEVERITY_WARNING",
+		2: "VALIDATION_SEVERITY_ERROR",
+		3: "VALIDATION_SEVERITY_FATAL",
+	}
+	ValidationSeverity_value = map[string]int32{
+		"VALIDATION_SEVERITY_INFO":    0,
+		"VALIDATION_SEVERITY_WARNING": 1,
+		"VALIDATION_SEVERITY_ERROR":   2,
+		"VALIDATION_SEVERITY_FATAL":   3,
+	}
+)
+
+func (x ValidationSeverity) Enum() *ValidationSeverity {
+	p := new(ValidationSeverity)
+	*p = x
+	return p
+}
+
+func (x ValidationSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValidationSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_octopus_proto_enumTypes[13].Descriptor()
+}
+
+func (ValidationSeverity) Type() protoreflect.EnumType {
+	return &file_octopus_proto_enumTypes[13]
+}
+
+func (x ValidationSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValidationSeverity.Descriptor instead.
+func (ValidationSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{13}
+}
+
+// Messages for data types
+type Topology struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Status    string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // enum?
-	Region    string   `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	Tier      uint32   `protobuf:"varint,5,opt,name=tier,proto3" json:"tier,omitempty"`
-	Animal    string   `protobuf:"bytes,6,opt,name=animal,proto3" json:"animal,omitempty"`
-	IsMcp     bool     `protobuf:"varint,7,opt,name=is_mcp,json=isMcp,proto3" json:"is_mcp,omitempty"`
-	IsFedramp bool     `protobuf:"varint,8,opt,name=is_fedramp,json=isFedramp,proto3" json:"is_fedramp,omitempty"`
-	Pop       string   `protobuf:"bytes,9,opt,name=pop,proto3" json:"pop,omitempty"`
-	Sites     []string `protobuf:"bytes,10,rep,name=sites,proto3" json:"sites,omitempty"`
+	Timestamp    uint64         `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Sites        []*Site        `protobuf:"bytes,2,rep,name=sites,proto3" json:"sites,omitempty"`
+	Pops         []*Pop         `protobuf:"bytes,3,rep,name=pops,proto3" json:"pops,omitempty"`
+	Colos        []*Colo        `protobuf:"bytes,4,rep,name=colos,proto3" json:"colos,omitempty"`
+	Devices      []*Device      `protobuf:"bytes,5,rep,name=devices,proto3" json:"devices,omitempty"`
+	Cables       []*Cable       `protobuf:"bytes,6,rep,name=cables,proto3" json:"cables,omitempty"`
+	Prefixes     []*Prefix      `protobuf:"bytes,7,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+	Circuits     []*Circuit     `protobuf:"bytes,8,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	Generation   uint64         `protobuf:"varint,9,opt,name=generation,proto3" json:"generation,omitempty"`
+	LogicalLinks []*LogicalLink `protobuf:"bytes,10,rep,name=logical_links,json=logicalLinks,proto3" json:"logical_links,omitempty"`
+	// Set if the topology has been restored from disk at startup and not been rebuilt from the connectors since
+	Stale bool `protobuf:"varint,11,opt,name=stale,proto3" json:"stale,omitempty"`
+	// The connectors the topology has been built from, in the order they enriched it
+	Connectors []*ConnectorProvenance `protobuf:"bytes,12,rep,name=connectors,proto3" json:"connectors,omitempty"`
+	// Attributes connectors set to different values, only included along with the provenance
+	MergeConflicts []*MergeConflict `protobuf:"bytes,13,rep,name=merge_conflicts,json=mergeConflicts,proto3" json:"merge_conflicts,omitempty"`
 }
 
-func (x *Colo) Reset() {
-	*x = Colo{}
+func (x *Topology) Reset() {
+	*x = Topology{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[3]
+		mi := &file_octopus_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Colo) String() string {
+func (x *Topology) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Colo) ProtoMessage() {}
+func (*Topology) ProtoMessage() {}
 
-func (x *Colo) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[3]
+func (x *Topology) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,116 +803,133 @@ func (x *Colo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Colo.ProtoReflect.Descriptor instead.
-func (*Colo) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Topology.ProtoReflect.Descriptor instead.
+func (*Topology) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Colo) GetId() uint32 {
+func (x *Topology) GetTimestamp() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *Colo) GetName() string {
+func (x *Topology) GetSites() []*Site {
 	if x != nil {
-		return x.Name
+		return x.Sites
 	}
-	return ""
+	return nil
 }
 
-func (x *Colo) GetStatus() string {
+func (x *Topology) GetPops() []*Pop {
 	if x != nil {
-		return x.Status
+		return x.Pops
 	}
-	return ""
+	return nil
 }
 
-func (x *Colo) GetRegion() string {
+func (x *Topology) GetColos() []*Colo {
 	if x != nil {
-		return x.Region
+		return x.Colos
 	}
-	return ""
+	return nil
 }
 
-func (x *Colo) GetTier() uint32 {
+func (x *Topology) GetDevices() []*Device {
 	if x != nil {
-		return x.Tier
+		return x.Devices
 	}
-	return 0
+	return nil
 }
 
-func (x *Colo) GetAnimal() string {
+func (x *Topology) GetCables() []*Cable {
 	if x != nil {
-		return x.Animal
+		return x.Cables
 	}
-	return ""
+	return nil
 }
 
-func (x *Colo) GetIsMcp() bool {
+func (x *Topology) GetPrefixes() []*Prefix {
 	if x != nil {
-		return x.IsMcp
+		return x.Prefixes
 	}
-	return false
+	return nil
 }
 
-func (x *Colo) GetIsFedramp() bool {
+func (x *Topology) GetCircuits() []*Circuit {
 	if x != nil {
-		return x.IsFedramp
+		return x.Circuits
 	}
-	return false
+	return nil
 }
 
-func (x *Colo) GetPop() string {
+func (x *Topology) GetGeneration() uint64 {
 	if x != nil {
-		return x.Pop
+		return x.Generation
 	}
-	return ""
+	return 0
 }
 
-func (x *Colo) GetSites() []string {
+func (x *Topology) GetLogicalLinks() []*LogicalLink {
 	if x != nil {
-		return x.Sites
+		return x.LogicalLinks
 	}
 	return nil
 }
 
-type Device struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name       string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status     string       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Role       string       `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	Platform   string       `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
-	ColoId     int32        `protobuf:"varint,5,opt,name=colo_id,json=coloId,proto3" json:"colo_id,omitempty"`
-	SiteName   string       `protobuf:"bytes,6,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
-	Interfaces []*Interface `protobuf:"bytes,7,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
-	FrontPorts []*FrontPort `protobuf:"bytes,8,rep,name=front_ports,json=frontPorts,proto3" json:"front_ports,omitempty"`
-	RearPorts  []*RearPort  `protobuf:"bytes,9,rep,name=rear_ports,json=rearPorts,proto3" json:"rear_ports,omitempty"`
-	DeviceType string       `protobuf:"bytes,11,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	MetaData   *MetaData    `protobuf:"bytes,12,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+func (x *Topology) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
 }
 
-func (x *Device) Reset() {
-	*x = Device{}
+func (x *Topology) GetConnectors() []*ConnectorProvenance {
+	if x != nil {
+		return x.Connectors
+	}
+	return nil
+}
+
+func (x *Topology) GetMergeConflicts() []*MergeConflict {
+	if x != nil {
+		return x.MergeConflicts
+	}
+	return nil
+}
+
+type ConnectorProvenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Connector    string                `protobuf:"bytes,1,opt,name=connector,proto3" json:"connector,omitempty"`
+	Policy       ConnectorPolicy       `protobuf:"varint,2,opt,name=policy,proto3,enum=cloudflare.net.octopus.ConnectorPolicy" json:"policy,omitempty"`
+	Contribution ConnectorContribution `protobuf:"varint,3,opt,name=contribution,proto3,enum=cloudflare.net.octopus.ConnectorContribution" json:"contribution,omitempty"`
+	// Timestamp (epoch) when the connector data was loaded
+	LoadTime uint64 `protobuf:"varint,4,opt,name=load_time,json=loadTime,proto3" json:"load_time,omitempty"`
+	// Age of the connector data (seconds) when the topology was built
+	DataAge uint64 `protobuf:"varint,5,opt,name=data_age,json=dataAge,proto3" json:"data_age,omitempty"`
+}
+
+func (x *ConnectorProvenance) Reset() {
+	*x = ConnectorProvenance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[4]
+		mi := &file_octopus_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Device) String() string {
+func (x *ConnectorProvenance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Device) ProtoMessage() {}
+func (*ConnectorProvenance) ProtoMessage() {}
 
-func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[4]
+func (x *ConnectorProvenance) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,117 +940,139 @@ func (x *Device) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Device.ProtoReflect.Descriptor instead.
-func (*Device) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ConnectorProvenance.ProtoReflect.Descriptor instead.
+func (*ConnectorProvenance) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Device) GetName() string {
+func (x *ConnectorProvenance) GetConnector() string {
 	if x != nil {
-		return x.Name
+		return x.Connector
 	}
 	return ""
 }
 
-func (x *Device) GetStatus() string {
+func (x *ConnectorProvenance) GetPolicy() ConnectorPolicy {
 	if x != nil {
-		return x.Status
+		return x.Policy
 	}
-	return ""
+	return ConnectorPolicy_CONNECTOR_POLICY_REQUIRED
 }
 
-func (x *Device) GetRole() string {
+func (x *ConnectorProvenance) GetContribution() ConnectorContribution {
 	if x != nil {
-		return x.Role
+		return x.Contribution
 	}
-	return ""
+	return ConnectorContribution_CONNECTOR_CONTRIBUTION_CURRENT
 }
 
-func (x *Device) GetPlatform() string {
+func (x *ConnectorProvenance) GetLoadTime() uint64 {
 	if x != nil {
-		return x.Platform
+		return x.LoadTime
 	}
-	return ""
+	return 0
 }
 
-func (x *Device) GetColoId() int32 {
+func (x *ConnectorProvenance) GetDataAge() uint64 {
 	if x != nil {
-		return x.ColoId
+		return x.DataAge
 	}
 	return 0
 }
 
-func (x *Device) GetSiteName() string {
-	if x != nil {
-		return x.SiteName
-	}
-	return ""
+// Where an attribute value comes from, keyed by the name of the attribute in the provenance maps (e.g. "role", "meta_data.tags.<tag>",
+// "meta_data.semantic_tags.<key>", "ip_addresses.<address>"). "name" refers to the connector which added the object.
+type Source struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Connector string `protobuf:"bytes,1,opt,name=connector,proto3" json:"connector,omitempty"`
+	// ID of the object in the data source of the connector, e.g. "dcim.device:42" for NetBox
+	ObjectId string `protobuf:"bytes,2,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
 }
 
-func (x *Device) GetInterfaces() []*Interface {
-	if x != nil {
-		return x.Interfaces
+func (x *Source) Reset() {
+	*x = Source{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Device) GetFrontPorts() []*FrontPort {
-	if x != nil {
-		return x.FrontPorts
-	}
-	return nil
+func (x *Source) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Device) GetRearPorts() []*RearPort {
-	if x != nil {
-		return x.RearPorts
+func (*Source) ProtoMessage() {}
+
+func (x *Source) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Device) GetDeviceType() string {
+// Deprecated: Use Source.ProtoReflect.Descriptor instead.
+func (*Source) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Source) GetConnector() string {
 	if x != nil {
-		return x.DeviceType
+		return x.Connector
 	}
 	return ""
 }
 
-func (x *Device) GetMetaData() *MetaData {
+func (x *Source) GetObjectId() string {
 	if x != nil {
-		return x.MetaData
+		return x.ObjectId
 	}
-	return nil
+	return ""
 }
 
-type Interface struct {
+type MergeConflict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Units       []*InterfaceUnit `protobuf:"bytes,2,rep,name=units,proto3" json:"units,omitempty"`
-	LagMemberOf string           `protobuf:"bytes,3,opt,name=lag_member_of,json=lagMemberOf,proto3" json:"lag_member_of,omitempty"`
-	Type        string           `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	MetaData    *MetaData        `protobuf:"bytes,6,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
-}
-
-func (x *Interface) Reset() {
-	*x = Interface{}
+	// device, interface, unit, ip, cable, circuit or prefix
+	Entity string `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// Identifies the object, e.g. "ccr01.dus01:et-0/0/0" for an interface
+	Key           string          `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Field         string          `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Strategy      MergeStrategy   `protobuf:"varint,4,opt,name=strategy,proto3,enum=cloudflare.net.octopus.MergeStrategy" json:"strategy,omitempty"`
+	Resolution    MergeResolution `protobuf:"varint,5,opt,name=resolution,proto3,enum=cloudflare.net.octopus.MergeResolution" json:"resolution,omitempty"`
+	Existing      *Source         `protobuf:"bytes,6,opt,name=existing,proto3" json:"existing,omitempty"`
+	ExistingValue string          `protobuf:"bytes,7,opt,name=existing_value,json=existingValue,proto3" json:"existing_value,omitempty"`
+	Incoming      *Source         `protobuf:"bytes,8,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	IncomingValue string          `protobuf:"bytes,9,opt,name=incoming_value,json=incomingValue,proto3" json:"incoming_value,omitempty"`
+}
+
+func (x *MergeConflict) Reset() {
+	*x = MergeConflict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[5]
+		mi := &file_octopus_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Interface) String() string {
+func (x *MergeConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Interface) ProtoMessage() {}
+func (*MergeConflict) ProtoMessage() {}
 
-func (x *Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[5]
+func (x *MergeConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,73 +1083,101 @@ func (x *Interface) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
-func (*Interface) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MergeConflict.ProtoReflect.Descriptor instead.
+func (*MergeConflict) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Interface) GetName() string {
+func (x *MergeConflict) GetEntity() string {
 	if x != nil {
-		return x.Name
+		return x.Entity
 	}
 	return ""
 }
 
-func (x *Interface) GetUnits() []*InterfaceUnit {
+func (x *MergeConflict) GetKey() string {
 	if x != nil {
-		return x.Units
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *Interface) GetLagMemberOf() string {
+func (x *MergeConflict) GetField() string {
 	if x != nil {
-		return x.LagMemberOf
+		return x.Field
 	}
 	return ""
 }
 
-func (x *Interface) GetType() string {
+func (x *MergeConflict) GetStrategy() MergeStrategy {
 	if x != nil {
-		return x.Type
+		return x.Strategy
+	}
+	return MergeStrategy_MERGE_STRATEGY_LAST_WRITER_WINS
+}
+
+func (x *MergeConflict) GetResolution() MergeResolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return MergeResolution_MERGE_RESOLUTION_REPLACED
+}
+
+func (x *MergeConflict) GetExisting() *Source {
+	if x != nil {
+		return x.Existing
+	}
+	return nil
+}
+
+func (x *MergeConflict) GetExistingValue() string {
+	if x != nil {
+		return x.ExistingValue
 	}
 	return ""
 }
 
-func (x *Interface) GetMetaData() *MetaData {
+func (x *MergeConflict) GetIncoming() *Source {
 	if x != nil {
-		return x.MetaData
+		return x.Incoming
 	}
 	return nil
 }
 
-type FrontPort struct {
+func (x *MergeConflict) GetIncomingValue() string {
+	if x != nil {
+		return x.IncomingValue
+	}
+	return ""
+}
+
+type ConnectorLoadTime struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	RearPort         string `protobuf:"bytes,2,opt,name=rear_port,json=rearPort,proto3" json:"rear_port,omitempty"`
-	RearPortPosition uint32 `protobuf:"varint,3,opt,name=rear_port_position,json=rearPortPosition,proto3" json:"rear_port_position,omitempty"`
+	Connector string `protobuf:"bytes,1,opt,name=connector,proto3" json:"connector,omitempty"`
+	// Timestamp (epoch) when the connector data was loaded
+	LoadTime uint64 `protobuf:"varint,2,opt,name=load_time,json=loadTime,proto3" json:"load_time,omitempty"`
 }
 
-func (x *FrontPort) Reset() {
-	*x = FrontPort{}
+func (x *ConnectorLoadTime) Reset() {
+	*x = ConnectorLoadTime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[6]
+		mi := &file_octopus_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FrontPort) String() string {
+func (x *ConnectorLoadTime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontPort) ProtoMessage() {}
+func (*ConnectorLoadTime) ProtoMessage() {}
 
-func (x *FrontPort) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[6]
+func (x *ConnectorLoadTime) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,58 +1188,52 @@ func (x *FrontPort) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontPort.ProtoReflect.Descriptor instead.
-func (*FrontPort) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *FrontPort) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ConnectorLoadTime.ProtoReflect.Descriptor instead.
+func (*ConnectorLoadTime) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *FrontPort) GetRearPort() string {
+func (x *ConnectorLoadTime) GetConnector() string {
 	if x != nil {
-		return x.RearPort
+		return x.Connector
 	}
 	return ""
 }
 
-func (x *FrontPort) GetRearPortPosition() uint32 {
+func (x *ConnectorLoadTime) GetLoadTime() uint64 {
 	if x != nil {
-		return x.RearPortPosition
+		return x.LoadTime
 	}
 	return 0
 }
 
-type RearPort struct {
+// A topology snapshot as persisted to disk
+type PersistedSnapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Positions uint32 `protobuf:"varint,2,opt,name=positions,proto3" json:"positions,omitempty"`
+	Topology           *Topology            `protobuf:"bytes,1,opt,name=topology,proto3" json:"topology,omitempty"`
+	ConnectorLoadTimes []*ConnectorLoadTime `protobuf:"bytes,2,rep,name=connector_load_times,json=connectorLoadTimes,proto3" json:"connector_load_times,omitempty"`
 }
 
-func (x *RearPort) Reset() {
-	*x = RearPort{}
+func (x *PersistedSnapshot) Reset() {
+	*x = PersistedSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[7]
+		mi := &file_octopus_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RearPort) String() string {
+func (x *PersistedSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RearPort) ProtoMessage() {}
+func (*PersistedSnapshot) ProtoMessage() {}
 
-func (x *RearPort) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[7]
+func (x *PersistedSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,58 +1244,50 @@ func (x *RearPort) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RearPort.ProtoReflect.Descriptor instead.
-func (*RearPort) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PersistedSnapshot.ProtoReflect.Descriptor instead.
+func (*PersistedSnapshot) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RearPort) GetName() string {
+func (x *PersistedSnapshot) GetTopology() *Topology {
 	if x != nil {
-		return x.Name
+		return x.Topology
 	}
-	return ""
+	return nil
 }
 
-func (x *RearPort) GetPositions() uint32 {
+func (x *PersistedSnapshot) GetConnectorLoadTimes() []*ConnectorLoadTime {
 	if x != nil {
-		return x.Positions
+		return x.ConnectorLoadTimes
 	}
-	return 0
+	return nil
 }
 
-type InterfaceUnit struct {
+type Site struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//
-	//The unit ID MAY refer to a VLAN ID if 802.1q or 802.1ad tagging is used (and will contain the same value as the inner_tag is so).
-	//For some interface types (GRE tunnels for example), the ID does NOT reflect a VLAN ID.
-	Id            uint32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Ipv4Addresses []*IPAddress `protobuf:"bytes,2,rep,name=ipv4_addresses,json=ipv4Addresses,proto3" json:"ipv4_addresses,omitempty"`
-	Ipv6Addresses []*IPAddress `protobuf:"bytes,3,rep,name=ipv6_addresses,json=ipv6Addresses,proto3" json:"ipv6_addresses,omitempty"`
-	OuterTag      uint32       `protobuf:"varint,5,opt,name=outer_tag,json=outerTag,proto3" json:"outer_tag,omitempty"`
-	InnerTag      uint32       `protobuf:"varint,6,opt,name=inner_tag,json=innerTag,proto3" json:"inner_tag,omitempty"`
-	MetaData      *MetaData    `protobuf:"bytes,7,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *InterfaceUnit) Reset() {
-	*x = InterfaceUnit{}
+func (x *Site) Reset() {
+	*x = Site{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[8]
+		mi := &file_octopus_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InterfaceUnit) String() string {
+func (x *Site) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceUnit) ProtoMessage() {}
+func (*Site) ProtoMessage() {}
 
-func (x *InterfaceUnit) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[8]
+func (x *Site) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,79 +1298,43 @@ func (x *InterfaceUnit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceUnit.ProtoReflect.Descriptor instead.
-func (*InterfaceUnit) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Site.ProtoReflect.Descriptor instead.
+func (*Site) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *InterfaceUnit) GetId() uint32 {
+func (x *Site) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *InterfaceUnit) GetIpv4Addresses() []*IPAddress {
-	if x != nil {
-		return x.Ipv4Addresses
-	}
-	return nil
+type Pop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *InterfaceUnit) GetIpv6Addresses() []*IPAddress {
-	if x != nil {
-		return x.Ipv6Addresses
-	}
-	return nil
-}
-
-func (x *InterfaceUnit) GetOuterTag() uint32 {
-	if x != nil {
-		return x.OuterTag
-	}
-	return 0
-}
-
-func (x *InterfaceUnit) GetInnerTag() uint32 {
-	if x != nil {
-		return x.InnerTag
-	}
-	return 0
-}
-
-func (x *InterfaceUnit) GetMetaData() *MetaData {
-	if x != nil {
-		return x.MetaData
-	}
-	return nil
-}
-
-type IPAddress struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	IP       *api.Prefix `protobuf:"bytes,1,opt,name=IP,proto3" json:"IP,omitempty"`
-	MetaData *MetaData   `protobuf:"bytes,2,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
-}
-
-func (x *IPAddress) Reset() {
-	*x = IPAddress{}
+func (x *Pop) Reset() {
+	*x = Pop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[9]
+		mi := &file_octopus_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IPAddress) String() string {
+func (x *Pop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPAddress) ProtoMessage() {}
+func (*Pop) ProtoMessage() {}
 
-func (x *IPAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[9]
+func (x *Pop) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,54 +1345,54 @@ func (x *IPAddress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPAddress.ProtoReflect.Descriptor instead.
-func (*IPAddress) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *IPAddress) GetIP() *api.Prefix {
-	if x != nil {
-		return x.IP
-	}
-	return nil
+// Deprecated: Use Pop.ProtoReflect.Descriptor instead.
+func (*Pop) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *IPAddress) GetMetaData() *MetaData {
+func (x *Pop) GetName() string {
 	if x != nil {
-		return x.MetaData
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type Circuit struct {
+type Colo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid      string    `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Provider string    `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
-	Type     string    `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status   string    `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	MetaData *MetaData `protobuf:"bytes,6,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	Id        uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status    string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // enum?
+	Region    string   `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Tier      uint32   `protobuf:"varint,5,opt,name=tier,proto3" json:"tier,omitempty"`
+	Animal    string   `protobuf:"bytes,6,opt,name=animal,proto3" json:"animal,omitempty"`
+	IsMcp     bool     `protobuf:"varint,7,opt,name=is_mcp,json=isMcp,proto3" json:"is_mcp,omitempty"`
+	IsFedramp bool     `protobuf:"varint,8,opt,name=is_fedramp,json=isFedramp,proto3" json:"is_fedramp,omitempty"`
+	Pop       string   `protobuf:"bytes,9,opt,name=pop,proto3" json:"pop,omitempty"`
+	Sites     []string `protobuf:"bytes,10,rep,name=sites,proto3" json:"sites,omitempty"`
+	// Which connector set the attributes, only included if requested
+	Provenance map[string]*Source `protobuf:"bytes,11,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Circuit) Reset() {
-	*x = Circuit{}
+func (x *Colo) Reset() {
+	*x = Colo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[10]
+		mi := &file_octopus_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Circuit) String() string {
+func (x *Colo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Circuit) ProtoMessage() {}
+func (*Colo) ProtoMessage() {}
 
-func (x *Circuit) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[10]
+func (x *Colo) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,128 +1403,125 @@ func (x *Circuit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Circuit.ProtoReflect.Descriptor instead.
-func (*Circuit) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Colo.ProtoReflect.Descriptor instead.
+func (*Colo) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Circuit) GetCid() string {
+func (x *Colo) GetId() uint32 {
 	if x != nil {
-		return x.Cid
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Circuit) GetProvider() string {
+func (x *Colo) GetName() string {
 	if x != nil {
-		return x.Provider
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Circuit) GetType() string {
+func (x *Colo) GetStatus() string {
 	if x != nil {
-		return x.Type
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Circuit) GetStatus() string {
+func (x *Colo) GetRegion() string {
 	if x != nil {
-		return x.Status
+		return x.Region
 	}
 	return ""
 }
 
-func (x *Circuit) GetMetaData() *MetaData {
+func (x *Colo) GetTier() uint32 {
 	if x != nil {
-		return x.MetaData
+		return x.Tier
 	}
-	return nil
-}
-
-type Cable struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AEnd *CableEnd `protobuf:"bytes,1,opt,name=a_end,json=aEnd,proto3" json:"a_end,omitempty"`
-	BEnd *CableEnd `protobuf:"bytes,2,opt,name=b_end,json=bEnd,proto3" json:"b_end,omitempty"`
+	return 0
 }
 
-func (x *Cable) Reset() {
-	*x = Cable{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Colo) GetAnimal() string {
+	if x != nil {
+		return x.Animal
 	}
+	return ""
 }
 
-func (x *Cable) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Colo) GetIsMcp() bool {
+	if x != nil {
+		return x.IsMcp
+	}
+	return false
 }
 
-func (*Cable) ProtoMessage() {}
-
-func (x *Cable) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Colo) GetIsFedramp() bool {
+	if x != nil {
+		return x.IsFedramp
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Cable.ProtoReflect.Descriptor instead.
-func (*Cable) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{11}
+func (x *Colo) GetPop() string {
+	if x != nil {
+		return x.Pop
+	}
+	return ""
 }
 
-func (x *Cable) GetAEnd() *CableEnd {
+func (x *Colo) GetSites() []string {
 	if x != nil {
-		return x.AEnd
+		return x.Sites
 	}
 	return nil
 }
 
-func (x *Cable) GetBEnd() *CableEnd {
+func (x *Colo) GetProvenance() map[string]*Source {
 	if x != nil {
-		return x.BEnd
+		return x.Provenance
 	}
 	return nil
 }
 
-type CableEnd struct {
+type Device struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeviceName   string            `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
-	EndpointType CableEndpointType `protobuf:"varint,2,opt,name=endpoint_type,json=endpointType,proto3,enum=cloudflare.net.octopus.CableEndpointType" json:"endpoint_type,omitempty"`
-	EndpointName string            `protobuf:"bytes,3,opt,name=endpoint_name,json=endpointName,proto3" json:"endpoint_name,omitempty"`
+	Name       string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status     string       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Role       string       `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Platform   string       `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	ColoId     int32        `protobuf:"varint,5,opt,name=colo_id,json=coloId,proto3" json:"colo_id,omitempty"`
+	SiteName   string       `protobuf:"bytes,6,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	Interfaces []*Interface `protobuf:"bytes,7,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	FrontPorts []*FrontPort `protobuf:"bytes,8,rep,name=front_ports,json=frontPorts,proto3" json:"front_ports,omitempty"`
+	RearPorts  []*RearPort  `protobuf:"bytes,9,rep,name=rear_ports,json=rearPorts,proto3" json:"rear_ports,omitempty"`
+	DeviceType string       `protobuf:"bytes,11,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	MetaData   *MetaData    `protobuf:"bytes,12,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	// Which connector set the attributes, only included if requested
+	Provenance map[string]*Source `protobuf:"bytes,13,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *CableEnd) Reset() {
-	*x = CableEnd{}
+func (x *Device) Reset() {
+	*x = Device{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[12]
+		mi := &file_octopus_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CableEnd) String() string {
+func (x *Device) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CableEnd) ProtoMessage() {}
+func (*Device) ProtoMessage() {}
 
-func (x *CableEnd) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[12]
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,58 +1532,126 @@ func (x *CableEnd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CableEnd.ProtoReflect.Descriptor instead.
-func (*CableEnd) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CableEnd) GetDeviceName() string {
+func (x *Device) GetName() string {
 	if x != nil {
-		return x.DeviceName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CableEnd) GetEndpointType() CableEndpointType {
+func (x *Device) GetStatus() string {
 	if x != nil {
-		return x.EndpointType
+		return x.Status
 	}
-	return CableEndpointType_CABLE_ENDPOINT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *CableEnd) GetEndpointName() string {
+func (x *Device) GetRole() string {
 	if x != nil {
-		return x.EndpointName
+		return x.Role
 	}
 	return ""
 }
 
-type Prefix struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Prefix   *api.Prefix `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	MetaData *MetaData   `protobuf:"bytes,4,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
-}
-
-func (x *Prefix) Reset() {
-	*x = Prefix{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Device) GetPlatform() string {
+	if x != nil {
+		return x.Platform
 	}
+	return ""
 }
 
-func (x *Prefix) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Device) GetColoId() int32 {
+	if x != nil {
+		return x.ColoId
+	}
+	return 0
 }
 
-func (*Prefix) ProtoMessage() {}
+func (x *Device) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
 
-func (x *Prefix) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[13]
+func (x *Device) GetInterfaces() []*Interface {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *Device) GetFrontPorts() []*FrontPort {
+	if x != nil {
+		return x.FrontPorts
+	}
+	return nil
+}
+
+func (x *Device) GetRearPorts() []*RearPort {
+	if x != nil {
+		return x.RearPorts
+	}
+	return nil
+}
+
+func (x *Device) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *Device) GetMetaData() *MetaData {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *Device) GetProvenance() map[string]*Source {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Units       []*InterfaceUnit `protobuf:"bytes,2,rep,name=units,proto3" json:"units,omitempty"`
+	LagMemberOf string           `protobuf:"bytes,3,opt,name=lag_member_of,json=lagMemberOf,proto3" json:"lag_member_of,omitempty"`
+	Type        string           `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	MetaData    *MetaData        `protobuf:"bytes,6,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	// Which connector set the attributes, only included if requested
+	Provenance map[string]*Source `protobuf:"bytes,7,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Interface) Reset() {
+	*x = Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interface) ProtoMessage() {}
+
+func (x *Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,52 +1662,80 @@ func (x *Prefix) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Prefix.ProtoReflect.Descriptor instead.
-func (*Prefix) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Interface.ProtoReflect.Descriptor instead.
+func (*Interface) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Prefix) GetPrefix() *api.Prefix {
+func (x *Interface) GetName() string {
 	if x != nil {
-		return x.Prefix
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Interface) GetUnits() []*InterfaceUnit {
+	if x != nil {
+		return x.Units
 	}
 	return nil
 }
 
-func (x *Prefix) GetMetaData() *MetaData {
+func (x *Interface) GetLagMemberOf() string {
+	if x != nil {
+		return x.LagMemberOf
+	}
+	return ""
+}
+
+func (x *Interface) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Interface) GetMetaData() *MetaData {
 	if x != nil {
 		return x.MetaData
 	}
 	return nil
 }
 
-type MetaData struct {
+func (x *Interface) GetProvenance() map[string]*Source {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type FrontPort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tags            []string          `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	SemanticTags    map[string]string `protobuf:"bytes,2,rep,name=semantic_tags,json=semanticTags,proto3" json:"semantic_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	CustomFieldData string            `protobuf:"bytes,3,opt,name=custom_field_data,json=customFieldData,proto3" json:"custom_field_data,omitempty"`
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RearPort         string `protobuf:"bytes,2,opt,name=rear_port,json=rearPort,proto3" json:"rear_port,omitempty"`
+	RearPortPosition uint32 `protobuf:"varint,3,opt,name=rear_port_position,json=rearPortPosition,proto3" json:"rear_port_position,omitempty"`
 }
 
-func (x *MetaData) Reset() {
-	*x = MetaData{}
+func (x *FrontPort) Reset() {
+	*x = FrontPort{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[14]
+		mi := &file_octopus_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MetaData) String() string {
+func (x *FrontPort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetaData) ProtoMessage() {}
+func (*FrontPort) ProtoMessage() {}
 
-func (x *MetaData) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[14]
+func (x *FrontPort) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,57 +1746,58 @@ func (x *MetaData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetaData.ProtoReflect.Descriptor instead.
-func (*MetaData) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use FrontPort.ProtoReflect.Descriptor instead.
+func (*FrontPort) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MetaData) GetTags() []string {
+func (x *FrontPort) GetName() string {
 	if x != nil {
-		return x.Tags
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *MetaData) GetSemanticTags() map[string]string {
+func (x *FrontPort) GetRearPort() string {
 	if x != nil {
-		return x.SemanticTags
+		return x.RearPort
 	}
-	return nil
+	return ""
 }
 
-func (x *MetaData) GetCustomFieldData() string {
+func (x *FrontPort) GetRearPortPosition() uint32 {
 	if x != nil {
-		return x.CustomFieldData
+		return x.RearPortPosition
 	}
-	return ""
+	return 0
 }
 
-//
-// Services and related messages
-type TopologyRequest struct {
+type RearPort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Positions uint32 `protobuf:"varint,2,opt,name=positions,proto3" json:"positions,omitempty"`
 }
 
-func (x *TopologyRequest) Reset() {
-	*x = TopologyRequest{}
+func (x *RearPort) Reset() {
+	*x = RearPort{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[15]
+		mi := &file_octopus_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopologyRequest) String() string {
+func (x *RearPort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopologyRequest) ProtoMessage() {}
+func (*RearPort) ProtoMessage() {}
 
-func (x *TopologyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[15]
+func (x *RearPort) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1220,465 +1808,5913 @@ func (x *TopologyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopologyRequest.ProtoReflect.Descriptor instead.
-func (*TopologyRequest) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RearPort.ProtoReflect.Descriptor instead.
+func (*RearPort) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{12}
 }
 
-type TopologyResponse struct {
+func (x *RearPort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RearPort) GetPositions() uint32 {
+	if x != nil {
+		return x.Positions
+	}
+	return 0
+}
+
+type InterfaceUnit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topology *Topology `protobuf:"bytes,1,opt,name=topology,proto3" json:"topology,omitempty"`
+	//
+	//The unit ID MAY refer to a VLAN ID if 802.1q or 802.1ad tagging is used (and will contain the same value as the inner_tag is so).
+	//For some interface types (GRE tunnels for example), the ID does NOT reflect a VLAN ID.
+	Id            uint32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ipv4Addresses []*IPAddress `protobuf:"bytes,2,rep,name=ipv4_addresses,json=ipv4Addresses,proto3" json:"ipv4_addresses,omitempty"`
+	Ipv6Addresses []*IPAddress `protobuf:"bytes,3,rep,name=ipv6_addresses,json=ipv6Addresses,proto3" json:"ipv6_addresses,omitempty"`
+	OuterTag      uint32       `protobuf:"varint,5,opt,name=outer_tag,json=outerTag,proto3" json:"outer_tag,omitempty"`
+	InnerTag      uint32       `protobuf:"varint,6,opt,name=inner_tag,json=innerTag,proto3" json:"inner_tag,omitempty"`
+	MetaData      *MetaData    `protobuf:"bytes,7,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	// Which connector set the attributes, only included if requested
+	Provenance map[string]*Source `protobuf:"bytes,8,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *TopologyResponse) Reset() {
-	*x = TopologyResponse{}
+func (x *InterfaceUnit) Reset() {
+	*x = InterfaceUnit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[16]
+		mi := &file_octopus_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopologyResponse) String() string {
+func (x *InterfaceUnit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopologyResponse) ProtoMessage() {}
+func (*InterfaceUnit) ProtoMessage() {}
 
-func (x *TopologyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[16]
+func (x *InterfaceUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TopologyResponse.ProtoReflect.Descriptor instead.
-func (*TopologyResponse) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *TopologyResponse) GetTopology() *Topology {
-	if x != nil {
-		return x.Topology
-	}
-	return nil
-}
-
-type DeviceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	DeviceName string `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
-}
-
-func (x *DeviceRequest) Reset() {
-	*x = DeviceRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DeviceRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeviceRequest) ProtoMessage() {}
-
-func (x *DeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceUnit.ProtoReflect.Descriptor instead.
+func (*InterfaceUnit) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InterfaceUnit) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InterfaceUnit) GetIpv4Addresses() []*IPAddress {
+	if x != nil {
+		return x.Ipv4Addresses
+	}
+	return nil
+}
+
+func (x *InterfaceUnit) GetIpv6Addresses() []*IPAddress {
+	if x != nil {
+		return x.Ipv6Addresses
+	}
+	return nil
+}
+
+func (x *InterfaceUnit) GetOuterTag() uint32 {
+	if x != nil {
+		return x.OuterTag
+	}
+	return 0
+}
+
+func (x *InterfaceUnit) GetInnerTag() uint32 {
+	if x != nil {
+		return x.InnerTag
+	}
+	return 0
+}
+
+func (x *InterfaceUnit) GetMetaData() *MetaData {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *InterfaceUnit) GetProvenance() map[string]*Source {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type IPAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IP       *api.Prefix `protobuf:"bytes,1,opt,name=IP,proto3" json:"IP,omitempty"`
+	MetaData *MetaData   `protobuf:"bytes,2,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+}
+
+func (x *IPAddress) Reset() {
+	*x = IPAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPAddress) ProtoMessage() {}
+
+func (x *IPAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPAddress.ProtoReflect.Descriptor instead.
+func (*IPAddress) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IPAddress) GetIP() *api.Prefix {
+	if x != nil {
+		return x.IP
+	}
+	return nil
+}
+
+func (x *IPAddress) GetMetaData() *MetaData {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+type Circuit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid      string    `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Provider string    `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Type     string    `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status   string    `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	MetaData *MetaData `protobuf:"bytes,6,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	// Which connector set the attributes, only included if requested
+	Provenance map[string]*Source `protobuf:"bytes,7,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Circuit) Reset() {
+	*x = Circuit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Circuit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Circuit) ProtoMessage() {}
+
+func (x *Circuit) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Circuit.ProtoReflect.Descriptor instead.
+func (*Circuit) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Circuit) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *Circuit) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Circuit) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Circuit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Circuit) GetMetaData() *MetaData {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *Circuit) GetProvenance() map[string]*Source {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type Cable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AEnd *CableEnd `protobuf:"bytes,1,opt,name=a_end,json=aEnd,proto3" json:"a_end,omitempty"`
+	BEnd *CableEnd `protobuf:"bytes,2,opt,name=b_end,json=bEnd,proto3" json:"b_end,omitempty"`
+	// Which connector set the attributes, only included if requested
+	Provenance map[string]*Source `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Cable) Reset() {
+	*x = Cable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cable) ProtoMessage() {}
+
+func (x *Cable) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cable.ProtoReflect.Descriptor instead.
+func (*Cable) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Cable) GetAEnd() *CableEnd {
+	if x != nil {
+		return x.AEnd
+	}
+	return nil
+}
+
+func (x *Cable) GetBEnd() *CableEnd {
+	if x != nil {
+		return x.BEnd
+	}
+	return nil
+}
+
+func (x *Cable) GetProvenance() map[string]*Source {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type CableEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName   string            `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	EndpointType CableEndpointType `protobuf:"varint,2,opt,name=endpoint_type,json=endpointType,proto3,enum=cloudflare.net.octopus.CableEndpointType" json:"endpoint_type,omitempty"`
+	EndpointName string            `protobuf:"bytes,3,opt,name=endpoint_name,json=endpointName,proto3" json:"endpoint_name,omitempty"`
+}
+
+func (x *CableEnd) Reset() {
+	*x = CableEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CableEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CableEnd) ProtoMessage() {}
+
+func (x *CableEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CableEnd.ProtoReflect.Descriptor instead.
+func (*CableEnd) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CableEnd) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *CableEnd) GetEndpointType() CableEndpointType {
+	if x != nil {
+		return x.EndpointType
+	}
+	return CableEndpointType_CABLE_ENDPOINT_TYPE_UNSPECIFIED
+}
+
+func (x *CableEnd) GetEndpointName() string {
+	if x != nil {
+		return x.EndpointName
+	}
+	return ""
+}
+
+type PathSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type PathSegmentType `protobuf:"varint,1,opt,name=type,proto3,enum=cloudflare.net.octopus.PathSegmentType" json:"type,omitempty"`
+	AEnd *CableEnd       `protobuf:"bytes,2,opt,name=a_end,json=aEnd,proto3" json:"a_end,omitempty"`
+	BEnd *CableEnd       `protobuf:"bytes,3,opt,name=b_end,json=bEnd,proto3" json:"b_end,omitempty"`
+}
+
+func (x *PathSegment) Reset() {
+	*x = PathSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathSegment) ProtoMessage() {}
+
+func (x *PathSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathSegment.ProtoReflect.Descriptor instead.
+func (*PathSegment) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PathSegment) GetType() PathSegmentType {
+	if x != nil {
+		return x.Type
+	}
+	return PathSegmentType_PATH_SEGMENT_TYPE_UNSPECIFIED
+}
+
+func (x *PathSegment) GetAEnd() *CableEnd {
+	if x != nil {
+		return x.AEnd
+	}
+	return nil
+}
+
+func (x *PathSegment) GetBEnd() *CableEnd {
+	if x != nil {
+		return x.BEnd
+	}
+	return nil
+}
+
+// A LogicalLink connects two interfaces, either directly by a cable or through patch panels and circuits.
+type LogicalLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AEnd *CableEnd      `protobuf:"bytes,1,opt,name=a_end,json=aEnd,proto3" json:"a_end,omitempty"`
+	BEnd *CableEnd      `protobuf:"bytes,2,opt,name=b_end,json=bEnd,proto3" json:"b_end,omitempty"`
+	Path []*PathSegment `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *LogicalLink) Reset() {
+	*x = LogicalLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogicalLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogicalLink) ProtoMessage() {}
+
+func (x *LogicalLink) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogicalLink.ProtoReflect.Descriptor instead.
+func (*LogicalLink) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LogicalLink) GetAEnd() *CableEnd {
+	if x != nil {
+		return x.AEnd
+	}
+	return nil
+}
+
+func (x *LogicalLink) GetBEnd() *CableEnd {
+	if x != nil {
+		return x.BEnd
+	}
+	return nil
+}
+
+func (x *LogicalLink) GetPath() []*PathSegment {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type Prefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefix   *api.Prefix `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	MetaData *MetaData   `protobuf:"bytes,4,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	// Which connector set the attributes, only included if requested
+	Provenance map[string]*Source `protobuf:"bytes,5,rep,name=provenance,proto3" json:"provenance,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Prefix) Reset() {
+	*x = Prefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Prefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prefix) ProtoMessage() {}
+
+func (x *Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prefix.ProtoReflect.Descriptor instead.
+func (*Prefix) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Prefix) GetPrefix() *api.Prefix {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *Prefix) GetMetaData() *MetaData {
+	if x != nil {
+		return x.MetaData
+	}
+	return nil
+}
+
+func (x *Prefix) GetProvenance() map[string]*Source {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type MetaData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags            []string          `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	SemanticTags    map[string]string `protobuf:"bytes,2,rep,name=semantic_tags,json=semanticTags,proto3" json:"semantic_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CustomFieldData string            `protobuf:"bytes,3,opt,name=custom_field_data,json=customFieldData,proto3" json:"custom_field_data,omitempty"`
+}
+
+func (x *MetaData) Reset() {
+	*x = MetaData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetaData) ProtoMessage() {}
+
+func (x *MetaData) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetaData.ProtoReflect.Descriptor instead.
+func (*MetaData) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MetaData) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MetaData) GetSemanticTags() map[string]string {
+	if x != nil {
+		return x.SemanticTags
+	}
+	return nil
+}
+
+func (x *MetaData) GetCustomFieldData() string {
+	if x != nil {
+		return x.CustomFieldData
+	}
+	return ""
+}
+
+// A TopologyEvent describes the change of a single object between two topology generations.
+// Devices are sent without interfaces, interfaces without units. Delete events carry the last known state of the object.
+type TopologyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type TopologyEventType `protobuf:"varint,1,opt,name=type,proto3,enum=cloudflare.net.octopus.TopologyEventType" json:"type,omitempty"`
+	// Set for interface and unit events
+	DeviceName string `protobuf:"bytes,2,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Set for unit events
+	InterfaceName string `protobuf:"bytes,3,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	// Types that are assignable to Object:
+	//	*TopologyEvent_Device
+	//	*TopologyEvent_Interface
+	//	*TopologyEvent_Unit
+	//	*TopologyEvent_Cable
+	//	*TopologyEvent_Circuit
+	//	*TopologyEvent_Prefix
+	Object isTopologyEvent_Object `protobuf_oneof:"object"`
+}
+
+func (x *TopologyEvent) Reset() {
+	*x = TopologyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopologyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyEvent) ProtoMessage() {}
+
+func (x *TopologyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyEvent.ProtoReflect.Descriptor instead.
+func (*TopologyEvent) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TopologyEvent) GetType() TopologyEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TopologyEventType_TOPOLOGY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *TopologyEvent) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *TopologyEvent) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (m *TopologyEvent) GetObject() isTopologyEvent_Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetDevice() *Device {
+	if x, ok := x.GetObject().(*TopologyEvent_Device); ok {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetInterface() *Interface {
+	if x, ok := x.GetObject().(*TopologyEvent_Interface); ok {
+		return x.Interface
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetUnit() *InterfaceUnit {
+	if x, ok := x.GetObject().(*TopologyEvent_Unit); ok {
+		return x.Unit
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetCable() *Cable {
+	if x, ok := x.GetObject().(*TopologyEvent_Cable); ok {
+		return x.Cable
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetCircuit() *Circuit {
+	if x, ok := x.GetObject().(*TopologyEvent_Circuit); ok {
+		return x.Circuit
+	}
+	return nil
+}
+
+func (x *TopologyEvent) GetPrefix() *Prefix {
+	if x, ok := x.GetObject().(*TopologyEvent_Prefix); ok {
+		return x.Prefix
+	}
+	return nil
+}
+
+type isTopologyEvent_Object interface {
+	isTopologyEvent_Object()
+}
+
+type TopologyEvent_Device struct {
+	Device *Device `protobuf:"bytes,4,opt,name=device,proto3,oneof"`
+}
+
+type TopologyEvent_Interface struct {
+	Interface *Interface `protobuf:"bytes,5,opt,name=interface,proto3,oneof"`
+}
+
+type TopologyEvent_Unit struct {
+	Unit *InterfaceUnit `protobuf:"bytes,6,opt,name=unit,proto3,oneof"`
+}
+
+type TopologyEvent_Cable struct {
+	Cable *Cable `protobuf:"bytes,7,opt,name=cable,proto3,oneof"`
+}
+
+type TopologyEvent_Circuit struct {
+	Circuit *Circuit `protobuf:"bytes,8,opt,name=circuit,proto3,oneof"`
+}
+
+type TopologyEvent_Prefix struct {
+	Prefix *Prefix `protobuf:"bytes,9,opt,name=prefix,proto3,oneof"`
+}
+
+func (*TopologyEvent_Device) isTopologyEvent_Object() {}
+
+func (*TopologyEvent_Interface) isTopologyEvent_Object() {}
+
+func (*TopologyEvent_Unit) isTopologyEvent_Object() {}
+
+func (*TopologyEvent_Cable) isTopologyEvent_Object() {}
+
+func (*TopologyEvent_Circuit) isTopologyEvent_Object() {}
+
+func (*TopologyEvent_Prefix) isTopologyEvent_Object() {}
+
+type TopologyChanges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*TopologyEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *TopologyChanges) Reset() {
+	*x = TopologyChanges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopologyChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyChanges) ProtoMessage() {}
+
+func (x *TopologyChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyChanges.ProtoReflect.Descriptor instead.
+func (*TopologyChanges) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TopologyChanges) GetEvents() []*TopologyEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type FieldDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FieldDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type ObjectDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DiffType `protobuf:"varint,1,opt,name=type,proto3,enum=cloudflare.net.octopus.DiffType" json:"type,omitempty"`
+	Key  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Only set for modified objects
+	Fields []*FieldDiff `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ObjectDiff) Reset() {
+	*x = ObjectDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectDiff) ProtoMessage() {}
+
+func (x *ObjectDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectDiff.ProtoReflect.Descriptor instead.
+func (*ObjectDiff) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ObjectDiff) GetType() DiffType {
+	if x != nil {
+		return x.Type
+	}
+	return DiffType_DIFF_TYPE_UNSPECIFIED
+}
+
+func (x *ObjectDiff) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ObjectDiff) GetFields() []*FieldDiff {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type TopologyDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices     []*ObjectDiff `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	Interfaces  []*ObjectDiff `protobuf:"bytes,2,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	Units       []*ObjectDiff `protobuf:"bytes,3,rep,name=units,proto3" json:"units,omitempty"`
+	IpAddresses []*ObjectDiff `protobuf:"bytes,4,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+	Cables      []*ObjectDiff `protobuf:"bytes,5,rep,name=cables,proto3" json:"cables,omitempty"`
+	Circuits    []*ObjectDiff `protobuf:"bytes,6,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	Prefixes    []*ObjectDiff `protobuf:"bytes,7,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *TopologyDiff) Reset() {
+	*x = TopologyDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopologyDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyDiff) ProtoMessage() {}
+
+func (x *TopologyDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyDiff.ProtoReflect.Descriptor instead.
+func (*TopologyDiff) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TopologyDiff) GetDevices() []*ObjectDiff {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *TopologyDiff) GetInterfaces() []*ObjectDiff {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *TopologyDiff) GetUnits() []*ObjectDiff {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *TopologyDiff) GetIpAddresses() []*ObjectDiff {
+	if x != nil {
+		return x.IpAddresses
+	}
+	return nil
+}
+
+func (x *TopologyDiff) GetCables() []*ObjectDiff {
+	if x != nil {
+		return x.Cables
+	}
+	return nil
+}
+
+func (x *TopologyDiff) GetCircuits() []*ObjectDiff {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+func (x *TopologyDiff) GetPrefixes() []*ObjectDiff {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+type TopologyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Returns the given retained snapshot instead of the current topology if set
+	AsOf              *SnapshotSelector `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	IncludeProvenance bool              `protobuf:"varint,2,opt,name=include_provenance,json=includeProvenance,proto3" json:"include_provenance,omitempty"`
+	// Generation the client already has. If it is the one requested, only not_modified is set in the response.
+	KnownGeneration uint64           `protobuf:"varint,3,opt,name=known_generation,json=knownGeneration,proto3" json:"known_generation,omitempty"`
+	Encoding        TopologyEncoding `protobuf:"varint,4,opt,name=encoding,proto3,enum=cloudflare.net.octopus.TopologyEncoding" json:"encoding,omitempty"`
+	// Epoch of the server known_generation has been received from, generations are only comparable within the same epoch
+	KnownEpoch string `protobuf:"bytes,5,opt,name=known_epoch,json=knownEpoch,proto3" json:"known_epoch,omitempty"`
+}
+
+func (x *TopologyRequest) Reset() {
+	*x = TopologyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyRequest) ProtoMessage() {}
+
+func (x *TopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyRequest.ProtoReflect.Descriptor instead.
+func (*TopologyRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TopologyRequest) GetAsOf() *SnapshotSelector {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *TopologyRequest) GetIncludeProvenance() bool {
+	if x != nil {
+		return x.IncludeProvenance
+	}
+	return false
+}
+
+func (x *TopologyRequest) GetKnownGeneration() uint64 {
+	if x != nil {
+		return x.KnownGeneration
+	}
+	return 0
+}
+
+func (x *TopologyRequest) GetEncoding() TopologyEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return TopologyEncoding_TOPOLOGY_ENCODING_NONE
+}
+
+func (x *TopologyRequest) GetKnownEpoch() string {
+	if x != nil {
+		return x.KnownEpoch
+	}
+	return ""
+}
+
+type TopologyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topology    *Topology        `protobuf:"bytes,1,opt,name=topology,proto3" json:"topology,omitempty"`
+	Generation  uint64           `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	NotModified bool             `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	Encoding    TopologyEncoding `protobuf:"varint,4,opt,name=encoding,proto3,enum=cloudflare.net.octopus.TopologyEncoding" json:"encoding,omitempty"`
+	// Marshalled Topology, compressed as given by encoding
+	EncodedTopology []byte `protobuf:"bytes,5,opt,name=encoded_topology,json=encodedTopology,proto3" json:"encoded_topology,omitempty"`
+	// Identifies the server process, clients have to pass it along with the generation as known_epoch
+	Epoch string `protobuf:"bytes,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *TopologyResponse) Reset() {
+	*x = TopologyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyResponse) ProtoMessage() {}
+
+func (x *TopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyResponse.ProtoReflect.Descriptor instead.
+func (*TopologyResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TopologyResponse) GetTopology() *Topology {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+func (x *TopologyResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *TopologyResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *TopologyResponse) GetEncoding() TopologyEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return TopologyEncoding_TOPOLOGY_ENCODING_NONE
+}
+
+func (x *TopologyResponse) GetEncodedTopology() []byte {
+	if x != nil {
+		return x.EncodedTopology
+	}
+	return nil
+}
+
+func (x *TopologyResponse) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+type StreamTopologyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Streams the given retained snapshot instead of the current topology if set
+	AsOf              *SnapshotSelector `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	IncludeProvenance bool              `protobuf:"varint,2,opt,name=include_provenance,json=includeProvenance,proto3" json:"include_provenance,omitempty"`
+	// Upper bound of the size of a chunk (1 MiB if not set, at most 3 MiB). Single objects larger than that are sent in a chunk of their own.
+	MaxChunkBytes uint32 `protobuf:"varint,3,opt,name=max_chunk_bytes,json=maxChunkBytes,proto3" json:"max_chunk_bytes,omitempty"`
+}
+
+func (x *StreamTopologyRequest) Reset() {
+	*x = StreamTopologyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamTopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTopologyRequest) ProtoMessage() {}
+
+func (x *StreamTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTopologyRequest.ProtoReflect.Descriptor instead.
+func (*StreamTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StreamTopologyRequest) GetAsOf() *SnapshotSelector {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *StreamTopologyRequest) GetIncludeProvenance() bool {
+	if x != nil {
+		return x.IncludeProvenance
+	}
+	return false
+}
+
+func (x *StreamTopologyRequest) GetMaxChunkBytes() uint32 {
+	if x != nil {
+		return x.MaxChunkBytes
+	}
+	return 0
+}
+
+type TopologyChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	// Index of the chunk within the stream, starting at 0
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Set on the last chunk
+	Last bool `protobuf:"varint,3,opt,name=last,proto3" json:"last,omitempty"`
+	// The first chunk holds the scalar fields, connectors and as many merge conflicts as fit, the following ones batches of
+	// the remaining merge conflicts, sites, pops, colos, devices, cables, prefixes, circuits, and logical links.
+	// Merging the parts of all chunks in order (e.g. with proto.Merge) yields the topology.
+	Part *Topology `protobuf:"bytes,4,opt,name=part,proto3" json:"part,omitempty"`
+}
+
+func (x *TopologyChunk) Reset() {
+	*x = TopologyChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopologyChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyChunk) ProtoMessage() {}
+
+func (x *TopologyChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyChunk.ProtoReflect.Descriptor instead.
+func (*TopologyChunk) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TopologyChunk) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *TopologyChunk) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TopologyChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *TopologyChunk) GetPart() *Topology {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+type ExportTopologyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=cloudflare.net.octopus.ExportFormat" json:"format,omitempty"`
+	// Exports the given retained snapshot instead of the current topology if set
+	AsOf *SnapshotSelector `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	// Only devices matching the filter are exported, all if not set
+	Filter *DeviceFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Export interfaces, front and rear ports, and circuits as nodes of their own.
+	// Otherwise edges are attached to the devices, and cables to circuits are left out.
+	IncludeInterfaces bool        `protobuf:"varint,4,opt,name=include_interfaces,json=includeInterfaces,proto3" json:"include_interfaces,omitempty"`
+	IncludePorts      bool        `protobuf:"varint,5,opt,name=include_ports,json=includePorts,proto3" json:"include_ports,omitempty"`
+	IncludeCircuits   bool        `protobuf:"varint,6,opt,name=include_circuits,json=includeCircuits,proto3" json:"include_circuits,omitempty"`
+	Edges             ExportEdges `protobuf:"varint,7,opt,name=edges,proto3,enum=cloudflare.net.octopus.ExportEdges" json:"edges,omitempty"`
+	// Upper bound of the size of the data of a chunk (1 MiB if not set, at most 3 MiB)
+	MaxChunkBytes uint32 `protobuf:"varint,8,opt,name=max_chunk_bytes,json=maxChunkBytes,proto3" json:"max_chunk_bytes,omitempty"`
+}
+
+func (x *ExportTopologyRequest) Reset() {
+	*x = ExportTopologyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTopologyRequest) ProtoMessage() {}
+
+func (x *ExportTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTopologyRequest.ProtoReflect.Descriptor instead.
+func (*ExportTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportTopologyRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_GRAPHML
+}
+
+func (x *ExportTopologyRequest) GetAsOf() *SnapshotSelector {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *ExportTopologyRequest) GetFilter() *DeviceFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportTopologyRequest) GetIncludeInterfaces() bool {
+	if x != nil {
+		return x.IncludeInterfaces
+	}
+	return false
+}
+
+func (x *ExportTopologyRequest) GetIncludePorts() bool {
+	if x != nil {
+		return x.IncludePorts
+	}
+	return false
+}
+
+func (x *ExportTopologyRequest) GetIncludeCircuits() bool {
+	if x != nil {
+		return x.IncludeCircuits
+	}
+	return false
+}
+
+func (x *ExportTopologyRequest) GetEdges() ExportEdges {
+	if x != nil {
+		return x.Edges
+	}
+	return ExportEdges_EXPORT_EDGES_CABLES
+}
+
+func (x *ExportTopologyRequest) GetMaxChunkBytes() uint32 {
+	if x != nil {
+		return x.MaxChunkBytes
+	}
+	return 0
+}
+
+// The export is streamed in chunks, concatenating their data in order yields the file.
+// generation, content_type and file_name are only set on the first chunk.
+type ExportTopologyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	// e.g. application/graphml+xml
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// e.g. topology-42.graphml
+	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Data     []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportTopologyResponse) Reset() {
+	*x = ExportTopologyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTopologyResponse) ProtoMessage() {}
+
+func (x *ExportTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTopologyResponse.ProtoReflect.Descriptor instead.
+func (*ExportTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExportTopologyResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ExportTopologyResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportTopologyResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportTopologyResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName string `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Returns the device from the given retained snapshot instead of the current topology if set
+	AsOf              *SnapshotSelector `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	IncludeProvenance bool              `protobuf:"varint,3,opt,name=include_provenance,json=includeProvenance,proto3" json:"include_provenance,omitempty"`
+}
+
+func (x *DeviceRequest) Reset() {
+	*x = DeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRequest) ProtoMessage() {}
+
+func (x *DeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRequest.ProtoReflect.Descriptor instead.
+func (*DeviceRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeviceRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *DeviceRequest) GetAsOf() *SnapshotSelector {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *DeviceRequest) GetIncludeProvenance() bool {
+	if x != nil {
+		return x.IncludeProvenance
+	}
+	return false
+}
+
+type DeviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device *Device `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *DeviceResponse) Reset() {
+	*x = DeviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceResponse) ProtoMessage() {}
+
+func (x *DeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceResponse.ProtoReflect.Descriptor instead.
+func (*DeviceResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeviceResponse) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type WatchTopologyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//
+	//Generation of the last topology snapshot the client has seen.
+	//If the server still knows the changes since then, only those are sent instead of a full snapshot.
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	//
+	//Epoch of the server the generation has been received from.
+	//Generations are only comparable within the same epoch, so a full snapshot is sent if it doesn't match.
+	Epoch string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *WatchTopologyRequest) Reset() {
+	*x = WatchTopologyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTopologyRequest) ProtoMessage() {}
+
+func (x *WatchTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTopologyRequest.ProtoReflect.Descriptor instead.
+func (*WatchTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WatchTopologyRequest) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *WatchTopologyRequest) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+type WatchTopologyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	Timestamp  uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are assignable to Update:
+	//	*WatchTopologyResponse_Snapshot
+	//	*WatchTopologyResponse_Changes
+	Update isWatchTopologyResponse_Update `protobuf_oneof:"update"`
+	// Identifies the server process, clients have to pass it along with the generation when resuming
+	Epoch string `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *WatchTopologyResponse) Reset() {
+	*x = WatchTopologyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTopologyResponse) ProtoMessage() {}
+
+func (x *WatchTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTopologyResponse.ProtoReflect.Descriptor instead.
+func (*WatchTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WatchTopologyResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *WatchTopologyResponse) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (m *WatchTopologyResponse) GetUpdate() isWatchTopologyResponse_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *WatchTopologyResponse) GetSnapshot() *Topology {
+	if x, ok := x.GetUpdate().(*WatchTopologyResponse_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *WatchTopologyResponse) GetChanges() *TopologyChanges {
+	if x, ok := x.GetUpdate().(*WatchTopologyResponse_Changes); ok {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *WatchTopologyResponse) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+type isWatchTopologyResponse_Update interface {
+	isWatchTopologyResponse_Update()
+}
+
+type WatchTopologyResponse_Snapshot struct {
+	Snapshot *Topology `protobuf:"bytes,3,opt,name=snapshot,proto3,oneof"`
+}
+
+type WatchTopologyResponse_Changes struct {
+	Changes *TopologyChanges `protobuf:"bytes,4,opt,name=changes,proto3,oneof"`
+}
+
+func (*WatchTopologyResponse_Snapshot) isWatchTopologyResponse_Update() {}
+
+func (*WatchTopologyResponse_Changes) isWatchTopologyResponse_Update() {}
+
+// Selects one of the retained topology snapshots, either by its generation
+// or by a timestamp (epoch), in which case the latest snapshot built at or before that time is used.
+type SnapshotSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Selector:
+	//	*SnapshotSelector_Generation
+	//	*SnapshotSelector_Timestamp
+	Selector isSnapshotSelector_Selector `protobuf_oneof:"selector"`
+}
+
+func (x *SnapshotSelector) Reset() {
+	*x = SnapshotSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotSelector) ProtoMessage() {}
+
+func (x *SnapshotSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotSelector.ProtoReflect.Descriptor instead.
+func (*SnapshotSelector) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{37}
+}
+
+func (m *SnapshotSelector) GetSelector() isSnapshotSelector_Selector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+func (x *SnapshotSelector) GetGeneration() uint64 {
+	if x, ok := x.GetSelector().(*SnapshotSelector_Generation); ok {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *SnapshotSelector) GetTimestamp() uint64 {
+	if x, ok := x.GetSelector().(*SnapshotSelector_Timestamp); ok {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type isSnapshotSelector_Selector interface {
+	isSnapshotSelector_Selector()
+}
+
+type SnapshotSelector_Generation struct {
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3,oneof"`
+}
+
+type SnapshotSelector_Timestamp struct {
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3,oneof"`
+}
+
+func (*SnapshotSelector_Generation) isSnapshotSelector_Selector() {}
+
+func (*SnapshotSelector_Timestamp) isSnapshotSelector_Selector() {}
+
+type DiffTopologyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *SnapshotSelector `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Defaults to the current topology if not set
+	To *SnapshotSelector `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *DiffTopologyRequest) Reset() {
+	*x = DiffTopologyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffTopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffTopologyRequest) ProtoMessage() {}
+
+func (x *DiffTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffTopologyRequest.ProtoReflect.Descriptor instead.
+func (*DiffTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DiffTopologyRequest) GetFrom() *SnapshotSelector {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffTopologyRequest) GetTo() *SnapshotSelector {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DiffTopologyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromGeneration uint64        `protobuf:"varint,1,opt,name=from_generation,json=fromGeneration,proto3" json:"from_generation,omitempty"`
+	ToGeneration   uint64        `protobuf:"varint,2,opt,name=to_generation,json=toGeneration,proto3" json:"to_generation,omitempty"`
+	Diff           *TopologyDiff `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *DiffTopologyResponse) Reset() {
+	*x = DiffTopologyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffTopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffTopologyResponse) ProtoMessage() {}
+
+func (x *DiffTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffTopologyResponse.ProtoReflect.Descriptor instead.
+func (*DiffTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DiffTopologyResponse) GetFromGeneration() uint64 {
+	if x != nil {
+		return x.FromGeneration
+	}
+	return 0
+}
+
+func (x *DiffTopologyResponse) GetToGeneration() uint64 {
+	if x != nil {
+		return x.ToGeneration
+	}
+	return 0
+}
+
+func (x *DiffTopologyResponse) GetDiff() *TopologyDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type NameFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pattern   string        `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MatchType NameMatchType `protobuf:"varint,2,opt,name=match_type,json=matchType,proto3,enum=cloudflare.net.octopus.NameMatchType" json:"match_type,omitempty"`
+}
+
+func (x *NameFilter) Reset() {
+	*x = NameFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NameFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameFilter) ProtoMessage() {}
+
+func (x *NameFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameFilter.ProtoReflect.Descriptor instead.
+func (*NameFilter) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NameFilter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *NameFilter) GetMatchType() NameMatchType {
+	if x != nil {
+		return x.MatchType
+	}
+	return NameMatchType_NAME_MATCH_TYPE_EXACT
+}
+
+type SemanticTagFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// If empty, any value matches as long as the key exists
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SemanticTagFilter) Reset() {
+	*x = SemanticTagFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SemanticTagFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SemanticTagFilter) ProtoMessage() {}
+
+func (x *SemanticTagFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SemanticTagFilter.ProtoReflect.Descriptor instead.
+func (*SemanticTagFilter) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SemanticTagFilter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SemanticTagFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Within each criterion any of the given values has to match (e.g. role is ccr OR edge).
+// All criteria set are combined using the operator, every tag and semantic tag being a criterion of its own
+// (e.g. with OR a device needs only one of the given tags).
+type DeviceFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator     FilterOperator       `protobuf:"varint,1,opt,name=operator,proto3,enum=cloudflare.net.octopus.FilterOperator" json:"operator,omitempty"`
+	Names        []*NameFilter        `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	Sites        []string             `protobuf:"bytes,3,rep,name=sites,proto3" json:"sites,omitempty"`
+	Colos        []string             `protobuf:"bytes,4,rep,name=colos,proto3" json:"colos,omitempty"`
+	Pops         []string             `protobuf:"bytes,5,rep,name=pops,proto3" json:"pops,omitempty"`
+	Roles        []string             `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	Platforms    []string             `protobuf:"bytes,7,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	Statuses     []string             `protobuf:"bytes,8,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	DeviceTypes  []string             `protobuf:"bytes,9,rep,name=device_types,json=deviceTypes,proto3" json:"device_types,omitempty"`
+	Tags         []string             `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	SemanticTags []*SemanticTagFilter `protobuf:"bytes,11,rep,name=semantic_tags,json=semanticTags,proto3" json:"semantic_tags,omitempty"`
+}
+
+func (x *DeviceFilter) Reset() {
+	*x = DeviceFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceFilter) ProtoMessage() {}
+
+func (x *DeviceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceFilter.ProtoReflect.Descriptor instead.
+func (*DeviceFilter) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeviceFilter) GetOperator() FilterOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return FilterOperator_FILTER_OPERATOR_AND
+}
+
+func (x *DeviceFilter) GetNames() []*NameFilter {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetSites() []string {
+	if x != nil {
+		return x.Sites
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetColos() []string {
+	if x != nil {
+		return x.Colos
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetPops() []string {
+	if x != nil {
+		return x.Pops
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetPlatforms() []string {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetDeviceTypes() []string {
+	if x != nil {
+		return x.DeviceTypes
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *DeviceFilter) GetSemanticTags() []*SemanticTagFilter {
+	if x != nil {
+		return x.SemanticTags
+	}
+	return nil
+}
+
+type QueryDevicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *DeviceFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// If set, only the given fields of the devices are returned (e.g. "name", "role", "meta_data.semantic_tags")
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+}
+
+func (x *QueryDevicesRequest) Reset() {
+	*x = QueryDevicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDevicesRequest) ProtoMessage() {}
+
+func (x *QueryDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryDevicesRequest.ProtoReflect.Descriptor instead.
+func (*QueryDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *QueryDevicesRequest) GetFilter() *DeviceFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryDevicesRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
+}
+
+type QueryDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*Device `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+}
+
+func (x *QueryDevicesResponse) Reset() {
+	*x = QueryDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryDevicesResponse) ProtoMessage() {}
+
+func (x *QueryDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryDevicesResponse.ProtoReflect.Descriptor instead.
+func (*QueryDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *QueryDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type TracePathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName    string `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	InterfaceName string `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+}
+
+func (x *TracePathRequest) Reset() {
+	*x = TracePathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracePathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracePathRequest) ProtoMessage() {}
+
+func (x *TracePathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracePathRequest.ProtoReflect.Descriptor instead.
+func (*TracePathRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TracePathRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *TracePathRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type TracePathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Origin *CableEnd `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	// The far end of the path, might be an unconnected port or circuit termination if the path is not complete
+	Destination *CableEnd `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// True if the path ends on an interface
+	Complete bool           `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	Path     []*PathSegment `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *TracePathResponse) Reset() {
+	*x = TracePathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracePathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracePathResponse) ProtoMessage() {}
+
+func (x *TracePathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracePathResponse.ProtoReflect.Descriptor instead.
+func (*TracePathResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TracePathResponse) GetOrigin() *CableEnd {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *TracePathResponse) GetDestination() *CableEnd {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *TracePathResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *TracePathResponse) GetPath() []*PathSegment {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type Neighbor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalInterfaceName string `protobuf:"bytes,1,opt,name=local_interface_name,json=localInterfaceName,proto3" json:"local_interface_name,omitempty"`
+	// The LAG the local interface is a member of, if any
+	LocalLag      string `protobuf:"bytes,2,opt,name=local_lag,json=localLag,proto3" json:"local_lag,omitempty"`
+	DeviceName    string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	InterfaceName string `protobuf:"bytes,4,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	// The LAG the remote interface is a member of, if any
+	RemoteLag string `protobuf:"bytes,5,opt,name=remote_lag,json=remoteLag,proto3" json:"remote_lag,omitempty"`
+	// True if both interfaces are connected by a single cable
+	Direct bool `protobuf:"varint,6,opt,name=direct,proto3" json:"direct,omitempty"`
+	// Identifiers of the cables and circuits along the path
+	Cables   []string `protobuf:"bytes,7,rep,name=cables,proto3" json:"cables,omitempty"`
+	Circuits []string `protobuf:"bytes,8,rep,name=circuits,proto3" json:"circuits,omitempty"`
+}
+
+func (x *Neighbor) Reset() {
+	*x = Neighbor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Neighbor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Neighbor) ProtoMessage() {}
+
+func (x *Neighbor) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Neighbor.ProtoReflect.Descriptor instead.
+func (*Neighbor) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Neighbor) GetLocalInterfaceName() string {
+	if x != nil {
+		return x.LocalInterfaceName
+	}
+	return ""
+}
+
+func (x *Neighbor) GetLocalLag() string {
+	if x != nil {
+		return x.LocalLag
+	}
+	return ""
+}
+
+func (x *Neighbor) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *Neighbor) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *Neighbor) GetRemoteLag() string {
+	if x != nil {
+		return x.RemoteLag
+	}
+	return ""
+}
+
+func (x *Neighbor) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+func (x *Neighbor) GetCables() []string {
+	if x != nil {
+		return x.Cables
+	}
+	return nil
+}
+
+func (x *Neighbor) GetCircuits() []string {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+type GetNeighborsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName string `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Optional, returns the neighbors of all interfaces of the device if empty.
+	// If a LAG is given the neighbors of all its members are returned.
+	InterfaceName string `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+}
+
+func (x *GetNeighborsRequest) Reset() {
+	*x = GetNeighborsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNeighborsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNeighborsRequest) ProtoMessage() {}
+
+func (x *GetNeighborsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNeighborsRequest.ProtoReflect.Descriptor instead.
+func (*GetNeighborsRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetNeighborsRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *GetNeighborsRequest) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+type GetNeighborsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Neighbors []*Neighbor `protobuf:"bytes,1,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
+}
+
+func (x *GetNeighborsResponse) Reset() {
+	*x = GetNeighborsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNeighborsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNeighborsResponse) ProtoMessage() {}
+
+func (x *GetNeighborsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNeighborsResponse.ProtoReflect.Descriptor instead.
+func (*GetNeighborsResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetNeighborsResponse) GetNeighbors() []*Neighbor {
+	if x != nil {
+		return x.Neighbors
+	}
+	return nil
+}
+
+type IPOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceName    string     `protobuf:"bytes,1,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	InterfaceName string     `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	UnitId        uint32     `protobuf:"varint,3,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`
+	OuterTag      uint32     `protobuf:"varint,4,opt,name=outer_tag,json=outerTag,proto3" json:"outer_tag,omitempty"`
+	InnerTag      uint32     `protobuf:"varint,5,opt,name=inner_tag,json=innerTag,proto3" json:"inner_tag,omitempty"`
+	Address       *IPAddress `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *IPOwner) Reset() {
+	*x = IPOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IPOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IPOwner) ProtoMessage() {}
+
+func (x *IPOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IPOwner.ProtoReflect.Descriptor instead.
+func (*IPOwner) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *IPOwner) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *IPOwner) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *IPOwner) GetUnitId() uint32 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *IPOwner) GetOuterTag() uint32 {
+	if x != nil {
+		return x.OuterTag
+	}
+	return 0
+}
+
+func (x *IPOwner) GetInnerTag() uint32 {
+	if x != nil {
+		return x.InnerTag
+	}
+	return 0
+}
+
+func (x *IPOwner) GetAddress() *IPAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type LookupIPRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IPv4 or IPv6 address, e.g. 2001:db8::1
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *LookupIPRequest) Reset() {
+	*x = LookupIPRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupIPRequest) ProtoMessage() {}
+
+func (x *LookupIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupIPRequest.ProtoReflect.Descriptor instead.
+func (*LookupIPRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LookupIPRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type LookupIPResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The interface units the address is configured on
+	Owners []*IPOwner `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	// All prefixes covering the address, most specific first
+	Prefixes []*Prefix `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *LookupIPResponse) Reset() {
+	*x = LookupIPResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupIPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupIPResponse) ProtoMessage() {}
+
+func (x *LookupIPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupIPResponse.ProtoReflect.Descriptor instead.
+func (*LookupIPResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LookupIPResponse) GetOwners() []*IPOwner {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *LookupIPResponse) GetPrefixes() []*Prefix {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+type LookupPrefixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IPv4 or IPv6 prefix, e.g. 192.0.2.0/24
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *LookupPrefixRequest) Reset() {
+	*x = LookupPrefixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupPrefixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupPrefixRequest) ProtoMessage() {}
+
+func (x *LookupPrefixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupPrefixRequest.ProtoReflect.Descriptor instead.
+func (*LookupPrefixRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LookupPrefixRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+type LookupPrefixResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The interface units with addresses within the prefix
+	Owners []*IPOwner `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+	// All prefixes covering the prefix including itself, most specific first
+	Prefixes []*Prefix `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *LookupPrefixResponse) Reset() {
+	*x = LookupPrefixResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupPrefixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupPrefixResponse) ProtoMessage() {}
+
+func (x *LookupPrefixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupPrefixResponse.ProtoReflect.Descriptor instead.
+func (*LookupPrefixResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LookupPrefixResponse) GetOwners() []*IPOwner {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *LookupPrefixResponse) GetPrefixes() []*Prefix {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+type GetMergeConflictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Returns the conflicts of the given retained snapshot instead of the current topology if set
+	AsOf *SnapshotSelector `protobuf:"bytes,1,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+}
+
+func (x *GetMergeConflictsRequest) Reset() {
+	*x = GetMergeConflictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMergeConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeConflictsRequest) ProtoMessage() {}
+
+func (x *GetMergeConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeConflictsRequest.ProtoReflect.Descriptor instead.
+func (*GetMergeConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetMergeConflictsRequest) GetAsOf() *SnapshotSelector {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type GetMergeConflictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Generation uint64           `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	Conflicts  []*MergeConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// Conflicts which failed the last topology build, empty once a build succeeded
+	Blocking []*MergeConflict `protobuf:"bytes,3,rep,name=blocking,proto3" json:"blocking,omitempty"`
+}
+
+func (x *GetMergeConflictsResponse) Reset() {
+	*x = GetMergeConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMergeConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMergeConflictsResponse) ProtoMessage() {}
+
+func (x *GetMergeConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMergeConflictsResponse.ProtoReflect.Descriptor instead.
+func (*GetMergeConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetMergeConflictsResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *GetMergeConflictsResponse) GetConflicts() []*MergeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *GetMergeConflictsResponse) GetBlocking() []*MergeConflict {
+	if x != nil {
+		return x.Blocking
+	}
+	return nil
+}
+
+type ValidationRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Severity    ValidationSeverity `protobuf:"varint,3,opt,name=severity,proto3,enum=cloudflare.net.octopus.ValidationSeverity" json:"severity,omitempty"`
+}
+
+func (x *ValidationRule) Reset() {
+	*x = ValidationRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationRule) ProtoMessage() {}
+
+func (x *ValidationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationRule.ProtoReflect.Descriptor instead.
+func (*ValidationRule) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ValidationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ValidationRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ValidationRule) GetSeverity() ValidationSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return ValidationSeverity_VALIDATION_SEVERITY_INFO
+}
+
+type ValidationViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule     string             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Severity ValidationSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=cloudflare.net.octopus.ValidationSeverity" json:"severity,omitempty"`
+	// device, interface, ip, cable or front_port
+	Entity string `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// Identifies the object, e.g. "ccr01.dus01:et-0/0/0" for an interface
+	Key     string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ValidationViolation) Reset() {
+	*x = ValidationViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationViolation) ProtoMessage() {}
+
+func (x *ValidationViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationViolation.ProtoReflect.Descriptor instead.
+func (*ValidationViolation) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ValidationViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *ValidationViolation) GetSeverity() ValidationSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return ValidationSeverity_VALIDATION_SEVERITY_INFO
+}
+
+func (x *ValidationViolation) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ValidationViolation) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ValidationViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ValidationReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Generation of the validated topology, 0 if it has been blocked
+	Generation uint64                 `protobuf:"varint,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	Blocked    bool                   `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	Rules      []*ValidationRule      `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	Violations []*ValidationViolation `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *ValidationReport) Reset() {
+	*x = ValidationReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationReport) ProtoMessage() {}
+
+func (x *ValidationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationReport.ProtoReflect.Descriptor instead.
+func (*ValidationReport) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ValidationReport) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ValidationReport) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ValidationReport) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *ValidationReport) GetRules() []*ValidationRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ValidationReport) GetViolations() []*ValidationViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type GetValidationReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only violations of at least this severity are returned
+	MinSeverity ValidationSeverity `protobuf:"varint,1,opt,name=min_severity,json=minSeverity,proto3,enum=cloudflare.net.octopus.ValidationSeverity" json:"min_severity,omitempty"`
+}
+
+func (x *GetValidationReportRequest) Reset() {
+	*x = GetValidationReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidationReportRequest) ProtoMessage() {}
+
+func (x *GetValidationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetValidationReportRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetValidationReportRequest) GetMinSeverity() ValidationSeverity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return ValidationSeverity_VALIDATION_SEVERITY_INFO
+}
+
+type GetValidationReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Report of the last topology build, which is the current topology unless it has been blocked
+	Report *ValidationReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *GetValidationReportResponse) Reset() {
+	*x = GetValidationReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetValidationReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValidationReportResponse) ProtoMessage() {}
+
+func (x *GetValidationReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValidationReportResponse.ProtoReflect.Descriptor instead.
+func (*GetValidationReportResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetValidationReportResponse) GetReport() *ValidationReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type TopologyShrinkage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sites, pops, colos, devices, interfaces, cables, circuits or prefixes
+	ItemType      string `protobuf:"bytes,1,opt,name=item_type,json=itemType,proto3" json:"item_type,omitempty"`
+	PreviousCount uint64 `protobuf:"varint,2,opt,name=previous_count,json=previousCount,proto3" json:"previous_count,omitempty"`
+	CurrentCount  uint64 `protobuf:"varint,3,opt,name=current_count,json=currentCount,proto3" json:"current_count,omitempty"`
+	// The threshold exceeded, e.g. "10%" or "100"
+	Limit string `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *TopologyShrinkage) Reset() {
+	*x = TopologyShrinkage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopologyShrinkage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyShrinkage) ProtoMessage() {}
+
+func (x *TopologyShrinkage) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyShrinkage.ProtoReflect.Descriptor instead.
+func (*TopologyShrinkage) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *TopologyShrinkage) GetItemType() string {
+	if x != nil {
+		return x.ItemType
+	}
+	return ""
+}
+
+func (x *TopologyShrinkage) GetPreviousCount() uint64 {
+	if x != nil {
+		return x.PreviousCount
+	}
+	return 0
+}
+
+func (x *TopologyShrinkage) GetCurrentCount() uint64 {
+	if x != nil {
+		return x.CurrentCount
+	}
+	return 0
+}
+
+func (x *TopologyShrinkage) GetLimit() string {
+	if x != nil {
+		return x.Limit
+	}
+	return ""
+}
+
+type GetShrinkageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetShrinkageRequest) Reset() {
+	*x = GetShrinkageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShrinkageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShrinkageRequest) ProtoMessage() {}
+
+func (x *GetShrinkageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShrinkageRequest.ProtoReflect.Descriptor instead.
+func (*GetShrinkageRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{63}
+}
+
+type GetShrinkageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Item types which dropped beyond the thresholds in the last topology build
+	Shrinkage []*TopologyShrinkage `protobuf:"bytes,1,rep,name=shrinkage,proto3" json:"shrinkage,omitempty"`
+	// The topology has not been published, false once a build succeeded
+	Blocked bool `protobuf:"varint,2,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// Time (epoch) the blocked topology was built, identifies it for ForceAcceptTopology
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetShrinkageResponse) Reset() {
+	*x = GetShrinkageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetShrinkageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShrinkageResponse) ProtoMessage() {}
+
+func (x *GetShrinkageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShrinkageResponse.ProtoReflect.Descriptor instead.
+func (*GetShrinkageResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetShrinkageResponse) GetShrinkage() []*TopologyShrinkage {
+	if x != nil {
+		return x.Shrinkage
+	}
+	return nil
+}
+
+func (x *GetShrinkageResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *GetShrinkageResponse) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ForceAcceptTopologyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logged along with the accepted shrinkage
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	//
+	//Timestamp of the blocked topology as returned by GetShrinkage.
+	//Builds continue while a topology is blocked, so the request fails if another build has been blocked since.
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ForceAcceptTopologyRequest) Reset() {
+	*x = ForceAcceptTopologyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceAcceptTopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceAcceptTopologyRequest) ProtoMessage() {}
+
+func (x *ForceAcceptTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceAcceptTopologyRequest.ProtoReflect.Descriptor instead.
+func (*ForceAcceptTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ForceAcceptTopologyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ForceAcceptTopologyRequest) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ForceAcceptTopologyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	// Shrinkage of the published topology, which is the one blocked before
+	Accepted []*TopologyShrinkage `protobuf:"bytes,2,rep,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (x *ForceAcceptTopologyResponse) Reset() {
+	*x = ForceAcceptTopologyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceAcceptTopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceAcceptTopologyResponse) ProtoMessage() {}
+
+func (x *ForceAcceptTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceAcceptTopologyResponse.ProtoReflect.Descriptor instead.
+func (*ForceAcceptTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ForceAcceptTopologyResponse) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ForceAcceptTopologyResponse) GetAccepted() []*TopologyShrinkage {
+	if x != nil {
+		return x.Accepted
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSnapshotsRequest) Reset() {
+	*x = ListSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{67}
+}
+
+type SnapshotInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Generation uint64 `protobuf:"varint,1,opt,name=generation,proto3" json:"generation,omitempty"`
+	// Time (epoch) the topology was built
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Empty for older snapshots found at warm start until they are loaded from disk
+	ConnectorLoadTimes []*ConnectorLoadTime `protobuf:"bytes,3,rep,name=connector_load_times,json=connectorLoadTimes,proto3" json:"connector_load_times,omitempty"`
+	// Size of the serialized topology
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Snapshots not in memory are loaded from disk when requested
+	InMemory  bool `protobuf:"varint,5,opt,name=in_memory,json=inMemory,proto3" json:"in_memory,omitempty"`
+	Persisted bool `protobuf:"varint,6,opt,name=persisted,proto3" json:"persisted,omitempty"`
+}
+
+func (x *SnapshotInfo) Reset() {
+	*x = SnapshotInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotInfo) ProtoMessage() {}
+
+func (x *SnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotInfo.ProtoReflect.Descriptor instead.
+func (*SnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SnapshotInfo) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *SnapshotInfo) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SnapshotInfo) GetConnectorLoadTimes() []*ConnectorLoadTime {
+	if x != nil {
+		return x.ConnectorLoadTimes
+	}
+	return nil
+}
+
+func (x *SnapshotInfo) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *SnapshotInfo) GetInMemory() bool {
+	if x != nil {
+		return x.InMemory
+	}
+	return false
+}
+
+func (x *SnapshotInfo) GetPersisted() bool {
+	if x != nil {
+		return x.Persisted
+	}
+	return false
+}
+
+type ListSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Oldest first
+	Snapshots []*SnapshotInfo `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListSnapshotsResponse) Reset() {
+	*x = ListSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_octopus_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_octopus_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_octopus_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListSnapshotsResponse) GetSnapshots() []*SnapshotInfo {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+var File_octopus_proto protoreflect.FileDescriptor
+
+var file_octopus_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x16, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6e, 0x65, 0x74, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x05, 0x0a,
+	0x08, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c,
+	0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e,
+	0x53, 0x69, 0x74, 0x65, 0x52, 0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x70,
+	0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70,
+	0x75, 0x73, 0x2e, 0x50, 0x6f, 0x70, 0x52, 0x04, 0x70, 0x6f, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x05,
+	0x63, 0x6f, 0x6c, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x73,
+	0x12, 0x38, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x63, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x3b, 0x0a,
+	0x08, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
+	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x52, 0x08, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x6c, 0x6f,
+	0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x63,
+	0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x4c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x0f, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65,
+	0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x0e, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3f, 0x0a,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f,
+	0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x51,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x41, 0x67, 0x65, 0x22, 0x43, 0x0a, 0x06, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0xa1,
+	0x03, 0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x41, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x67, 0x79, 0x12, 0x47, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x08,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08,
+	0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x3a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x4e, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c,
+	0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x08, 0x74, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08, 0x74, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x5b, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52,
+	0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x04, 0x53, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x19, 0x0a, 0x03, 0x50, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x91, 0x03, 0x0a, 0x04, 0x43,
+	0x6f, 0x6c, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x69,
+	0x6d, 0x61, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x6d, 0x63, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x4d, 0x63, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
+	0x5f, 0x66, 0x65, 0x64, 0x72, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x46, 0x65, 0x64, 0x72, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x70,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x69, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a,
+	0x5d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf7,
+	0x04, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x6f, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x6f, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x69, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
+	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x42,
+	0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c,
+	0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e,
+	0x52, 0x65, 0x61, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x72, 0x65, 0x61, 0x72, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x1a, 0x5d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x22, 0x8b, 0x03, 0x0a, 0x09, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x75, 0x6e,
+	0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70,
+	0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x67, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6c, 0x61, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x51,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0x5d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x6a, 0x0a, 0x09, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x72, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x72,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x72, 0x65, 0x61, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xe8, 0x03, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x48, 0x0a, 0x0e, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0d, 0x69,
+	0x70, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0e,
+	0x69, 0x70, 0x76, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x49, 0x50,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x54, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67,
+	0x12, 0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x55, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x5d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x6b, 0x0a, 0x09, 0x49,
+	0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x02, 0x49, 0x50, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x69, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x02, 0x49, 0x50, 0x12, 0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74,
+	0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd8, 0x02, 0x0a, 0x07, 0x43, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d,
+	0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75,
+	0x69, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x5d,
+	0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08,
+	0x05, 0x10, 0x06, 0x22, 0xa3, 0x02, 0x0a, 0x05, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x35, 0x0a,
+	0x05, 0x61, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x04,
+	0x61, 0x45, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x62, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62,
+	0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x04, 0x62, 0x45, 0x6e, 0x64, 0x12, 0x4d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
+	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x5d, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa0, 0x01, 0x0a, 0x08, 0x43, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a,
+	0x0b, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x61, 0x5f, 0x65,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
+	0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x04, 0x61, 0x45, 0x6e, 0x64,
+	0x12, 0x35, 0x0a, 0x05, 0x62, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
+	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e,
+	0x64, 0x52, 0x04, 0x62, 0x45, 0x6e, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x69,
+	0x63, 0x61, 0x6c, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x35, 0x0a, 0x05, 0x61, 0x5f, 0x65, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c,
+	0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e,
+	0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x04, 0x61, 0x45, 0x6e, 0x64, 0x12, 0x35,
+	0x0a, 0x05, 0x62, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f,
+	0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52,
+	0x04, 0x62, 0x45, 0x6e, 0x64, 0x12, 0x37, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x74,
+	0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xab,
+	0x02, 0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x69, 0x6f, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x4e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x1a, 0x5d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xe4, 0x01, 0x0a,
+	0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x57, 0x0a,
+	0x0d, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54,
+	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
+	0x69, 0x63, 0x54, 0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x61,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x88, 0x04, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70,
+	0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x48, 0x00,
+	0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43,
+	0x61, 0x62, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3b, 0x0a,
+	0x07, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x07, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x50,
+	0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x12, 0x3d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x4d, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0x8f, 0x01, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x34,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c,
+	0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0xcd, 0x03, 0x0a, 0x0c, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x44, 0x69,
+	0x66, 0x66, 0x12, 0x3c, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x12, 0x42, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x45,
+	0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x06, 0x63, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x08, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x73, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65,
+	0x73, 0x22, 0x91, 0x02, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04,
+	0x61, 0x73, 0x4f, 0x66, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44,
+	0x0a, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65,
+	0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x9a, 0x02, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x74, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08,
+	0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x6e, 0x6f, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x08, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f,
+	0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x22, 0xad, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x05,
+	0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x2d, 0x0a, 0x12, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x34,
+	0x0a, 0x04, 0x70, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x04,
+	0x70, 0x61, 0x72, 0x74, 0x22, 0xb4, 0x03, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3d, 0x0a, 0x05,
+	0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x3c, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x64, 0x67, 0x65, 0x73, 0x52, 0x05, 0x65, 0x64,
+	0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x16,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9e, 0x01, 0x0a, 0x0d, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a,
+	0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x2d, 0x0a, 0x12,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x48, 0x0a, 0x0e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f,
+	0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x4c, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3e, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f,
+	0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x48,
+	0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x22, 0x60, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
+	0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x38, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x02,
+	0x74, 0x6f, 0x22, 0x9e, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x22, 0x6c, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x44, 0x0a, 0x0a, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
+	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x3b, 0x0a, 0x11, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x61, 0x67,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa3,
+	0x03, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x42, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69,
+	0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x70,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x70, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x4e, 0x0a, 0x0d, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
+	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x61, 0x67,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63,
+	0x54, 0x61, 0x67, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63,
+	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
+	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x50, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0xe6, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70,
+	0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x06, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
+	0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x8c, 0x02, 0x0a,
+	0x08, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4c, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x61, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x22, 0x5d, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x09, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f,
+	0x72, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x07, 0x49, 0x50, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x3b, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x49, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x50,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
+	0x73, 0x2e, 0x49, 0x50, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x2d, 0x0a,
+	0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x8b, 0x01, 0x0a,
+	0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x49,
+	0x50, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x3a,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65,
+	0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0xc3, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c,
+	0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x22, 0x8e, 0x01, 0x0a, 0x0e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c,
+	0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb5, 0x01, 0x0a,
+	0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65,
+	0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x4b, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0c, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65,
+	0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
+	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x11, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x68,
+	0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x09, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x09, 0x73,
+	0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0x52, 0x0a, 0x1a, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x84, 0x01, 0x0a, 0x1b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c,
+	0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67,
+	0x65, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x83, 0x02, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x5b, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65,
+	0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x12, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x6e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2a, 0x7d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x47,
+	0x4f, 0x4f, 0x44, 0x10, 0x02, 0x2a, 0x85, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
+	0x54, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x41,
+	0x53, 0x54, 0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xb7, 0x01,
+	0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x23, 0x0a, 0x1f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x52, 0x5f, 0x57, 0x49,
+	0x4e, 0x53, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x52, 0x5f, 0x57, 0x49, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x45,
+	0x52, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x50, 0x52, 0x49,
+	0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x52, 0x47, 0x45,
+	0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10,
+	0x03, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54,
+	0x45, 0x47, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x04, 0x2a, 0x67, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45,
+	0x52, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x52,
+	0x47, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x45,
+	0x50, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x52, 0x45,
+	0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02,
+	0x2a, 0xcf, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x5f,
+	0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x43,
+	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x22,
+	0x0a, 0x1e, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4e, 0x54, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x52, 0x5f, 0x50,
+	0x4f, 0x52, 0x54, 0x10, 0x03, 0x12, 0x2b, 0x0a, 0x27, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45,
+	0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x49, 0x52,
+	0x43, 0x55, 0x49, 0x54, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x04, 0x2a, 0x94, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x74, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x53,
+	0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x54,
+	0x48, 0x5f, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x53,
+	0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x4d, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41,
+	0x54, 0x48, 0x5f, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x10, 0x03, 0x2a, 0x95, 0x01, 0x0a, 0x11, 0x54, 0x6f,
+	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x23, 0x0a, 0x1f, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x10,
+	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10,
+	0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x03, 0x2a, 0x69, 0x0a, 0x08, 0x44, 0x69, 0x66, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a,
+	0x15, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x49, 0x46, 0x46,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a,
+	0x11, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x66, 0x0a, 0x10,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x1a, 0x0a, 0x16, 0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x45, 0x4e, 0x43,
+	0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x54, 0x4f, 0x50, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x47, 0x5a, 0x49, 0x50, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x4f, 0x50, 0x4f,
+	0x4c, 0x4f, 0x47, 0x59, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x5a, 0x53,
+	0x54, 0x44, 0x10, 0x02, 0x2a, 0x6f, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x4d, 0x4c, 0x10, 0x00, 0x12,
+	0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x44, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x47, 0x45, 0x58, 0x46, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x4a, 0x47, 0x46, 0x10, 0x03, 0x2a, 0x54, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x64, 0x67, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x45,
+	0x44, 0x47, 0x45, 0x53, 0x5f, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x53, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x53, 0x5f, 0x50, 0x41,
+	0x54, 0x48, 0x53, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x45, 0x44, 0x47, 0x45, 0x53, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x2a, 0x41, 0x0a, 0x0e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a,
+	0x13, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52,
+	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x5f,
+	0x0a, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x15, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x4c,
+	0x4f, 0x42, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x2a,
+	0x91, 0x01, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x49, 0x4e,
+	0x46, 0x4f, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x41, 0x54, 0x41,
+	0x4c, 0x10, 0x03, 0x32, 0xfd, 0x0d, 0x0a, 0x0e, 0x4f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
+	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70,
+	0x75, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6b, 0x0a, 0x0c, 0x44, 0x69,
+	0x66, 0x66, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x2b, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61,
+	0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x08, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49,
+	0x50, 0x12, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
+	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x49, 0x50, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x50, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c,
+	0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
+	0x70, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x80, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61,
+	0x67, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
+	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x68, 0x72, 0x69, 0x6e, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
+	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x72, 0x69,
+	0x6e, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x80, 0x01, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x32, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
+	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
+	0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6c,
+	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x12, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
+	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
+	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x73,
+	0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x12, 0x2d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65,
+	0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
+	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2f, 0x6f, 0x63, 0x74,
+	0x6f, 0x70, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x63, 0x74, 0x6f, 0x70,
+	0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_octopus_proto_rawDescOnce sync.Once
+	file_octopus_proto_rawDescData = file_octopus_proto_rawDesc
+)
+
+func file_octopus_proto_rawDescGZIP() []byte {
+	file_octopus_proto_rawDescOnce.Do(func() {
+		file_octopus_proto_rawDescData = protoimpl.X.CompressGZIP(file_octopus_proto_rawDescData)
+	})
+	return file_octopus_proto_rawDescData
+}
+
+var file_octopus_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_octopus_proto_msgTypes = make([]protoimpl.MessageInfo, 78)
+var file_octopus_proto_goTypes = []interface{}{
+	(ConnectorPolicy)(0),                // 0: cloudflare.net.octopus.ConnectorPolicy
+	(ConnectorContribution)(0),          // 1: cloudflare.net.octopus.ConnectorContribution
+	(MergeStrategy)(0),                  // 2: cloudflare.net.octopus.MergeStrategy
+	(MergeResolution)(0),                // 3: cloudflare.net.octopus.MergeResolution
+	(CableEndpointType)(0),              // 4: cloudflare.net.octopus.CableEndpointType
+	(PathSegmentType)(0),                // 5: cloudflare.net.octopus.PathSegmentType
+	(TopologyEventType)(0),              // 6: cloudflare.net.octopus.TopologyEventType
+	(DiffType)(0),                       // 7: cloudflare.net.octopus.DiffType
+	(TopologyEncoding)(0),               // 8: cloudflare.net.octopus.TopologyEncoding
+	(ExportFormat)(0),                   // 9: cloudflare.net.octopus.ExportFormat
+	(ExportEdges)(0),                    // 10: cloudflare.net.octopus.ExportEdges
+	(FilterOperator)(0),                 // 11: cloudflare.net.octopus.FilterOperator
+	(NameMatchType)(0),                  // 12: cloudflare.net.octopus.NameMatchType
+	(ValidationSeverity)(0),             // 13: cloudflare.net.octopus.ValidationSeverity
+	(*Topology)(nil),                    // 14: cloudflare.net.octopus.Topology
+	(*ConnectorProvenance)(nil),         // 15: cloudflare.net.octopus.ConnectorProvenance
+	(*Source)(nil),                      // 16: cloudflare.net.octopus.Source
+	(*MergeConflict)(nil),               // 17: cloudflare.net.octopus.MergeConflict
+	(*ConnectorLoadTime)(nil),           // 18: cloudflare.net.octopus.ConnectorLoadTime
+	(*PersistedSnapshot)(nil),           // 19: cloudflare.net.octopus.PersistedSnapshot
+	(*Site)(nil),                        // 20: cloudflare.net.octopus.Site
+	(*Pop)(nil),                         // 21: cloudflare.net.octopus.Pop
+	(*Colo)(nil),                        // 22: cloudflare.net.octopus.Colo
+	(*Device)(nil),                      // 23: cloudflare.net.octopus.Device
+	(*Interface)(nil),                   // 24: cloudflare.net.octopus.Interface
+	(*FrontPort)(nil),                   // 25: cloudflare.net.octopus.FrontPort
+	(*RearPort)(nil),                    // 26: cloudflare.net.octopus.RearPort
+	(*InterfaceUnit)(nil),               // 27: cloudflare.net.octopus.InterfaceUnit
+	(*IPAddress)(nil),                   // 28: cloudflare.net.octopus.IPAddress
+	(*Circuit)(nil),                     // 29: cloudflare.net.octopus.Circuit
+	(*Cable)(nil),                       // 30: cloudflare.net.octopus.Cable
+	(*CableEnd)(nil),                    // 31: cloudflare.net.octopus.CableEnd
+	(*PathSegment)(nil),                 // 32: cloudflare.net.octopus.PathSegment
+	(*LogicalLink)(nil),                 // 33: cloudflare.net.octopus.LogicalLink
+	(*Prefix)(nil),                      // 34: cloudflare.net.octopus.Prefix
+	(*MetaData)(nil),                    // 35: cloudflare.net.octopus.MetaData
+	(*TopologyEvent)(nil),               // 36: cloudflare.net.octopus.TopologyEvent
+	(*TopologyChanges)(nil),             // 37: cloudflare.net.octopus.TopologyChanges
+	(*FieldDiff)(nil),                   // 38: cloudflare.net.octopus.FieldDiff
+	(*ObjectDiff)(nil),                  // 39: cloudflare.net.octopus.ObjectDiff
+	(*TopologyDiff)(nil),                // 40: cloudflare.net.octopus.TopologyDiff
+	(*TopologyRequest)(nil),             // 41: cloudflare.net.octopus.TopologyRequest
+	(*TopologyResponse)(nil),            // 42: cloudflare.net.octopus.TopologyResponse
+	(*StreamTopologyRequest)(nil),       // 43: cloudflare.net.octopus.StreamTopologyRequest
+	(*TopologyChunk)(nil),               // 44: cloudflare.net.octopus.TopologyChunk
+	(*ExportTopologyRequest)(nil),       // 45: cloudflare.net.octopus.ExportTopologyRequest
+	(*ExportTopologyResponse)(nil),      // 46: cloudflare.net.octopus.ExportTopologyResponse
+	(*DeviceRequest)(nil),               // 47: cloudflare.net.octopus.DeviceRequest
+	(*DeviceResponse)(nil),              // 48: cloudflare.net.octopus.DeviceResponse
+	(*WatchTopologyRequest)(nil),        // 49: cloudflare.net.octopus.WatchTopologyRequest
+	(*WatchTopologyResponse)(nil),       // 50: cloudflare.net.octopus.WatchTopologyResponse
+	(*SnapshotSelector)(nil),            // 51: cloudflare.net.octopus.SnapshotSelector
+	(*DiffTopologyRequest)(nil),         // 52: cloudflare.net.octopus.DiffTopologyRequest
+	(*DiffTopologyResponse)(nil),        // 53: cloudflare.net.octopus.DiffTopologyResponse
+	(*NameFilter)(nil),                  // 54: cloudflare.net.octopus.NameFilter
+	(*SemanticTagFilter)(nil),           // 55: cloudflare.net.octopus.SemanticTagFilter
+	(*DeviceFilter)(nil),                // 56: cloudflare.net.octopus.DeviceFilter
+	(*QueryDevicesRequest)(nil),         // 57: cloudflare.net.octopus.QueryDevicesRequest
+	(*QueryDevicesResponse)(nil),        // 58: cloudflare.net.octopus.QueryDevicesResponse
+	(*TracePathRequest)(nil),            // 59: cloudflare.net.octopus.TracePathRequest
+	(*TracePathResponse)(nil),           // 60: cloudflare.net.octopus.TracePathResponse
+	(*Neighbor)(nil),                    // 61: cloudflare.net.octopus.Neighbor
+	(*GetNeighborsRequest)(nil),         // 62: cloudflare.net.octopus.GetNeighborsRequest
+	(*GetNeighborsResponse)(nil),        // 63: cloudflare.net.octopus.GetNeighborsResponse
+	(*IPOwner)(nil),                     // 64: cloudflare.net.octopus.IPOwner
+	(*LookupIPRequest)(nil),             // 65: cloudflare.net.octopus.LookupIPRequest
+	(*LookupIPResponse)(nil),            // 66: cloudflare.net.octopus.LookupIPResponse
+	(*LookupPrefixRequest)(nil),         // 67: cloudflare.net.octopus.LookupPrefixRequest
+	(*LookupPrefixResponse)(nil),        // 68: cloudflare.net.octopus.LookupPrefixResponse
+	(*GetMergeConflictsRequest)(nil),    // 69: cloudflare.net.octopus.GetMergeConflictsRequest
+	(*GetMergeConflictsResponse)(nil),   // 70: cloudflare.net.octopus.GetMergeConflictsResponse
+	(*ValidationRule)(nil),              // 71: cloudflare.net.octopus.ValidationRule
+	(*ValidationViolation)(nil),         // 72: cloudflare.net.octopus.ValidationViolation
+	(*ValidationReport)(nil),            // 73: cloudflare.net.octopus.ValidationReport
+	(*GetValidationReportRequest)(nil),  // 74: cloudflare.net.octopus.GetValidationReportRequest
+	(*GetValidationReportResponse)(nil), // 75: cloudflare.net.octopus.GetValidationReportResponse
+	(*TopologyShrinkage)(nil),           // 76: cloudflare.net.octopus.TopologyShrinkage
+	(*GetShrinkageRequest)(nil),         // 77: cloudflare.net.octopus.GetShrinkageRequest
+	(*GetShrinkageResponse)(nil),        // 78: cloudflare.net.octopus.GetShrinkageResponse
+	(*ForceAcceptTopologyRequest)(nil),  // 79: cloudflare.net.octopus.ForceAcceptTopologyRequest
+	(*ForceAcceptTopologyResponse)(nil), // 80: cloudflare.net.octopus.ForceAcceptTopologyResponse
+	(*ListSnapshotsRequest)(nil),        // 81: cloudflare.net.octopus.ListSnapshotsRequest
+	(*SnapshotInfo)(nil),                // 82: cloudflare.net.octopus.SnapshotInfo
+	(*ListSnapshotsResponse)(nil),       // 83: cloudflare.net.octopus.ListSnapshotsResponse
+	nil,                                 // 84: cloudflare.net.octopus.Colo.ProvenanceEntry
+	nil,                                 // 85: cloudflare.net.octopus.Device.ProvenanceEntry
+	nil,                                 // 86: cloudflare.net.octopus.Interface.ProvenanceEntry
+	nil,                                 // 87: cloudflare.net.octopus.InterfaceUnit.ProvenanceEntry
+	nil,                                 // 88: cloudflare.net.octopus.Circuit.ProvenanceEntry
+	nil,                                 // 89: cloudflare.net.octopus.Cable.ProvenanceEntry
+	nil,                                 // 90: cloudflare.net.octopus.Prefix.ProvenanceEntry
+	nil,                                 // 91: cloudflare.net.octopus.MetaData.SemanticTagsEntry
+	(*api.Prefix)(nil),                  // 92: bio.net.Prefix
+	(*fieldmaskpb.FieldMask)(nil),       // 93: google.protobuf.FieldMask
+}
+var file_octopus_proto_depIdxs = []int32{
+	20,  // 0: cloudflare.net.octopus.Topology.sites:type_name -> cloudflare.net.octopus.Site
+	21,  // 1: cloudflare.net.octopus.Topology.pops:type_name -> cloudflare.net.octopus.Pop
+	22,  // 2: cloudflare.net.octopus.Topology.colos:type_name -> cloudflare.net.octopus.Colo
+	23,  // 3: cloudflare.net.octopus.Topology.devices:type_name -> cloudflare.net.octopus.Device
+	30,  // 4: cloudflare.net.octopus.Topology.cables:type_name -> cloudflare.net.octopus.Cable
+	34,  // 5: cloudflare.net.octopus.Topology.prefixes:type_name -> cloudflare.net.octopus.Prefix
+	29,  // 6: cloudflare.net.octopus.Topology.circuits:type_name -> cloudflare.net.octopus.Circuit
+	33,  // 7: cloudflare.net.octopus.Topology.logical_links:type_name -> cloudflare.net.octopus.LogicalLink
+	15,  // 8: cloudflare.net.octopus.Topology.connectors:type_name -> cloudflare.net.octopus.ConnectorProvenance
+	17,  // 9: cloudflare.net.octopus.Topology.merge_conflicts:type_name -> cloudflare.net.octopus.MergeConflict
+	0,   // 10: cloudflare.net.octopus.ConnectorProvenance.policy:type_name -> cloudflare.net.octopus.ConnectorPolicy
+	1,   // 11: cloudflare.net.octopus.ConnectorProvenance.contribution:type_name -> cloudflare.net.octopus.ConnectorContribution
+	2,   // 12: cloudflare.net.octopus.MergeConflict.strategy:type_name -> cloudflare.net.octopus.MergeStrategy
+	3,   // 13: cloudflare.net.octopus.MergeConflict.resolution:type_name -> cloudflare.net.octopus.MergeResolution
+	16,  // 14: cloudflare.net.octopus.MergeConflict.existing:type_name -> cloudflare.net.octopus.Source
+	16,  // 15: cloudflare.net.octopus.MergeConflict.incoming:type_name -> cloudflare.net.octopus.Source
+	14,  // 16: cloudflare.net.octopus.PersistedSnapshot.topology:type_name -> cloudflare.net.octopus.Topology
+	18,  // 17: cloudflare.net.octopus.PersistedSnapshot.connector_load_times:type_name -> cloudflare.net.octopus.ConnectorLoadTime
+	84,  // 18: cloudflare.net.octopus.Colo.provenance:type_name -> cloudflare.net.octopus.Colo.ProvenanceEntry
+	24,  // 19: cloudflare.net.octopus.Device.interfaces:type_name -> cloudflare.net.octopus.Interface
+	25,  // 20: cloudflare.net.octopus.Device.front_ports:type_name -> cloudflare.net.octopus.FrontPort
+	26,  // 21: cloudflare.net.octopus.Device.rear_ports:type_name -> cloudflare.net.octopus.RearPort
+	35,  // 22: cloudflare.net.octopus.Device.meta_data:type_name -> cloudflare.net.octopus.MetaData
+	85,  // 23: cloudflare.net.octopus.Device.provenance:type_name -> cloudflare.net.octopus.Device.ProvenanceEntry
+	27,  // 24: cloudflare.net.octopus.Interface.units:type_name -> cloudflare.net.octopus.InterfaceUnit
+	35,  // 25: cloudflare.net.octopus.Interface.meta_data:type_name -> cloudflare.net.octopus.MetaData
+	86,  // 26: cloudflare.net.octopus.Interface.provenance:type_name -> cloudflare.net.octopus.Interface.ProvenanceEntry
+	28,  // 27: cloudflare.net.octopus.InterfaceUnit.ipv4_addresses:type_name -> cloudflare.net.octopus.IPAddress
+	28,  // 28: cloudflare.net.octopus.InterfaceUnit.ipv6_addresses:type_name -> cloudflare.net.octopus.IPAddress
+	35,  // 29: cloudflare.net.octopus.InterfaceUnit.meta_data:type_name -> cloudflare.net.octopus.MetaData
+	87,  // 30: cloudflare.net.octopus.InterfaceUnit.provenance:type_name -> cloudflare.net.octopus.InterfaceUnit.ProvenanceEntry
+	92,  // 31: cloudflare.net.octopus.IPAddress.IP:type_name -> bio.net.Prefix
+	35,  // 32: cloudflare.net.octopus.IPAddress.meta_data:type_name -> cloudflare.net.octopus.MetaData
+	35,  // 33: cloudflare.net.octopus.Circuit.meta_data:type_name -> cloudflare.net.octopus.MetaData
+	88,  // 34: cloudflare.net.octopus.Circuit.provenance:type_name -> cloudflare.net.octopus.Circuit.ProvenanceEntry
+	31,  // 35: cloudflare.net.octopus.Cable.a_end:type_name -> cloudflare.net.octopus.CableEnd
+	31,  // 36: cloudflare.net.octopus.Cable.b_end:type_name -> cloudflare.net.octopus.CableEnd
+	89,  // 37: cloudflare.net.octopus.Cable.provenance:type_name -> cloudflare.net.octopus.Cable.ProvenanceEntry
+	4,   // 38: cloudflare.net.octopus.CableEnd.endpoint_type:type_name -> cloudflare.net.octopus.CableEndpointType
+	5,   // 39: cloudflare.net.octopus.PathSegment.type:type_name -> cloudflare.net.octopus.PathSegmentType
+	31,  // 40: cloudflare.net.octopus.PathSegment.a_end:type_name -> cloudflare.net.octopus.CableEnd
+	31,  // 41: cloudflare.net.octopus.PathSegment.b_end:type_name -> cloudflare.net.octopus.CableEnd
+	31,  // 42: cloudflare.net.octopus.LogicalLink.a_end:type_name -> cloudflare.net.octopus.CableEnd
+	31,  // 43: cloudflare.net.octopus.LogicalLink.b_end:type_name -> cloudflare.net.octopus.CableEnd
+	32,  // 44: cloudflare.net.octopus.LogicalLink.path:type_name -> cloudflare.net.octopus.PathSegment
+	92,  // 45: cloudflare.net.octopus.Prefix.prefix:type_name -> bio.net.Prefix
+	35,  // 46: cloudflare.net.octopus.Prefix.meta_data:type_name -> cloudflare.net.octopus.MetaData
+	90,  // 47: cloudflare.net.octopus.Prefix.provenance:type_name -> cloudflare.net.octopus.Prefix.ProvenanceEntry
+	91,  // 48: cloudflare.net.octopus.MetaData.semantic_tags:type_name -> cloudflare.net.octopus.MetaData.SemanticTagsEntry
+	6,   // 49: cloudflare.net.octopus.TopologyEvent.type:type_name -> cloudflare.net.octopus.TopologyEventType
+	23,  // 50: cloudflare.net.octopus.TopologyEvent.device:type_name -> cloudflare.net.octopus.Device
+	24,  // 51: cloudflare.net.octopus.TopologyEvent.interface:type_name -> cloudflare.net.octopus.Interface
+	27,  // 52: cloudflare.net.octopus.TopologyEvent.unit:type_name -> cloudflare.net.octopus.InterfaceUnit
+	30,  // 53: cloudflare.net.octopus.TopologyEvent.cable:type_name -> cloudflare.net.octopus.Cable
+	29,  // 54: cloudflare.net.octopus.TopologyEvent.circuit:type_name -> cloudflare.net.octopus.Circuit
+	34,  // 55: cloudflare.net.octopus.TopologyEvent.prefix:type_name -> cloudflare.net.octopus.Prefix
+	36,  // 56: cloudflare.net.octopus.TopologyChanges.events:type_name -> cloudflare.net.octopus.TopologyEvent
+	7,   // 57: cloudflare.net.octopus.ObjectDiff.type:type_name -> cloudflare.net.octopus.DiffType
+	38,  // 58: cloudflare.net.octopus.ObjectDiff.fields:type_name -> cloudflare.net.octopus.FieldDiff
+	39,  // 59: cloudflare.net.octopus.TopologyDiff.devices:type_name -> cloudflare.net.octopus.ObjectDiff
+	39,  // 60: cloudflare.net.octopus.TopologyDiff.interfaces:type_name -> cloudflare.net.octopus.ObjectDiff
+	39,  // 61: cloudflare.net.octopus.TopologyDiff.units:type_name -> cloudflare.net.octopus.ObjectDiff
+	39,  // 62: cloudflare.net.octopus.TopologyDiff.ip_addresses:type_name -> cloudflare.net.octopus.ObjectDiff
+	39,  // 63: cloudflare.net.octopus.TopologyDiff.cables:type_name -> cloudflare.net.octopus.ObjectDiff
+	39,  // 64: cloudflare.net.octopus.TopologyDiff.circuits:type_name -> cloudflare.net.octopus.ObjectDiff
+	39,  // 65: cloudflare.net.octopus.TopologyDiff.prefixes:type_name -> cloudflare.net.octopus.ObjectDiff
+	51,  // 66: cloudflare.net.octopus.TopologyRequest.as_of:type_name -> cloudflare.net.octopus.SnapshotSelector
+	8,   // 67: cloudflare.net.octopus.TopologyRequest.encoding:type_name -> cloudflare.net.octopus.TopologyEncoding
+	14,  // 68: cloudflare.net.octopus.TopologyResponse.topology:type_name -> cloudflare.net.octopus.Topology
+	8,   // 69: cloudflare.net.octopus.TopologyResponse.encoding:type_name -> cloudflare.net.octopus.TopologyEncoding
+	51,  // 70: cloudflare.net.octopus.StreamTopologyRequest.as_of:type_name -> cloudflare.net.octopus.SnapshotSelector
+	14,  // 71: cloudflare.net.octopus.TopologyChunk.part:type_name -> cloudflare.net.octopus.Topology
+	9,   // 72: cloudflare.net.octopus.ExportTopologyRequest.format:type_name -> cloudflare.net.octopus.ExportFormat
+	51,  // 73: cloudflare.net.octopus.ExportTopologyRequest.as_of:type_name -> cloudflare.net.octopus.SnapshotSelector
+	56,  // 74: cloudflare.net.octopus.ExportTopologyRequest.filter:type_name -> cloudflare.net.octopus.DeviceFilter
+	10,  // 75: cloudflare.net.octopus.ExportTopologyRequest.edges:type_name -> cloudflare.net.octopus.ExportEdges
+	51,  // 76: cloudflare.net.octopus.DeviceRequest.as_of:type_name -> cloudflare.net.octopus.SnapshotSelector
+	23,  // 77: cloudflare.net.octopus.DeviceResponse.device:type_name -> cloudflare.net.octopus.Device
+	14,  // 78: cloudflare.net.octopus.WatchTopologyResponse.snapshot:type_name -> cloudflare.net.octopus.Topology
+	37,  // 79: cloudflare.net.octopus.WatchTopologyResponse.changes:type_name -> cloudflare.net.octopus.TopologyChanges
+	51,  // 80: cloudflare.net.octopus.DiffTopologyRequest.from:type_name -> cloudflare.net.octopus.SnapshotSelector
+	51,  // 81: cloudflare.net.octopus.DiffTopologyRequest.to:type_name -> cloudflare.net.octopus.SnapshotSelector
+	40,  // 82: cloudflare.net.octopus.DiffTopologyResponse.diff:type_name -> cloudflare.net.octopus.TopologyDiff
+	12,  // 83: cloudflare.net.octopus.NameFilter.match_type:type_name -> cloudflare.net.octopus.NameMatchType
+	11,  // 84: cloudflare.net.octopus.DeviceFilter.operator:type_name -> cloudflare.net.octopus.FilterOperator
+	54,  // 85: cloudflare.net.octopus.DeviceFilter.names:type_name -> cloudflare.net.octopus.NameFilter
+	55,  // 86: cloudflare.net.octopus.DeviceFilter.semantic_tags:type_name -> cloudflare.net.octopus.SemanticTagFilter
+	56,  // 87: cloudflare.net.octopus.QueryDevicesRequest.filter:type_name -> cloudflare.net.octopus.DeviceFilter
+	93,  // 88: cloudflare.net.octopus.QueryDevicesRequest.field_mask:type_name -> google.protobuf.FieldMask
+	23,  // 89: cloudflare.net.octopus.QueryDevicesResponse.devices:type_name -> cloudflare.net.octopus.Device
+	31,  // 90: cloudflare.net.octopus.TracePathResponse.origin:type_name -> cloudflare.net.octopus.CableEnd
+	31,  // 91: cloudflare.net.octopus.TracePathResponse.destination:type_name -> cloudflare.net.octopus.CableEnd
+	32,  // 92: cloudflare.net.octopus.TracePathResponse.path:type_name -> cloudflare.net.octopus.PathSegment
+	61,  // 93: cloudflare.net.octopus.GetNeighborsResponse.neighbors:type_name -> cloudflare.net.octopus.Neighbor
+	28,  // 94: cloudflare.net.octopus.IPOwner.address:type_name -> cloudflare.net.octopus.IPAddress
+	64,  // 95: cloudflare.net.octopus.LookupIPResponse.owners:type_name -> cloudflare.net.octopus.IPOwner
+	34,  // 96: cloudflare.net.octopus.LookupIPResponse.prefixes:type_name -> cloudflare.net.octopus.Prefix
+	64,  // 97: cloudflare.net.octopus.LookupPrefixResponse.owners:type_name -> cloudflare.net.octopus.IPOwner
+	34,  // 98: cloudflare.net.octopus.LookupPrefixResponse.prefixes:type_name -> cloudflare.net.octopus.Prefix
+	51,  // 99: cloudflare.net.octopus.GetMergeConflictsRequest.as_of:type_name -> cloudflare.net.octopus.SnapshotSelector
+	17,  // 100: cloudflare.net.octopus.GetMergeConflictsResponse.conflicts:type_name -> cloudflare.net.octopus.MergeConflict
+	17,  // 101: cloudflare.net.octopus.GetMergeConflictsResponse.blocking:type_name -> cloudflare.net.octopus.MergeConflict
+	13,  // 102: cloudflare.net.octopus.ValidationRule.severity:type_name -> cloudflare.net.octopus.ValidationSeverity
+	13,  // 103: cloudflare.net.octopus.ValidationViolation.severity:type_name -> cloudflare.net.octopus.ValidationSeverity
+	71,  // 104: cloudflare.net.octopus.ValidationReport.rules:type_name -> cloudflare.net.octopus.ValidationRule
+	72,  // 105: cloudflare.net.octopus.ValidationReport.violations:type_name -> cloudflare.net.octopus.ValidationViolation
+	13,  // 106: cloudflare.net.octopus.GetValidationReportRequest.min_severity:type_name -> cloudflare.net.octopus.ValidationSeverity
+	73,  // 107: cloudflare.net.octopus.GetValidationReportResponse.report:type_name -> cloudflare.net.octopus.ValidationReport
+	76,  // 108: cloudflare.net.octopus.GetShrinkageResponse.shrinkage:type_name -> cloudflare.net.octopus.TopologyShrinkage
+	76,  // 109: cloudflare.net.octopus.ForceAcceptTopologyResponse.accepted:type_name -> cloudflare.net.octopus.TopologyShrinkage
+	18,  // 110: cloudflare.net.octopus.SnapshotInfo.connector_load_times:type_name -> cloudflare.net.octopus.ConnectorLoadTime
+	82,  // 111: cloudflare.net.octopus.ListSnapshotsResponse.snapshots:type_name -> cloudflare.net.octopus.SnapshotInfo
+	16,  // 112: cloudflare.net.octopus.Colo.ProvenanceEntry.value:type_name -> cloudflare.net.octopus.Source
+	16,  // 113: cloudflare.net.octopus.Device.ProvenanceEntry.value:type_name -> cloudflare.net.octopus.Source
+	16,  // 114: cloudflare.net.octopus.Interface.ProvenanceEntry.value:type_name -> cloudflare.net.octopus.Source
+	16,  // 115: cloudflare.net.octopus.InterfaceUnit.ProvenanceEntry.value:type_name -> cloudflare.net.octopus.Source
+	16,  // 116: cloudflare.net.octopus.Circuit.ProvenanceEntry.value:type_name -> cloudflare.net.octopus.Source
+	16,  // 117: cloudflare.net.octopus.Cable.ProvenanceEntry.value:type_name -> cloudflare.net.octopus.Source
+	16,  // 118: cloudflare.net.octopus.Prefix.ProvenanceEntry.value:type_name -> cloudflare.net.octopus.Source
+	41,  // 119: cloudflare.net.octopus.OctopusService.GetTopology:input_type -> cloudflare.net.octopus.TopologyRequest
+	47,  // 120: cloudflare.net.octopus.OctopusService.GetDevice:input_type -> cloudflare.net.octopus.DeviceRequest
+	49,  // 121: cloudflare.net.octopus.OctopusService.WatchTopology:input_type -> cloudflare.net.octopus.WatchTopologyRequest
+	52,  // 122: cloudflare.net.octopus.OctopusService.DiffTopology:input_type -> cloudflare.net.octopus.DiffTopologyRequest
+	57,  // 123: cloudflare.net.octopus.OctopusService.QueryDevices:input_type -> cloudflare.net.octopus.QueryDevicesRequest
+	59,  // 124: cloudflare.net.octopus.OctopusService.TracePath:input_type -> cloudflare.net.octopus.TracePathRequest
+	62,  // 125: cloudflare.net.octopus.OctopusService.GetNeighbors:input_type -> cloudflare.net.octopus.GetNeighborsRequest
+	65,  // 126: cloudflare.net.octopus.OctopusService.LookupIP:input_type -> cloudflare.net.octopus.LookupIPRequest
+	67,  // 127: cloudflare.net.octopus.OctopusService.LookupPrefix:input_type -> cloudflare.net.octopus.LookupPrefixRequest
+	81,  // 128: cloudflare.net.octopus.OctopusService.ListSnapshots:input_type -> cloudflare.net.octopus.ListSnapshotsRequest
+	69,  // 129: cloudflare.net.octopus.OctopusService.GetMergeConflicts:input_type -> cloudflare.net.octopus.GetMergeConflictsRequest
+	74,  // 130: cloudflare.net.octopus.OctopusService.GetValidationReport:input_type -> cloudflare.net.octopus.GetValidationReportRequest
+	77,  // 131: cloudflare.net.octopus.OctopusService.GetShrinkage:input_type -> cloudflare.net.octopus.GetShrinkageRequest
+	79,  // 132: cloudflare.net.octopus.OctopusService.ForceAcceptTopology:input_type -> cloudflare.net.octopus.ForceAcceptTopologyRequest
+	43,  // 133: cloudflare.net.octopus.OctopusService.StreamTopology:input_type -> cloudflare.net.octopus.StreamTopologyRequest
+	45,  // 134: cloudflare.net.octopus.OctopusService.ExportTopology:input_type -> cloudflare.net.octopus.ExportTopologyRequest
+	42,  // 135: cloudflare.net.octopus.OctopusService.GetTopology:output_type -> cloudflare.net.octopus.TopologyResponse
+	48,  // 136: cloudflare.net.octopus.OctopusService.GetDevice:output_type -> cloudflare.net.octopus.DeviceResponse
+	50,  // 137: cloudflare.net.octopus.OctopusService.WatchTopology:output_type -> cloudflare.net.octopus.WatchTopologyResponse
+	53,  // 138: cloudflare.net.octopus.OctopusService.DiffTopology:output_type -> cloudflare.net.octopus.DiffTopologyResponse
+	58,  // 139: cloudflare.net.octopus.OctopusService.QueryDevices:output_type -> cloudflare.net.octopus.QueryDevicesResponse
+	60,  // 140: cloudflare.net.octopus.OctopusService.TracePath:output_type -> cloudflare.net.octopus.TracePathResponse
+	63,  // 141: cloudflare.net.octopus.OctopusService.GetNeighbors:output_type -> cloudflare.net.octopus.GetNeighborsResponse
+	66,  // 142: cloudflare.net.octopus.OctopusService.LookupIP:output_type -> cloudflare.net.octopus.LookupIPResponse
+	68,  // 143: cloudflare.net.octopus.OctopusService.LookupPrefix:output_type -> cloudflare.net.octopus.LookupPrefixResponse
+	83,  // 144: cloudflare.net.octopus.OctopusService.ListSnapshots:output_type -> cloudflare.net.octopus.ListSnapshotsResponse
+	70,  // 145: cloudflare.net.octopus.OctopusService.GetMergeConflicts:output_type -> cloudflare.net.octopus.GetMergeConflictsResponse
+	75,  // 146: cloudflare.net.octopus.OctopusService.GetValidationReport:output_type -> cloudflare.net.octopus.GetValidationReportResponse
+	78,  // 147: cloudflare.net.octopus.OctopusService.GetShrinkage:output_type -> cloudflare.net.octopus.GetShrinkageResponse
+	80,  // 148: cloudflare.net.octopus.OctopusService.ForceAcceptTopology:output_type -> cloudflare.net.octopus.ForceAcceptTopologyResponse
+	44,  // 149: cloudflare.net.octopus.OctopusService.StreamTopology:output_type -> cloudflare.net.octopus.TopologyChunk
+	46,  // 150: cloudflare.net.octopus.OctopusService.ExportTopology:output_type -> cloudflare.net.octopus.ExportTopologyResponse
+	135, // [135:151] is the sub-list for method output_type
+	119, // [119:135] is the sub-list for method input_type
+	119, // [119:119] is the sub-list for extension type_name
+	119, // [119:119] is the sub-list for extension extendee
+	0,   // [0:119] is the sub-list for field type_name
+}
+
+func init() { file_octopus_proto_init() }
+func file_octopus_proto_init() {
+	if File_octopus_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_octopus_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Topology); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectorProvenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Source); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectorLoadTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistedSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Site); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Colo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Device); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrontPort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RearPort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterfaceUnit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Circuit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CableEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogicalLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Prefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetaData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyChanges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTopologyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTopologyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTopologyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTopologyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTopologyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotSelector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffTopologyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffTopologyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SemanticTagFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracePathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TracePathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Neighbor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNeighborsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_octopus_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNeighborsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeviceRequest.ProtoReflect.Descriptor instead.
-func (*DeviceRequest) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *DeviceRequest) GetDeviceName() string {
-	if x != nil {
-		return x.DeviceName
-	}
-	return ""
-}
-
-type DeviceResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Device *Device `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
-}
-
-func (x *DeviceResponse) Reset() {
-	*x = DeviceResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_octopus_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *DeviceResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeviceResponse) ProtoMessage() {}
-
-func (x *DeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_octopus_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_octopus_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeviceResponse.ProtoReflect.Descriptor instead.
-func (*DeviceResponse) Descriptor() ([]byte, []int) {
-	return file_octopus_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *DeviceResponse) GetDevice() *Device {
-	if x != nil {
-		return x.Device
-	}
-	return nil
-}
-
-var File_octopus_proto protoreflect.FileDescriptor
-
-var file_octopus_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x16, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
-	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x1a, 0x11, 0x6e, 0x65, 0x74, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x03, 0x0a, 0x08, 0x54,
-	0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
-	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x53, 0x69,
-	0x74, 0x65, 0x52, 0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x6f, 0x70,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
-	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
-	0x2e, 0x50, 0x6f, 0x70, 0x52, 0x04, 0x70, 0x6f, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x6f,
-	0x6c, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70,
-	0x75, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x73, 0x12, 0x38,
-	0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
-	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
-	0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x06, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
-	0x3a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
-	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x63,
-	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f,
-	0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x52, 0x08,
-	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x73, 0x22, 0x1a, 0x0a, 0x04, 0x53, 0x69, 0x74, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x19, 0x0a, 0x03, 0x50, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0xe4, 0x01, 0x0a, 0x04, 0x43, 0x6f, 0x6c, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x6d,
-	0x63, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x4d, 0x63, 0x70, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x65, 0x64, 0x72, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x65, 0x64, 0x72, 0x61, 0x6d, 0x70, 0x12, 0x10,
-	0x0a, 0x03, 0x70, 0x6f, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x70,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0xc8, 0x03, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x17, 0x0a,
-	0x07, 0x63, 0x6f, 0x6c, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x63, 0x6f, 0x6c, 0x6f, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x74, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
-	0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x5f,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
-	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0a,
-	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x72, 0x65,
-	0x61, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
-	0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x72, 0x50, 0x6f, 0x72, 0x74,
-	0x52, 0x09, 0x72, 0x65, 0x61, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x09,
-	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74,
-	0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x0a, 0x10,
-	0x0b, 0x22, 0xd9, 0x01, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
-	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73,
-	0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x67, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f,
-	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x67, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x4f, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
-	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x6a, 0x0a,
-	0x09, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x65, 0x61, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x72,
-	0x65, 0x61, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x61, 0x72, 0x50, 0x6f, 0x72,
-	0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x08, 0x52, 0x65, 0x61,
-	0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x0e, 0x69, 0x70, 0x76,
-	0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
-	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x49, 0x50, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0e, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74,
-	0x6f, 0x70, 0x75, 0x73, 0x2e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0d,
-	0x69, 0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e,
-	0x6e, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69,
-	0x6e, 0x6e, 0x65, 0x72, 0x54, 0x61, 0x67, 0x12, 0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
-	0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x6b, 0x0a, 0x09,
-	0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x02, 0x49, 0x50, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x69, 0x6f, 0x2e, 0x6e, 0x65, 0x74, 0x2e,
-	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x02, 0x49, 0x50, 0x12, 0x3d, 0x0a, 0x09, 0x6d, 0x65,
-	0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f,
-	0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa8, 0x01, 0x0a, 0x07, 0x43, 0x69,
-	0x72, 0x63, 0x75, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e,
-	0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x04,
-	0x08, 0x05, 0x10, 0x06, 0x22, 0x75, 0x0a, 0x05, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x35, 0x0a,
-	0x05, 0x61, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
-	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x04,
-	0x61, 0x45, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x62, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65,
-	0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x6e, 0x64, 0x52, 0x04, 0x62, 0x45, 0x6e, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x08,
-	0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x29, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65,
-	0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x7c,
-	0x0a, 0x06, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x69, 0x6f, 0x2e, 0x6e,
-	0x65, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x12, 0x3d, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
-	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65,
-	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
-	0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xe4, 0x01, 0x0a,
-	0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x57, 0x0a,
-	0x0d, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72,
-	0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x4d, 0x65,
-	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54,
-	0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x65, 0x6d, 0x61, 0x6e, 0x74,
-	0x69, 0x63, 0x54, 0x61, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61,
-	0x74, 0x61, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x65, 0x6d, 0x61, 0x6e, 0x74, 0x69, 0x63, 0x54, 0x61,
-	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x11, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f,
-	0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x74, 0x6f,
-	0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
-	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08,
-	0x74, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x22, 0x30, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x0e, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63,
-	0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x2a, 0xcf, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x41,
-	0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x21, 0x0a, 0x1d, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e,
-	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45,
-	0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x50,
-	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4e, 0x54, 0x5f,
-	0x50, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x5f,
-	0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
-	0x41, 0x52, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x03, 0x12, 0x2b, 0x0a, 0x27, 0x43, 0x41, 0x42,
-	0x4c, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x32, 0xd2, 0x01, 0x0a, 0x0e, 0x4f, 0x63, 0x74, 0x6f, 0x70,
-	0x75, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x0b, 0x47, 0x65, 0x74,
-	0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75,
-	0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
-	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x6f, 0x6c,
-	0x6f, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a,
-	0x09, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6c, 0x6f,
-	0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f,
-	0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66, 0x6c, 0x61, 0x72, 0x65, 0x2e, 0x6e,
-	0x65, 0x74, 0x2e, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x66,
-	0x6c, 0x61, 0x72, 0x65, 0x2f, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x6f, 0x63, 0x74, 0x6f, 0x70, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
-}
-
-var (
-	file_octopus_proto_rawDescOnce sync.Once
-	file_octopus_proto_rawDescData = file_octopus_proto_rawDesc
-)
-
-func file_octopus_proto_rawDescGZIP() []byte {
-	file_octopus_proto_rawDescOnce.Do(func() {
-		file_octopus_proto_rawDescData = protoimpl.X.CompressGZIP(file_octopus_proto_rawDescData)
-	})
-	return file_octopus_proto_rawDescData
-}
-
-var file_octopus_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_octopus_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
-var file_octopus_proto_goTypes = []interface{}{
-	(CableEndpointType)(0),   // 0: cloudflare.net.octopus.CableEndpointType
-	(*Topology)(nil),         // 1: cloudflare.net.octopus.Topology
-	(*Site)(nil),             // 2: cloudflare.net.octopus.Site
-	(*Pop)(nil),              // 3: cloudflare.net.octopus.Pop
-	(*Colo)(nil),             // 4: cloudflare.net.octopus.Colo
-	(*Device)(nil),           // 5: cloudflare.net.octopus.Device
-	(*Interface)(nil),        // 6: cloudflare.net.octopus.Interface
-	(*FrontPort)(nil),        // 7: cloudflare.net.octopus.FrontPort
-	(*RearPort)(nil),         // 8: cloudflare.net.octopus.RearPort
-	(*InterfaceUnit)(nil),    // 9: cloudflare.net.octopus.InterfaceUnit
-	(*IPAddress)(nil),        // 10: cloudflare.net.octopus.IPAddress
-	(*Circuit)(nil),          // 11: cloudflare.net.octopus.Circuit
-	(*Cable)(nil),            // 12: cloudflare.net.octopus.Cable
-	(*CableEnd)(nil),         // 13: cloudflare.net.octopus.CableEnd
-	(*Prefix)(nil),           // 14: cloudflare.net.octopus.Prefix
-	(*MetaData)(nil),         // 15: cloudflare.net.octopus.MetaData
-	(*TopologyRequest)(nil),  // 16: cloudflare.net.octopus.TopologyRequest
-	(*TopologyResponse)(nil), // 17: cloudflare.net.octopus.TopologyResponse
-	(*DeviceRequest)(nil),    // 18: cloudflare.net.octopus.DeviceRequest
-	(*DeviceResponse)(nil),   // 19: cloudflare.net.octopus.DeviceResponse
-	nil,                      // 20: cloudflare.net.octopus.MetaData.SemanticTagsEntry
-	(*api.Prefix)(nil),       // 21: bio.net.Prefix
-}
-var file_octopus_proto_depIdxs = []int32{
-	2,  // 0: cloudflare.net.octopus.Topology.sites:type_name -> cloudflare.net.octopus.Site
-	3,  // 1: cloudflare.net.octopus.Topology.pops:type_name -> cloudflare.net.octopus.Pop
-	4,  // 2: cloudflare.net.octopus.Topology.colos:type_name -> cloudflare.net.octopus.Colo
-	5,  // 3: cloudflare.net.octopus.Topology.devices:type_name -> cloudflare.net.octopus.Device
-	12, // 4: cloudflare.net.octopus.Topology.cables:type_name -> cloudflare.net.octopus.Cable
-	14, // 5: cloudflare.net.octopus.Topology.prefixes:type_name -> cloudflare.net.octopus.Prefix
-	11, // 6: cloudflare.net.octopus.Topology.circuits:type_name -> cloudflare.net.octopus.Circuit
-	6,  // 7: cloudflare.net.octopus.Device.interfaces:type_name -> cloudflare.net.octopus.Interface
-	7,  // 8: cloudflare.net.octopus.Device.front_ports:type_name -> cloudflare.net.octopus.FrontPort
-	8,  // 9: cloudflare.net.octopus.Device.rear_ports:type_name -> cloudflare.net.octopus.RearPort
-	15, // 10: cloudflare.net.octopus.Device.meta_data:type_name -> cloudflare.net.octopus.MetaData
-	9,  // 11: cloudflare.net.octopus.Interface.units:type_name -> cloudflare.net.octopus.InterfaceUnit
-	15, // 12: cloudflare.net.octopus.Interface.meta_data:type_name -> cloudflare.net.octopus.MetaData
-	10, // 13: cloudflare.net.octopus.InterfaceUnit.ipv4_addresses:type_name -> cloudflare.net.octopus.IPAddress
-	10, // 14: cloudflare.net.octopus.InterfaceUnit.ipv6_addresses:type_name -> cloudflare.net.octopus.IPAddress
-	15, // 15: cloudflare.net.octopus.InterfaceUnit.meta_data:type_name -> cloudflare.net.octopus.MetaData
-	21, // 16: cloudflare.net.octopus.IPAddress.IP:type_name -> bio.net.Prefix
-	15, // 17: cloudflare.net.octopus.IPAddress.meta_data:type_name -> cloudflare.net.octopus.MetaData
-	15, // 18: cloudflare.net.octopus.Circuit.meta_data:type_name -> cloudflare.net.octopus.MetaData
-	13, // 19: cloudflare.net.octopus.Cable.a_end:type_name -> cloudflare.net.octopus.CableEnd
-	13, // 20: cloudflare.net.octopus.Cable.b_end:type_name -> cloudflare.net.octopus.CableEnd
-	0,  // 21: cloudflare.net.octopus.CableEnd.endpoint_type:type_name -> cloudflare.net.octopus.CableEndpointType
-	21, // 22: cloudflare.net.octopus.Prefix.prefix:type_name -> bio.net.Prefix
-	15, // 23: cloudflare.net.octopus.Prefix.meta_data:type_name -> cloudflare.net.octopus.MetaData
-	20, // 24: cloudflare.net.octopus.MetaData.semantic_tags:type_name -> cloudflare.net.octopus.MetaData.SemanticTagsEntry
-	1,  // 25: cloudflare.net.octopus.TopologyResponse.topology:type_name -> cloudflare.net.octopus.Topology
-	5,  // 26: cloudflare.net.octopus.DeviceResponse.device:type_name -> cloudflare.net.octopus.Device
-	16, // 27: cloudflare.net.octopus.OctopusService.GetTopology:input_type -> cloudflare.net.octopus.TopologyRequest
-	18, // 28: cloudflare.net.octopus.OctopusService.GetDevice:input_type -> cloudflare.net.octopus.DeviceRequest
-	17, // 29: cloudflare.net.octopus.OctopusService.GetTopology:output_type -> cloudflare.net.octopus.TopologyResponse
-	19, // 30: cloudflare.net.octopus.OctopusService.GetDevice:output_type -> cloudflare.net.octopus.DeviceResponse
-	29, // [29:31] is the sub-list for method output_type
-	27, // [27:29] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
-}
-
-func init() { file_octopus_proto_init() }
-func file_octopus_proto_init() {
-	if File_octopus_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_octopus_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Topology); i {
+		file_octopus_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupIPRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1689,8 +7725,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Site); i {
+		file_octopus_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupIPResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1701,8 +7737,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pop); i {
+		file_octopus_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupPrefixRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1713,8 +7749,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Colo); i {
+		file_octopus_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupPrefixResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1725,8 +7761,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Device); i {
+		file_octopus_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMergeConflictsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1737,8 +7773,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface); i {
+		file_octopus_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMergeConflictsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1749,8 +7785,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FrontPort); i {
+		file_octopus_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1761,8 +7797,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RearPort); i {
+		file_octopus_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationViolation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1773,8 +7809,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InterfaceUnit); i {
+		file_octopus_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1785,8 +7821,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPAddress); i {
+		file_octopus_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidationReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1797,8 +7833,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Circuit); i {
+		file_octopus_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetValidationReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1809,8 +7845,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cable); i {
+		file_octopus_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopologyShrinkage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1821,8 +7857,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CableEnd); i {
+		file_octopus_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShrinkageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1833,8 +7869,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Prefix); i {
+		file_octopus_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShrinkageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1845,8 +7881,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetaData); i {
+		file_octopus_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceAcceptTopologyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1857,8 +7893,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopologyRequest); i {
+		file_octopus_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceAcceptTopologyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1869,8 +7905,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopologyResponse); i {
+		file_octopus_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1881,8 +7917,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeviceRequest); i {
+		file_octopus_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1893,8 +7929,8 @@ func file_octopus_proto_init() {
 				return nil
 			}
 		}
-		file_octopus_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeviceResponse); i {
+		file_octopus_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1906,13 +7942,29 @@ func file_octopus_proto_init() {
 			}
 		}
 	}
+	file_octopus_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*TopologyEvent_Device)(nil),
+		(*TopologyEvent_Interface)(nil),
+		(*TopologyEvent_Unit)(nil),
+		(*TopologyEvent_Cable)(nil),
+		(*TopologyEvent_Circuit)(nil),
+		(*TopologyEvent_Prefix)(nil),
+	}
+	file_octopus_proto_msgTypes[36].OneofWrappers = []interface{}{
+		(*WatchTopologyResponse_Snapshot)(nil),
+		(*WatchTopologyResponse_Changes)(nil),
+	}
+	file_octopus_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*SnapshotSelector_Generation)(nil),
+		(*SnapshotSelector_Timestamp)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_octopus_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   20,
+			NumEnums:      14,
+			NumMessages:   78,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
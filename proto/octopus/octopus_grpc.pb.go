@@ -24,6 +24,20 @@ const _ = grpc.SupportPackageIsVersion7
 type OctopusServiceClient interface {
 	GetTopology(ctx context.Context, in *TopologyRequest, opts ...grpc.CallOption) (*TopologyResponse, error)
 	GetDevice(ctx context.Context, in *DeviceRequest, opts ...grpc.CallOption) (*DeviceResponse, error)
+	WatchTopology(ctx context.Context, in *WatchTopologyRequest, opts ...grpc.CallOption) (OctopusService_WatchTopologyClient, error)
+	DiffTopology(ctx context.Context, in *DiffTopologyRequest, opts ...grpc.CallOption) (*DiffTopologyResponse, error)
+	QueryDevices(ctx context.Context, in *QueryDevicesRequest, opts ...grpc.CallOption) (*QueryDevicesResponse, error)
+	TracePath(ctx context.Context, in *TracePathRequest, opts ...grpc.CallOption) (*TracePathResponse, error)
+	GetNeighbors(ctx context.Context, in *GetNeighborsRequest, opts ...grpc.CallOption) (*GetNeighborsResponse, error)
+	LookupIP(ctx context.Context, in *LookupIPRequest, opts ...grpc.CallOption) (*LookupIPResponse, error)
+	LookupPrefix(ctx context.Context, in *LookupPrefixRequest, opts ...grpc.CallOption) (*LookupPrefixResponse, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	GetMergeConflicts(ctx context.Context, in *GetMergeConflictsRequest, opts ...grpc.CallOption) (*GetMergeConflictsResponse, error)
+	GetValidationReport(ctx context.Context, in *GetValidationReportRequest, opts ...grpc.CallOption) (*GetValidationReportResponse, error)
+	GetShrinkage(ctx context.Context, in *GetShrinkageRequest, opts ...grpc.CallOption) (*GetShrinkageResponse, error)
+	ForceAcceptTopology(ctx context.Context, in *ForceAcceptTopologyRequest, opts ...grpc.CallOption) (*ForceAcceptTopologyResponse, error)
+	StreamTopology(ctx context.Context, in *StreamTopologyRequest, opts ...grpc.CallOption) (OctopusService_StreamTopologyClient, error)
+	ExportTopology(ctx context.Context, in *ExportTopologyRequest, opts ...grpc.CallOption) (OctopusService_ExportTopologyClient, error)
 }
 
 type octopusServiceClient struct {
@@ -52,12 +66,221 @@ func (c *octopusServiceClient) GetDevice(ctx context.Context, in *DeviceRequest,
 	return out, nil
 }
 
+func (c *octopusServiceClient) WatchTopology(ctx context.Context, in *WatchTopologyRequest, opts ...grpc.CallOption) (OctopusService_WatchTopologyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OctopusService_ServiceDesc.Streams[0], "/cloudflare.net.octopus.OctopusService/WatchTopology", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &octopusServiceWatchTopologyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OctopusService_WatchTopologyClient interface {
+	Recv() (*WatchTopologyResponse, error)
+	grpc.ClientStream
+}
+
+type octopusServiceWatchTopologyClient struct {
+	grpc.ClientStream
+}
+
+func (x *octopusServiceWatchTopologyClient) Recv() (*WatchTopologyResponse, error) {
+	m := new(WatchTopologyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *octopusServiceClient) DiffTopology(ctx context.Context, in *DiffTopologyRequest, opts ...grpc.CallOption) (*DiffTopologyResponse, error) {
+	out := new(DiffTopologyResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/DiffTopology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) QueryDevices(ctx context.Context, in *QueryDevicesRequest, opts ...grpc.CallOption) (*QueryDevicesResponse, error) {
+	out := new(QueryDevicesResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/QueryDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) TracePath(ctx context.Context, in *TracePathRequest, opts ...grpc.CallOption) (*TracePathResponse, error) {
+	out := new(TracePathResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/TracePath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) GetNeighbors(ctx context.Context, in *GetNeighborsRequest, opts ...grpc.CallOption) (*GetNeighborsResponse, error) {
+	out := new(GetNeighborsResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/GetNeighbors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) LookupIP(ctx context.Context, in *LookupIPRequest, opts ...grpc.CallOption) (*LookupIPResponse, error) {
+	out := new(LookupIPResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/LookupIP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) LookupPrefix(ctx context.Context, in *LookupPrefixRequest, opts ...grpc.CallOption) (*LookupPrefixResponse, error) {
+	out := new(LookupPrefixResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/LookupPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) GetMergeConflicts(ctx context.Context, in *GetMergeConflictsRequest, opts ...grpc.CallOption) (*GetMergeConflictsResponse, error) {
+	out := new(GetMergeConflictsResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/GetMergeConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) GetValidationReport(ctx context.Context, in *GetValidationReportRequest, opts ...grpc.CallOption) (*GetValidationReportResponse, error) {
+	out := new(GetValidationReportResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/GetValidationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) GetShrinkage(ctx context.Context, in *GetShrinkageRequest, opts ...grpc.CallOption) (*GetShrinkageResponse, error) {
+	out := new(GetShrinkageResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/GetShrinkage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) ForceAcceptTopology(ctx context.Context, in *ForceAcceptTopologyRequest, opts ...grpc.CallOption) (*ForceAcceptTopologyResponse, error) {
+	out := new(ForceAcceptTopologyResponse)
+	err := c.cc.Invoke(ctx, "/cloudflare.net.octopus.OctopusService/ForceAcceptTopology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *octopusServiceClient) StreamTopology(ctx context.Context, in *StreamTopologyRequest, opts ...grpc.CallOption) (OctopusService_StreamTopologyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OctopusService_ServiceDesc.Streams[1], "/cloudflare.net.octopus.OctopusService/StreamTopology", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &octopusServiceStreamTopologyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OctopusService_StreamTopologyClient interface {
+	Recv() (*TopologyChunk, error)
+	grpc.ClientStream
+}
+
+type octopusServiceStreamTopologyClient struct {
+	grpc.ClientStream
+}
+
+func (x *octopusServiceStreamTopologyClient) Recv() (*TopologyChunk, error) {
+	m := new(TopologyChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *octopusServiceClient) ExportTopology(ctx context.Context, in *ExportTopologyRequest, opts ...grpc.CallOption) (OctopusService_ExportTopologyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OctopusService_ServiceDesc.Streams[2], "/cloudflare.net.octopus.OctopusService/ExportTopology", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &octopusServiceExportTopologyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OctopusService_ExportTopologyClient interface {
+	Recv() (*ExportTopologyResponse, error)
+	grpc.ClientStream
+}
+
+type octopusServiceExportTopologyClient struct {
+	grpc.ClientStream
+}
+
+func (x *octopusServiceExportTopologyClient) Recv() (*ExportTopologyResponse, error) {
+	m := new(ExportTopologyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OctopusServiceServer is the server API for OctopusService service.
 // All implementations should embed UnimplementedOctopusServiceServer
 // for forward compatibility
 type OctopusServiceServer interface {
 	GetTopology(context.Context, *TopologyRequest) (*TopologyResponse, error)
 	GetDevice(context.Context, *DeviceRequest) (*DeviceResponse, error)
+	WatchTopology(*WatchTopologyRequest, OctopusService_WatchTopologyServer) error
+	DiffTopology(context.Context, *DiffTopologyRequest) (*DiffTopologyResponse, error)
+	QueryDevices(context.Context, *QueryDevicesRequest) (*QueryDevicesResponse, error)
+	TracePath(context.Context, *TracePathRequest) (*TracePathResponse, error)
+	GetNeighbors(context.Context, *GetNeighborsRequest) (*GetNeighborsResponse, error)
+	LookupIP(context.Context, *LookupIPRequest) (*LookupIPResponse, error)
+	LookupPrefix(context.Context, *LookupPrefixRequest) (*LookupPrefixResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	GetMergeConflicts(context.Context, *GetMergeConflictsRequest) (*GetMergeConflictsResponse, error)
+	GetValidationReport(context.Context, *GetValidationReportRequest) (*GetValidationReportResponse, error)
+	GetShrinkage(context.Context, *GetShrinkageRequest) (*GetShrinkageResponse, error)
+	ForceAcceptTopology(context.Context, *ForceAcceptTopologyRequest) (*ForceAcceptTopologyResponse, error)
+	StreamTopology(*StreamTopologyRequest, OctopusService_StreamTopologyServer) error
+	ExportTopology(*ExportTopologyRequest, OctopusService_ExportTopologyServer) error
 }
 
 // UnimplementedOctopusServiceServer should be embedded to have forward compatible implementations.
@@ -70,6 +293,48 @@ func (UnimplementedOctopusServiceServer) GetTopology(context.Context, *TopologyR
 func (UnimplementedOctopusServiceServer) GetDevice(context.Context, *DeviceRequest) (*DeviceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDevice not implemented")
 }
+func (UnimplementedOctopusServiceServer) WatchTopology(*WatchTopologyRequest, OctopusService_WatchTopologyServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTopology not implemented")
+}
+func (UnimplementedOctopusServiceServer) DiffTopology(context.Context, *DiffTopologyRequest) (*DiffTopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffTopology not implemented")
+}
+func (UnimplementedOctopusServiceServer) QueryDevices(context.Context, *QueryDevicesRequest) (*QueryDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDevices not implemented")
+}
+func (UnimplementedOctopusServiceServer) TracePath(context.Context, *TracePathRequest) (*TracePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TracePath not implemented")
+}
+func (UnimplementedOctopusServiceServer) GetNeighbors(context.Context, *GetNeighborsRequest) (*GetNeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNeighbors not implemented")
+}
+func (UnimplementedOctopusServiceServer) LookupIP(context.Context, *LookupIPRequest) (*LookupIPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupIP not implemented")
+}
+func (UnimplementedOctopusServiceServer) LookupPrefix(context.Context, *LookupPrefixRequest) (*LookupPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupPrefix not implemented")
+}
+func (UnimplementedOctopusServiceServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedOctopusServiceServer) GetMergeConflicts(context.Context, *GetMergeConflictsRequest) (*GetMergeConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMergeConflicts not implemented")
+}
+func (UnimplementedOctopusServiceServer) GetValidationReport(context.Context, *GetValidationReportRequest) (*GetValidationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidationReport not implemented")
+}
+func (UnimplementedOctopusServiceServer) GetShrinkage(context.Context, *GetShrinkageRequest) (*GetShrinkageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShrinkage not implemented")
+}
+func (UnimplementedOctopusServiceServer) ForceAcceptTopology(context.Context, *ForceAcceptTopologyRequest) (*ForceAcceptTopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceAcceptTopology not implemented")
+}
+func (UnimplementedOctopusServiceServer) StreamTopology(*StreamTopologyRequest, OctopusService_StreamTopologyServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTopology not implemented")
+}
+func (UnimplementedOctopusServiceServer) ExportTopology(*ExportTopologyRequest, OctopusService_ExportTopologyServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTopology not implemented")
+}
 
 // UnsafeOctopusServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to OctopusServiceServer will
@@ -118,6 +383,267 @@ func _OctopusService_GetDevice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OctopusService_WatchTopology_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTopologyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OctopusServiceServer).WatchTopology(m, &octopusServiceWatchTopologyServer{stream})
+}
+
+type OctopusService_WatchTopologyServer interface {
+	Send(*WatchTopologyResponse) error
+	grpc.ServerStream
+}
+
+type octopusServiceWatchTopologyServer struct {
+	grpc.ServerStream
+}
+
+func (x *octopusServiceWatchTopologyServer) Send(m *WatchTopologyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OctopusService_DiffTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).DiffTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/DiffTopology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).DiffTopology(ctx, req.(*DiffTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_QueryDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).QueryDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/QueryDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).QueryDevices(ctx, req.(*QueryDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_TracePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TracePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).TracePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/TracePath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).TracePath(ctx, req.(*TracePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_GetNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).GetNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/GetNeighbors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).GetNeighbors(ctx, req.(*GetNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_LookupIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).LookupIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/LookupIP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).LookupIP(ctx, req.(*LookupIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_LookupPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupPrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).LookupPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/LookupPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).LookupPrefix(ctx, req.(*LookupPrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_GetMergeConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMergeConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).GetMergeConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/GetMergeConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).GetMergeConflicts(ctx, req.(*GetMergeConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_GetValidationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).GetValidationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/GetValidationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).GetValidationReport(ctx, req.(*GetValidationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_GetShrinkage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShrinkageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).GetShrinkage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/GetShrinkage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).GetShrinkage(ctx, req.(*GetShrinkageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_ForceAcceptTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceAcceptTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OctopusServiceServer).ForceAcceptTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cloudflare.net.octopus.OctopusService/ForceAcceptTopology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OctopusServiceServer).ForceAcceptTopology(ctx, req.(*ForceAcceptTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OctopusService_StreamTopology_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTopologyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OctopusServiceServer).StreamTopology(m, &octopusServiceStreamTopologyServer{stream})
+}
+
+type OctopusService_StreamTopologyServer interface {
+	Send(*TopologyChunk) error
+	grpc.ServerStream
+}
+
+type octopusServiceStreamTopologyServer struct {
+	grpc.ServerStream
+}
+
+func (x *octopusServiceStreamTopologyServer) Send(m *TopologyChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OctopusService_ExportTopology_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTopologyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OctopusServiceServer).ExportTopology(m, &octopusServiceExportTopologyServer{stream})
+}
+
+type OctopusService_ExportTopologyServer interface {
+	Send(*ExportTopologyResponse) error
+	grpc.ServerStream
+}
+
+type octopusServiceExportTopologyServer struct {
+	grpc.ServerStream
+}
+
+func (x *octopusServiceExportTopologyServer) Send(m *ExportTopologyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // OctopusService_ServiceDesc is the grpc.ServiceDesc for OctopusService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -133,7 +659,67 @@ var OctopusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDevice",
 			Handler:    _OctopusService_GetDevice_Handler,
 		},
+		{
+			MethodName: "DiffTopology",
+			Handler:    _OctopusService_DiffTopology_Handler,
+		},
+		{
+			MethodName: "QueryDevices",
+			Handler:    _OctopusService_QueryDevices_Handler,
+		},
+		{
+			MethodName: "TracePath",
+			Handler:    _OctopusService_TracePath_Handler,
+		},
+		{
+			MethodName: "GetNeighbors",
+			Handler:    _OctopusService_GetNeighbors_Handler,
+		},
+		{
+			MethodName: "LookupIP",
+			Handler:    _OctopusService_LookupIP_Handler,
+		},
+		{
+			MethodName: "LookupPrefix",
+			Handler:    _OctopusService_LookupPrefix_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _OctopusService_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "GetMergeConflicts",
+			Handler:    _OctopusService_GetMergeConflicts_Handler,
+		},
+		{
+			MethodName: "GetValidationReport",
+			Handler:    _OctopusService_GetValidationReport_Handler,
+		},
+		{
+			MethodName: "GetShrinkage",
+			Handler:    _OctopusService_GetShrinkage_Handler,
+		},
+		{
+			MethodName: "ForceAcceptTopology",
+			Handler:    _OctopusService_ForceAcceptTopology_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTopology",
+			Handler:       _OctopusService_WatchTopology_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTopology",
+			Handler:       _OctopusService_StreamTopology_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportTopology",
+			Handler:       _OctopusService_ExportTopology_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "octopus.proto",
 }